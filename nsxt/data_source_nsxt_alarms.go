@@ -0,0 +1,171 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+)
+
+var alarmSeverityValues = []string{
+	model.Alarm_SEVERITY_CRITICAL,
+	model.Alarm_SEVERITY_HIGH,
+	model.Alarm_SEVERITY_MEDIUM,
+	model.Alarm_SEVERITY_LOW,
+}
+
+var alarmStatusValues = []string{
+	model.Alarm_STATUS_OPEN,
+	model.Alarm_STATUS_ACKNOWLEDGED,
+	model.Alarm_STATUS_SUPPRESSED,
+	model.Alarm_STATUS_RESOLVED,
+}
+
+func dataSourceNsxtAlarms() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtAlarmsRead,
+
+		Schema: map[string]*schema.Schema{
+			"severity": {
+				Type:        schema.TypeSet,
+				Description: "Retrieve only alarms with these severities",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(alarmSeverityValues, false),
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Description:  "Retrieve only alarms with this status",
+				Optional:     true,
+				Default:      model.Alarm_STATUS_OPEN,
+				ValidateFunc: validation.StringInSlice(alarmStatusValues, false),
+			},
+			"feature_name": {
+				Type:        schema.TypeString,
+				Description: "Retrieve only alarms of this feature",
+				Optional:    true,
+			},
+			"event_type": {
+				Type:        schema.TypeString,
+				Description: "Retrieve only alarms of this event type",
+				Optional:    true,
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Description: "Retrieve only alarms reported by this node",
+				Optional:    true,
+			},
+			"alarm_count": {
+				Type:        schema.TypeInt,
+				Description: "Number of alarms matching the filters",
+				Computed:    true,
+			},
+			"alarm": {
+				Type:        schema.TypeList,
+				Description: "Alarms matching the filters",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":                 getDataSourceStringSchema("ID of the alarm"),
+						"feature_name":       getDataSourceStringSchema("Feature that reported the alarm"),
+						"event_type":         getDataSourceStringSchema("Event type of the alarm"),
+						"severity":           getDataSourceStringSchema("Severity of the alarm"),
+						"status":             getDataSourceStringSchema("Status of the alarm"),
+						"node_id":            getDataSourceStringSchema("ID of the node that reported the alarm"),
+						"node_display_name":  getDataSourceStringSchema("Display name of the node that reported the alarm"),
+						"node_resource_type": getDataSourceStringSchema("Resource type of the node that reported the alarm"),
+						"entity_id":          getDataSourceStringSchema("ID of the entity the alarm is raised for"),
+						"summary":            getDataSourceStringSchema("Summary of the alarm"),
+						"description":        getDataSourceStringSchema("Description of the alarm"),
+						"recommended_action": getDataSourceStringSchema("Recommended action to resolve the alarm"),
+						"last_reported_time": {
+							Type:        schema.TypeInt,
+							Description: "Time the alarm was last reported, in epoch milliseconds",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// listNsxtAlarms retrieves all pages of alarms matching the filters
+func listNsxtAlarms(connector client.Connector, status string, featureName string, eventType string, nodeID string) ([]model.Alarm, error) {
+	client := nsx.NewAlarmsClient(connector)
+	var results []model.Alarm
+	var cursor *string
+	var statusPtr, featurePtr, eventTypePtr, nodePtr *string
+	if status != "" {
+		statusPtr = &status
+	}
+	if featureName != "" {
+		featurePtr = &featureName
+	}
+	if eventType != "" {
+		eventTypePtr = &eventType
+	}
+	if nodeID != "" {
+		nodePtr = &nodeID
+	}
+
+	for {
+		alarms, err := client.List(nil, nil, cursor, nil, eventTypePtr, featurePtr, nil, nil, nodePtr, nil, nil, nil, nil, nil, nil, nil, statusPtr, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, alarms.Results...)
+		if alarms.Cursor == nil || *alarms.Cursor == "" || len(alarms.Results) == 0 {
+			return results, nil
+		}
+		cursor = alarms.Cursor
+	}
+}
+
+func setNsxtAlarmsInSchema(d *schema.ResourceData, alarms []model.Alarm, severities []string) error {
+	var alarmList []interface{}
+	for _, alarm := range alarms {
+		if len(severities) > 0 && (alarm.Severity == nil || !stringInList(*alarm.Severity, severities)) {
+			continue
+		}
+		elem := make(map[string]interface{})
+		elem["id"] = alarm.Id
+		elem["feature_name"] = alarm.FeatureName
+		elem["event_type"] = alarm.EventType
+		elem["severity"] = alarm.Severity
+		elem["status"] = alarm.Status
+		elem["node_id"] = alarm.NodeId
+		elem["node_display_name"] = alarm.NodeDisplayName
+		elem["node_resource_type"] = alarm.NodeResourceType
+		elem["entity_id"] = alarm.EntityId
+		elem["summary"] = alarm.Summary
+		elem["description"] = alarm.Description
+		elem["recommended_action"] = alarm.RecommendedAction
+		elem["last_reported_time"] = alarm.LastReportedTime
+		alarmList = append(alarmList, elem)
+	}
+
+	d.Set("alarm_count", len(alarmList))
+	return d.Set("alarm", alarmList)
+}
+
+func dataSourceNsxtAlarmsRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	severities := interface2StringList(d.Get("severity").(*schema.Set).List())
+
+	alarms, err := listNsxtAlarms(connector, d.Get("status").(string), d.Get("feature_name").(string), d.Get("event_type").(string), d.Get("node_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error listing alarms: %v", err)
+	}
+
+	d.SetId(newUUID())
+	return setNsxtAlarmsInSchema(d, alarms, severities)
+}
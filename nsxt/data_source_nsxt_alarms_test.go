@@ -0,0 +1,76 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtAlarms_basic(t *testing.T) {
+	testResourceName := "data.nsxt_alarms.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nsxt_alarms" "test" {
+  severity = ["CRITICAL", "HIGH"]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "alarm_count"),
+					resource.TestCheckResourceAttr(testResourceName, "status", "OPEN"),
+				),
+			},
+		},
+	})
+}
+
+func TestListNsxtAlarms(t *testing.T) {
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/alarms" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("status") != "OPEN" || r.URL.Query().Get("feature_name") != "edge_health" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Query().Get("cursor") == "" {
+			writeTestJSONResponse(w, http.StatusOK, `{"cursor": "1", "results": [
+			  {"id": "a1", "feature_name": "edge_health", "event_type": "edge_cpu_usage_very_high", "severity": "CRITICAL", "status": "OPEN", "node_id": "edge1", "last_reported_time": 1700000000000}]}`)
+			return
+		}
+		writeTestJSONResponse(w, http.StatusOK, `{"results": [
+		  {"id": "a2", "feature_name": "edge_health", "event_type": "edge_disk_usage_high", "severity": "MEDIUM", "status": "OPEN", "node_id": "edge2"}]}`)
+	})
+
+	alarms, err := listNsxtAlarms(connector, "OPEN", "edge_health", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alarms) != 2 {
+		t.Fatalf("Expected 2 alarms, got %d", len(alarms))
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtAlarms().Schema, map[string]interface{}{})
+	if err := setNsxtAlarmsInSchema(d, alarms, []string{"CRITICAL", "HIGH"}); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("alarm_count").(int) != 1 || d.Get("alarm.0.id").(string) != "a1" || d.Get("alarm.0.last_reported_time").(int) != 1700000000000 {
+		t.Errorf("Unexpected alarms %v", d.Get("alarm"))
+	}
+
+	if err := setNsxtAlarmsInSchema(d, alarms, nil); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("alarm_count").(int) != 2 {
+		t.Errorf("Expected 2 alarms, got %d", d.Get("alarm_count").(int))
+	}
+}
@@ -0,0 +1,70 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric"
+)
+
+func dataSourceNsxtContainerClusters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtContainerClustersRead,
+
+		Schema: getInventoryDataSourceListSchema(map[string]*schema.Schema{
+			"cluster_type": {
+				Type:        schema.TypeString,
+				Description: "Type of container clusters to retrieve",
+				Optional:    true,
+			},
+		}, map[string]*schema.Schema{
+			"cluster_type":   getDataSourceStringSchema("Type of the container cluster"),
+			"cni_type":       getDataSourceStringSchema("Type of the container network interface"),
+			"network_status": getDataSourceStringSchema("Network status of the container cluster"),
+		}),
+	}
+}
+
+func listNsxtContainerClusters(connector client.Connector, clusterType *string) ([]inventoryListItem, error) {
+	client := fabric.NewContainerClustersClient(connector)
+	var results []inventoryListItem
+	var cursor *string
+	for {
+		clusters, err := client.List(clusterType, cursor, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		for _, cluster := range clusters.Results {
+			results = append(results, inventoryListItem{
+				id:          cluster.ExternalId,
+				displayName: cluster.DisplayName,
+				description: cluster.Description,
+				tags:        cluster.Tags,
+				attributes: map[string]interface{}{
+					"cluster_type":   cluster.ClusterType,
+					"cni_type":       cluster.CniType,
+					"network_status": cluster.NetworkStatus,
+				},
+			})
+		}
+		if clusters.Cursor == nil || *clusters.Cursor == "" || len(clusters.Results) == 0 {
+			return results, nil
+		}
+		cursor = clusters.Cursor
+	}
+}
+
+func dataSourceNsxtContainerClustersRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	clusters, err := listNsxtContainerClusters(connector, getOptionalStringPtr(d, "cluster_type"))
+	if err != nil {
+		return fmt.Errorf("Error listing container clusters: %v", err)
+	}
+
+	return inventoryDataSourceListSet(d, clusters)
+}
@@ -0,0 +1,75 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric"
+)
+
+func dataSourceNsxtContainerIngressPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtContainerIngressPoliciesRead,
+
+		Schema: getInventoryDataSourceListSchema(map[string]*schema.Schema{
+			"container_cluster_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the container cluster to retrieve ingress policies from",
+				Optional:    true,
+			},
+			"container_namespace_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the container namespace to retrieve ingress policies from",
+				Optional:    true,
+			},
+		}, map[string]*schema.Schema{
+			"container_cluster_id":   getDataSourceStringSchema("ID of the container cluster"),
+			"container_namespace_id": getDataSourceStringSchema("ID of the container namespace"),
+			"network_status":         getDataSourceStringSchema("Network status of the ingress policy"),
+		}),
+	}
+}
+
+func listNsxtContainerIngressPolicies(connector client.Connector, clusterID *string, namespaceID *string) ([]inventoryListItem, error) {
+	client := fabric.NewContainerIngressPoliciesClient(connector)
+	var results []inventoryListItem
+	var cursor *string
+	for {
+		policies, err := client.List(clusterID, namespaceID, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		for _, policy := range policies.Results {
+			results = append(results, inventoryListItem{
+				id:          policy.ExternalId,
+				displayName: policy.DisplayName,
+				description: policy.Description,
+				tags:        policy.Tags,
+				attributes: map[string]interface{}{
+					"container_cluster_id":   policy.ContainerClusterId,
+					"container_namespace_id": policy.ContainerProjectId,
+					"network_status":         policy.NetworkStatus,
+				},
+			})
+		}
+		if policies.Cursor == nil || *policies.Cursor == "" || len(policies.Results) == 0 {
+			return results, nil
+		}
+		cursor = policies.Cursor
+	}
+}
+
+func dataSourceNsxtContainerIngressPoliciesRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	policies, err := listNsxtContainerIngressPolicies(connector, getOptionalStringPtr(d, "container_cluster_id"), getOptionalStringPtr(d, "container_namespace_id"))
+	if err != nil {
+		return fmt.Errorf("Error listing container ingress policies: %v", err)
+	}
+
+	return inventoryDataSourceListSet(d, policies)
+}
@@ -0,0 +1,68 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric"
+)
+
+func dataSourceNsxtContainerNamespaces() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtContainerNamespacesRead,
+
+		Schema: getInventoryDataSourceListSchema(map[string]*schema.Schema{
+			"container_cluster_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the container cluster to retrieve namespaces from",
+				Optional:    true,
+			},
+		}, map[string]*schema.Schema{
+			"container_cluster_id": getDataSourceStringSchema("ID of the container cluster"),
+			"network_status":       getDataSourceStringSchema("Network status of the namespace"),
+		}),
+	}
+}
+
+func listNsxtContainerNamespaces(connector client.Connector, clusterID *string) ([]inventoryListItem, error) {
+	client := fabric.NewContainerProjectsClient(connector)
+	var results []inventoryListItem
+	var cursor *string
+	for {
+		projects, err := client.List(clusterID, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		for _, project := range projects.Results {
+			results = append(results, inventoryListItem{
+				id:          project.ExternalId,
+				displayName: project.DisplayName,
+				description: project.Description,
+				tags:        project.Tags,
+				attributes: map[string]interface{}{
+					"container_cluster_id": project.ContainerClusterId,
+					"network_status":       project.NetworkStatus,
+				},
+			})
+		}
+		if projects.Cursor == nil || *projects.Cursor == "" || len(projects.Results) == 0 {
+			return results, nil
+		}
+		cursor = projects.Cursor
+	}
+}
+
+func dataSourceNsxtContainerNamespacesRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	namespaces, err := listNsxtContainerNamespaces(connector, getOptionalStringPtr(d, "container_cluster_id"))
+	if err != nil {
+		return fmt.Errorf("Error listing container namespaces: %v", err)
+	}
+
+	return inventoryDataSourceListSet(d, namespaces)
+}
@@ -0,0 +1,169 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/transport_nodes"
+)
+
+func getTransportNodeStatusSummarySchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"up_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"degraded_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"down_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"unknown_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtManagerClusterStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtManagerClusterStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id":             getDataSourceStringSchema("ID of the NSX cluster"),
+			"overall_status":         getDataSourceStringSchema("Overall status of the NSX cluster"),
+			"mgmt_cluster_status":    getDataSourceStringSchema("Status of the management cluster"),
+			"control_cluster_status": getDataSourceStringSchema("Status of the control cluster"),
+			"online_nodes": {
+				Type:        schema.TypeInt,
+				Description: "Number of online management nodes",
+				Computed:    true,
+			},
+			"offline_nodes": {
+				Type:        schema.TypeInt,
+				Description: "Number of offline management nodes",
+				Computed:    true,
+			},
+			"group": {
+				Type:        schema.TypeList,
+				Description: "Status of cluster service groups",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_type":   getDataSourceStringSchema("Type of the service group"),
+						"group_status": getDataSourceStringSchema("Status of the service group"),
+						"member": {
+							Type:        schema.TypeList,
+							Description: "Members of the service group",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fqdn":   getDataSourceStringSchema("FQDN of the member"),
+									"ip":     getDataSourceStringSchema("IP address of the member"),
+									"uuid":   getDataSourceStringSchema("UUID of the member"),
+									"status": getDataSourceStringSchema("Status of the member"),
+								},
+							},
+						},
+					},
+				},
+			},
+			"host_transport_nodes": getTransportNodeStatusSummarySchema("Status summary of host transport nodes"),
+			"edge_transport_nodes": getTransportNodeStatusSummarySchema("Status summary of edge transport nodes"),
+		},
+	}
+}
+
+func getTransportNodeStatusSummary(connector client.Connector, nodeType string) ([]interface{}, error) {
+	client := transport_nodes.NewStatusClient(connector)
+	status, err := client.Get(&nodeType)
+	if err != nil {
+		return nil, err
+	}
+	elem := make(map[string]interface{})
+	elem["up_count"] = status.UpCount
+	elem["degraded_count"] = status.DegradedCount
+	elem["down_count"] = status.DownCount
+	elem["unknown_count"] = status.UnknownCount
+	return []interface{}{elem}, nil
+}
+
+func setNsxtManagerClusterStatusInSchema(d *schema.ResourceData, status model.ClusterStatus) {
+	d.Set("cluster_id", status.ClusterId)
+	if status.MgmtClusterStatus != nil {
+		d.Set("mgmt_cluster_status", status.MgmtClusterStatus.Status)
+		d.Set("online_nodes", len(status.MgmtClusterStatus.OnlineNodes))
+		d.Set("offline_nodes", len(status.MgmtClusterStatus.OfflineNodes))
+	}
+	if status.ControlClusterStatus != nil {
+		d.Set("control_cluster_status", status.ControlClusterStatus.Status)
+	}
+
+	var groups []interface{}
+	if status.DetailedClusterStatus != nil {
+		d.Set("overall_status", status.DetailedClusterStatus.OverallStatus)
+		for _, group := range status.DetailedClusterStatus.Groups {
+			var members []interface{}
+			for _, member := range group.Members {
+				members = append(members, map[string]interface{}{
+					"fqdn":   member.MemberFqdn,
+					"ip":     member.MemberIp,
+					"uuid":   member.MemberUuid,
+					"status": member.MemberStatus,
+				})
+			}
+			groups = append(groups, map[string]interface{}{
+				"group_type":   group.GroupType,
+				"group_status": group.GroupStatus,
+				"member":       members,
+			})
+		}
+	}
+	d.Set("group", groups)
+}
+
+func dataSourceNsxtManagerClusterStatusRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := cluster.NewStatusClient(connector)
+
+	status, err := client.Get()
+	if err != nil {
+		return fmt.Errorf("Error reading cluster status: %v", err)
+	}
+	setNsxtManagerClusterStatusInSchema(d, status)
+
+	hostSummary, err := getTransportNodeStatusSummary(connector, transport_nodes.Status_GET_NODE_TYPE_HOST)
+	if err != nil {
+		return fmt.Errorf("Error reading host transport node status: %v", err)
+	}
+	d.Set("host_transport_nodes", hostSummary)
+
+	edgeSummary, err := getTransportNodeStatusSummary(connector, transport_nodes.Status_GET_NODE_TYPE_EDGE)
+	if err != nil {
+		return fmt.Errorf("Error reading edge transport node status: %v", err)
+	}
+	d.Set("edge_transport_nodes", edgeSummary)
+
+	if status.ClusterId != nil {
+		d.SetId(*status.ClusterId)
+	} else {
+		d.SetId(newUUID())
+	}
+	return nil
+}
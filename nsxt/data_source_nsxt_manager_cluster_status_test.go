@@ -0,0 +1,78 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster"
+)
+
+func TestAccDataSourceNsxtManagerClusterStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_manager_cluster_status.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nsxt_manager_cluster_status" "test" {
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "cluster_id"),
+					resource.TestCheckResourceAttr(testResourceName, "overall_status", "STABLE"),
+					resource.TestCheckResourceAttr(testResourceName, "host_transport_nodes.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "edge_transport_nodes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestNsxtManagerClusterStatus(t *testing.T) {
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/cluster/status":
+			writeTestJSONResponse(w, http.StatusOK, `{"cluster_id": "c1",
+			  "mgmt_cluster_status": {"status": "STABLE", "online_nodes": [{"uuid": "n1"}, {"uuid": "n2"}], "offline_nodes": [{"uuid": "n3"}]},
+			  "control_cluster_status": {"status": "STABLE"},
+			  "detailed_cluster_status": {"overall_status": "DEGRADED", "groups": [
+			    {"group_type": "MANAGER", "group_status": "DEGRADED", "members": [{"member_fqdn": "nsx1", "member_status": "UP"}, {"member_fqdn": "nsx3", "member_status": "DOWN"}]}]}}`)
+		case "/api/v1/transport-nodes/status":
+			if r.URL.Query().Get("node_type") == "EDGE" {
+				writeTestJSONResponse(w, http.StatusOK, `{"up_count": 1, "degraded_count": 1}`)
+				return
+			}
+			writeTestJSONResponse(w, http.StatusOK, `{"up_count": 4}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	status, err := cluster.NewStatusClient(connector).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtManagerClusterStatus().Schema, map[string]interface{}{})
+	setNsxtManagerClusterStatusInSchema(d, status)
+	if d.Get("overall_status").(string) != "DEGRADED" || d.Get("online_nodes").(int) != 2 || d.Get("offline_nodes").(int) != 1 {
+		t.Errorf("Unexpected cluster status: overall %v, online %v, offline %v", d.Get("overall_status"), d.Get("online_nodes"), d.Get("offline_nodes"))
+	}
+	if d.Get("group.0.member.1.status").(string) != "DOWN" {
+		t.Errorf("Unexpected group status %v", d.Get("group"))
+	}
+
+	summary, err := getTransportNodeStatusSummary(connector, "EDGE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Set("edge_transport_nodes", summary)
+	if d.Get("edge_transport_nodes.0.degraded_count").(int) != 1 || d.Get("edge_transport_nodes.0.down_count").(int) != 0 {
+		t.Errorf("Unexpected edge status summary %v", d.Get("edge_transport_nodes"))
+	}
+}
@@ -0,0 +1,75 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric"
+)
+
+func dataSourceNsxtPhysicalServers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPhysicalServersRead,
+
+		Schema: getInventoryDataSourceListSchema(map[string]*schema.Schema{
+			"os_type": {
+				Type:        schema.TypeString,
+				Description: "Operating system type of physical servers to retrieve",
+				Optional:    true,
+			},
+		}, map[string]*schema.Schema{
+			"os_type": getDataSourceStringSchema("Operating system type of the physical server"),
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "IP addresses of the physical server",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func listNsxtPhysicalServers(connector client.Connector, osType *string) ([]inventoryListItem, error) {
+	client := fabric.NewPhysicalServersClient(connector)
+	var results []inventoryListItem
+	var cursor *string
+	for {
+		servers, err := client.List(cursor, nil, nil, osType, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		for _, server := range servers.Results {
+			results = append(results, inventoryListItem{
+				id:          server.Id,
+				displayName: server.DisplayName,
+				description: server.Description,
+				tags:        server.Tags,
+				attributes: map[string]interface{}{
+					"os_type":      server.OsType,
+					"ip_addresses": server.IpAddresses,
+				},
+			})
+		}
+		if servers.Cursor == nil || *servers.Cursor == "" || len(servers.Results) == 0 {
+			return results, nil
+		}
+		cursor = servers.Cursor
+	}
+}
+
+func dataSourceNsxtPhysicalServersRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	servers, err := listNsxtPhysicalServers(connector, getOptionalStringPtr(d, "os_type"))
+	if err != nil {
+		return fmt.Errorf("Error listing physical servers: %v", err)
+	}
+
+	return inventoryDataSourceListSet(d, servers)
+}
@@ -0,0 +1,146 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyBgpNeighborStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyBgpNeighborStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"bgp_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of BGP routing config of Tier0 gateway",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"neighbor_address": {
+				Type:         schema.TypeString,
+				Description:  "Retrieve status only for neighbor with this address",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"edge_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of edge node to retrieve status from",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"enforcement_point_path": {
+				Type:        schema.TypeString,
+				Description: "Enforcement point to retrieve status from",
+				Optional:    true,
+			},
+			"neighbor": {
+				Type:        schema.TypeList,
+				Description: "Status per BGP neighbor and edge node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"neighbor_address": {
+							Type:        schema.TypeString,
+							Description: "Address of BGP neighbor",
+							Computed:    true,
+						},
+						"source_address": {
+							Type:        schema.TypeString,
+							Description: "Local address of the BGP session",
+							Computed:    true,
+						},
+						"remote_as_number": {
+							Type:        schema.TypeString,
+							Description: "AS number of BGP neighbor",
+							Computed:    true,
+						},
+						"connection_state": {
+							Type:        schema.TypeString,
+							Description: "Current state of the BGP session",
+							Computed:    true,
+						},
+						"edge_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of edge node the session is established from",
+							Computed:    true,
+						},
+						"time_since_established":       getComputedIntSchema("Time in ms since the session was established"),
+						"established_connection_count": getComputedIntSchema("Number of times the session was established"),
+						"connection_drop_count":        getComputedIntSchema("Number of times the session was dropped"),
+						"total_in_prefix_count":        getComputedIntSchema("Number of prefixes received from the neighbor"),
+						"total_out_prefix_count":       getComputedIntSchema("Number of prefixes advertised to the neighbor"),
+						"messages_received":            getComputedIntSchema("Number of messages received from the neighbor"),
+						"messages_sent":                getComputedIntSchema("Number of messages sent to the neighbor"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// listPolicyBgpNeighborStatus retrieves status of all BGP neighbors of a gateway by BGP config path
+func listPolicyBgpNeighborStatus(connector client.Connector, isGlobalManager bool, bgpPath string, query map[string]string) (model.PolicyBgpNeighborsStatusListResult, error) {
+	statusPath := strings.TrimSuffix(bgpPath, "/") + "/neighbors/status"
+	result, err := policyGenericGetModel(connector, isGlobalManager, statusPath, query, model.PolicyBgpNeighborsStatusListResultBindingType())
+	if err != nil {
+		return model.PolicyBgpNeighborsStatusListResult{}, err
+	}
+	return result.(model.PolicyBgpNeighborsStatusListResult), nil
+}
+
+func setPolicyBgpNeighborStatusInSchema(d *schema.ResourceData, status model.PolicyBgpNeighborsStatusListResult, neighborAddress string) error {
+	var neighbors []interface{}
+	for _, neighbor := range status.Results {
+		if neighborAddress != "" && (neighbor.NeighborAddress == nil || *neighbor.NeighborAddress != neighborAddress) {
+			continue
+		}
+		elem := make(map[string]interface{})
+		elem["neighbor_address"] = neighbor.NeighborAddress
+		elem["source_address"] = neighbor.SourceAddress
+		elem["remote_as_number"] = neighbor.RemoteAsNumber
+		elem["connection_state"] = neighbor.ConnectionState
+		elem["edge_path"] = neighbor.EdgePath
+		elem["time_since_established"] = neighbor.TimeSinceEstablished
+		elem["established_connection_count"] = neighbor.EstablishedConnectionCount
+		elem["connection_drop_count"] = neighbor.ConnectionDropCount
+		elem["total_in_prefix_count"] = neighbor.TotalInPrefixCount
+		elem["total_out_prefix_count"] = neighbor.TotalOutPrefixCount
+		elem["messages_received"] = neighbor.MessagesReceived
+		elem["messages_sent"] = neighbor.MessagesSent
+		neighbors = append(neighbors, elem)
+	}
+
+	return d.Set("neighbor", neighbors)
+}
+
+func dataSourceNsxtPolicyBgpNeighborStatusRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	bgpPath := d.Get("bgp_path").(string)
+	isGlobalManager := isPolicyGlobalManager(m)
+
+	if !strings.HasSuffix(strings.TrimSuffix(bgpPath, "/"), "/bgp") {
+		return fmt.Errorf("BGP path %s is expected to point to BGP config of Tier0 gateway", bgpPath)
+	}
+
+	query := make(map[string]string)
+	for _, param := range []string{"edge_path", "enforcement_point_path"} {
+		if value := d.Get(param).(string); value != "" {
+			query[param] = value
+		}
+	}
+
+	status, err := listPolicyBgpNeighborStatus(connector, isGlobalManager, bgpPath, query)
+	if err != nil {
+		return handleDataSourceReadError(d, "BGP Neighbor Status", bgpPath, err)
+	}
+
+	d.SetId(bgpPath + "/neighbors/status")
+	return setPolicyBgpNeighborStatusInSchema(d, status, d.Get("neighbor_address").(string))
+}
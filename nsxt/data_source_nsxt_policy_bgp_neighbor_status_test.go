@@ -0,0 +1,77 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtPolicyBgpNeighborStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_bgp_neighbor_status.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_CLUSTER")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyBgpNeighborStatusTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "neighbor.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyBgpNeighborStatusTemplate() string {
+	return testAccNsxtPolicyBgpNeighborMinimalistic() + `
+data "nsxt_policy_bgp_neighbor_status" "test" {
+  bgp_path         = nsxt_policy_tier0_gateway.test.bgp_config.0.path
+  neighbor_address = nsxt_policy_bgp_neighbor.test.neighbor_address
+}`
+}
+
+func TestPolicyBgpNeighborStatus(t *testing.T) {
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/policy/api/v1/infra/tier-0s/t0/locale-services/default/bgp/neighbors/status" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeTestJSONResponse(w, http.StatusOK, `{"results": [
+		  {"neighbor_address": "192.168.0.1", "remote_as_number": "65001", "connection_state": "ESTABLISHED", "total_in_prefix_count": 7},
+		  {"neighbor_address": "192.168.0.2", "remote_as_number": "65002", "connection_state": "ACTIVE"}
+		]}`)
+	})
+
+	status, err := listPolicyBgpNeighborStatus(connector, false, "/infra/tier-0s/t0/locale-services/default/bgp", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtPolicyBgpNeighborStatus().Schema, map[string]interface{}{})
+	if err := setPolicyBgpNeighborStatusInSchema(d, status, "192.168.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("neighbor.#").(int) != 1 {
+		t.Fatalf("Expected 1 neighbor, got %d", d.Get("neighbor.#").(int))
+	}
+	if d.Get("neighbor.0.connection_state").(string) != "ESTABLISHED" || d.Get("neighbor.0.total_in_prefix_count").(int) != 7 {
+		t.Errorf("Unexpected neighbor status %v", d.Get("neighbor"))
+	}
+
+	if err := setPolicyBgpNeighborStatusInSchema(d, status, ""); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("neighbor.#").(int) != 2 {
+		t.Errorf("Expected 2 neighbors, got %d", d.Get("neighbor.#").(int))
+	}
+}
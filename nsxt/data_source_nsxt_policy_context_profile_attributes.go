@@ -0,0 +1,237 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	cont_prof "github.com/vmware/terraform-provider-nsxt/api/infra/context_profiles"
+	custom_attr "github.com/vmware/terraform-provider-nsxt/api/infra/context_profiles/custom_attributes"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+const contextProfileAttributeSourceAll = "ALL"
+
+var contextProfileAttributeSourceValues = []string{
+	contextProfileAttributeSourceAll,
+	model.PolicyCustomAttributes_ATTRIBUTE_SOURCE_SYSTEM,
+	model.PolicyCustomAttributes_ATTRIBUTE_SOURCE_CUSTOM,
+}
+
+func dataSourceNsxtPolicyContextProfileAttributes() *schema.Resource {
+	var keys []string
+	for key := range attributeKeyMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyContextProfileAttributesRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:         schema.TypeString,
+				Description:  "Attribute key",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(keys, false),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Description:  "Source of attributes to retrieve",
+				Optional:     true,
+				Default:      contextProfileAttributeSourceAll,
+				ValidateFunc: validation.StringInSlice(contextProfileAttributeSourceValues, false),
+			},
+			"context": getContextSchema(false, false, false),
+			"values": {
+				Type:        schema.TypeList,
+				Description: "Sorted list of all available attribute values",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"attribute": {
+				Type:        schema.TypeList,
+				Description: "Available attributes with details",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:        schema.TypeString,
+							Description: "Attribute value",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of the attribute",
+							Computed:    true,
+						},
+						"source": {
+							Type:        schema.TypeString,
+							Description: "Source of the attribute",
+							Computed:    true,
+						},
+						"is_alg_type": {
+							Type:        schema.TypeBool,
+							Description: "Whether the attribute is an ALG type",
+							Computed:    true,
+						},
+						"sub_attribute": {
+							Type:        schema.TypeList,
+							Description: "Available sub-attributes",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Description: "Sub-attribute key",
+										Computed:    true,
+									},
+									"values": {
+										Type:        schema.TypeList,
+										Description: "Available sub-attribute values",
+										Computed:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type policyContextProfileAttribute struct {
+	value     string
+	source    string
+	attribute model.PolicyAttributes
+}
+
+// listPolicyContextProfileAttributes returns attributes with given key, sorted by value.
+// Custom attributes take precedence over system attributes with same value.
+func listPolicyContextProfileAttributes(context utl.SessionContext, connector client.Connector, attributeKey string, source string) ([]policyContextProfileAttribute, error) {
+	includeMarkForDeleteObjects := false
+	attributes := make(map[string]policyContextProfileAttribute)
+	// collect reads all pages of attribute list, and stores values of the requested attribute key
+	collect := func(listFunc func(cursor *string) (model.PolicyContextProfileListResult, error), attrSource string) error {
+		var cursor *string
+		total := 0
+		for {
+			result, err := listFunc(cursor)
+			if err != nil {
+				return err
+			}
+			for _, profile := range result.Results {
+				for _, attribute := range profile.Attributes {
+					if attribute.Key == nil || *attribute.Key != attributeKey {
+						continue
+					}
+					for _, value := range attribute.Value {
+						attributes[value] = policyContextProfileAttribute{value: value, source: attrSource, attribute: attribute}
+					}
+				}
+			}
+			total += len(result.Results)
+			cursor = result.Cursor
+			if cursor == nil || *cursor == "" || len(result.Results) == 0 || (result.ResultCount != nil && int64(total) >= *result.ResultCount) {
+				return nil
+			}
+		}
+	}
+
+	if source != model.PolicyCustomAttributes_ATTRIBUTE_SOURCE_CUSTOM {
+		attrSource := model.PolicyCustomAttributes_ATTRIBUTE_SOURCE_SYSTEM
+		client := cont_prof.NewAttributesClient(context, connector)
+		if client == nil {
+			return nil, policyResourceNotSupportedError()
+		}
+		err := collect(func(cursor *string) (model.PolicyContextProfileListResult, error) {
+			return client.List(&attributeKey, &attrSource, cursor, &includeMarkForDeleteObjects, nil, nil, nil, nil)
+		}, attrSource)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if source != model.PolicyCustomAttributes_ATTRIBUTE_SOURCE_SYSTEM {
+		attrSource := model.PolicyCustomAttributes_ATTRIBUTE_SOURCE_CUSTOM
+		client := custom_attr.NewDefaultClient(context, connector)
+		if client == nil {
+			return nil, policyResourceNotSupportedError()
+		}
+		err := collect(func(cursor *string) (model.PolicyContextProfileListResult, error) {
+			return client.List(&attributeKey, &attrSource, cursor, &includeMarkForDeleteObjects, nil, nil, nil, nil)
+		}, attrSource)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var results []policyContextProfileAttribute
+	for _, attribute := range attributes {
+		results = append(results, attribute)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].value < results[j].value
+	})
+	return results, nil
+}
+
+func setPolicyContextProfileAttributesInSchema(d *schema.ResourceData, attributes []policyContextProfileAttribute) error {
+	var values []string
+	var attrList []interface{}
+	for _, attribute := range attributes {
+		values = append(values, attribute.value)
+		elem := make(map[string]interface{})
+		elem["value"] = attribute.value
+		elem["description"] = attribute.attribute.Description
+		elem["source"] = attribute.source
+		elem["is_alg_type"] = attribute.attribute.IsALGType
+		var subAttributes []interface{}
+		for _, subAttribute := range attribute.attribute.SubAttributes {
+			key := ""
+			if subAttribute.Key != nil {
+				key = subAttributeReverseKeyMap[*subAttribute.Key]
+				if key == "" {
+					key = strings.ToLower(*subAttribute.Key)
+				}
+			}
+			subAttributes = append(subAttributes, map[string]interface{}{
+				"key":    key,
+				"values": subAttribute.Value,
+			})
+		}
+		elem["sub_attribute"] = subAttributes
+		attrList = append(attrList, elem)
+	}
+
+	d.Set("values", values)
+	return d.Set("attribute", attrList)
+}
+
+func dataSourceNsxtPolicyContextProfileAttributesRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	key := d.Get("key").(string)
+	source := d.Get("source").(string)
+
+	attributes, err := listPolicyContextProfileAttributes(getSessionContext(d, m), connector, attributeKeyMap[key], source)
+	if err != nil {
+		return fmt.Errorf("Error listing context profile attributes with key %s: %v", key, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", key, source))
+	return setPolicyContextProfileAttributesInSchema(d, attributes)
+}
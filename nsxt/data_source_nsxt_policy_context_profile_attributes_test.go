@@ -0,0 +1,103 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func TestAccDataSourceNsxtPolicyContextProfileAttributes_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_context_profile_attributes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyContextProfileAttributesTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "values.#"),
+					resource.TestCheckTypeSetElemAttr(testResourceName, "values.*", "SSL"),
+					resource.TestCheckResourceAttr(testResourceName, "attribute.0.source", "SYSTEM"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyContextProfileAttributesTemplate() string {
+	return `
+data "nsxt_policy_context_profile_attributes" "test" {
+  key    = "app_id"
+  source = "SYSTEM"
+}`
+}
+
+func TestListPolicyContextProfileAttributes(t *testing.T) {
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("attribute_key") != "APP_ID" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/policy/api/v1/infra/context-profiles/attributes":
+			// system attributes are returned in two pages
+			if r.URL.Query().Get("cursor") == "" {
+				writeTestJSONResponse(w, http.StatusOK, `{"result_count": 2, "cursor": "page2", "results": [{"attributes": [
+				  {"key": "APP_ID", "value": ["SSL"], "description": "Secure Sockets Layer",
+				   "sub_attributes": [{"key": "TLS_VERSION", "value": ["TLS_V12", "TLS_V13"]}]}]}]}`)
+			} else {
+				writeTestJSONResponse(w, http.StatusOK, `{"result_count": 2, "results": [{"attributes": [{"key": "APP_ID", "value": ["HTTP", "DNS"]}]}]}`)
+			}
+		case "/policy/api/v1/infra/context-profiles/custom-attributes/default":
+			writeTestJSONResponse(w, http.StatusOK, `{"result_count": 1, "results": [{"attributes": [{"key": "APP_ID", "value": ["MYAPP"]}]}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	context := utl.SessionContext{ClientType: utl.Local}
+
+	attributes, err := listPolicyContextProfileAttributes(context, connector, "APP_ID", contextProfileAttributeSourceAll)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtPolicyContextProfileAttributes().Schema, map[string]interface{}{})
+	if err := setPolicyContextProfileAttributesInSchema(d, attributes); err != nil {
+		t.Fatal(err)
+	}
+	values := interface2StringList(d.Get("values").([]interface{}))
+	expected := []string{"DNS", "HTTP", "MYAPP", "SSL"}
+	if len(values) != len(expected) {
+		t.Fatalf("Expected values %v, got %v", expected, values)
+	}
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Errorf("Expected values %v, got %v", expected, values)
+		}
+	}
+	if d.Get("attribute.2.source").(string) != "CUSTOM" || d.Get("attribute.3.source").(string) != "SYSTEM" {
+		t.Errorf("Unexpected attribute sources %v", d.Get("attribute"))
+	}
+	if d.Get("attribute.3.sub_attribute.0.key").(string) != "tls_version" || d.Get("attribute.3.sub_attribute.0.values.#").(int) != 2 {
+		t.Errorf("Unexpected sub attributes %v", d.Get("attribute.3.sub_attribute"))
+	}
+
+	attributes, err = listPolicyContextProfileAttributes(context, connector, "APP_ID", "CUSTOM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attributes) != 1 || attributes[0].value != "MYAPP" {
+		t.Errorf("Unexpected custom attributes %v", attributes)
+	}
+}
@@ -15,6 +15,8 @@ func dataSourceNsxtPolicyDistributedVlanConnection() *schema.Resource {
 			"id":           getDataSourceIDSchema(),
 			"path":         getPathSchema(),
 			"display_name": getDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getPolicySearchTagFilterSchema(),
 			"description":  getDescriptionSchema(),
 		},
 	}
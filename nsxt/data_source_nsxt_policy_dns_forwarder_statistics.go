@@ -0,0 +1,325 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t0_dns_forwarder "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/dns_forwarder"
+	t1_dns_forwarder "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/dns_forwarder"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_t1_dns_forwarder "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/tier_1s/dns_forwarder"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func getDNSForwarderZoneStatisticsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"domain_names": {
+					Type:        schema.TypeList,
+					Description: "Domain names of the forwarder zone",
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"upstream_server": {
+					Type:        schema.TypeList,
+					Description: "Statistics per upstream server",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"address": {
+								Type:        schema.TypeString,
+								Description: "IP address of the upstream server",
+								Computed:    true,
+							},
+							"queries_succeeded": getComputedIntSchema("Number of queries forwarded successfully"),
+							"queries_failed":    getComputedIntSchema("Number of queries failed to forward"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyDNSForwarderStatistics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyDNSForwarderStatisticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"gateway_path": getPolicyPathSchema(false, false, "Policy path of Tier0 or Tier1 gateway"),
+			"context":      getContextSchema(false, false, true),
+			"enforcement_point_path": {
+				Type:        schema.TypeString,
+				Description: "Enforcement point to retrieve statistics from",
+				Optional:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Runtime status of the DNS forwarder",
+				Computed:    true,
+			},
+			"extra_message": {
+				Type:        schema.TypeString,
+				Description: "Extra message for the runtime status, if available",
+				Computed:    true,
+			},
+			"total_queries":            getComputedIntSchema("Total number of received DNS queries"),
+			"queries_answered_locally": getComputedIntSchema("Number of queries answered from local cache"),
+			"queries_forwarded":        getComputedIntSchema("Number of forwarded DNS queries"),
+			"cached_entries":           getComputedIntSchema("Total number of cached entries"),
+			"configured_cache_size":    getComputedIntSchema("Configured cache size, in KB"),
+			"timestamp":                getComputedIntSchema("Timestamp of the statistics, in ms"),
+			"used_cache": {
+				Type:        schema.TypeList,
+				Description: "Cache usage per transport node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the transport node",
+							Computed:    true,
+						},
+						"cached_entries":  getComputedIntSchema("Number of cached entries"),
+						"used_cache_size": getComputedIntSchema("Used cache size, in KB"),
+					},
+				},
+			},
+			"default_forwarder":     getDNSForwarderZoneStatisticsSchema("Statistics of the default forwarder zone"),
+			"conditional_forwarder": getDNSForwarderZoneStatisticsSchema("Statistics of conditional forwarder zones"),
+		},
+	}
+}
+
+// getPolicyGatewayDNSForwarderStatistics retrieves DNS forwarder statistics of Tier0 or Tier1 gateway.
+// nil is returned if statistics are not yet available on the enforcement point.
+func getPolicyGatewayDNSForwarderStatistics(sessionContext utl.SessionContext, connector client.Connector, parent policyDNSForwarderParent, enforcementPointPath *string) (*model.NsxTDNSForwarderStatistics, error) {
+	var result model.AggregateDNSForwarderStatistics
+	var err error
+	if parent.isT0 {
+		client := t0_dns_forwarder.NewStatisticsClient(connector)
+		result, err = client.Get(parent.gwID, enforcementPointPath)
+	} else if sessionContext.ClientType == utl.Multitenancy {
+		client := project_t1_dns_forwarder.NewStatisticsClient(connector)
+		result, err = client.Get(utl.DefaultOrgID, sessionContext.ProjectID, parent.gwID, enforcementPointPath)
+	} else {
+		client := t1_dns_forwarder.NewStatisticsClient(connector)
+		result, err = client.Get(parent.gwID, enforcementPointPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	converter := bindings.NewTypeConverter()
+	for _, statisticsValue := range result.StatisticsPerEnforcementPoint {
+		statistics, errs := converter.ConvertToGolang(statisticsValue, model.NsxTDNSForwarderStatisticsBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		forwarderStatistics := statistics.(model.NsxTDNSForwarderStatistics)
+		return &forwarderStatistics, nil
+	}
+	return nil, nil
+}
+
+// getPolicyVpcDNSForwarderStatistics retrieves statistics of DNS forwarder serving the VPC.
+// VPC statistics are reported in reduced form, and are converted to gateway statistics model here.
+func getPolicyVpcDNSForwarderStatistics(connector client.Connector, context utl.SessionContext, enforcementPoint string) (*model.NsxTDNSForwarderStatistics, error) {
+	client := vpcs.NewDnsForwarderStatisticsClient(connector)
+	result, err := client.Get(defaultOrgID, context.ProjectID, context.VPCID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, statistics := range result.StatisticsPerEnforcementPoint {
+		if enforcementPoint != "" && (statistics.EnforcementPointPath == nil || *statistics.EnforcementPointPath != enforcementPoint) {
+			continue
+		}
+		forwarderStatistics := model.NsxTDNSForwarderStatistics{
+			EnforcementPointPath:   statistics.EnforcementPointPath,
+			QueriesAnsweredLocally: statistics.QueriesAnsweredLocally,
+		}
+		for _, zone := range statistics.ConditionalForwarderStatistics {
+			zoneStatistics := model.NsxTDNSForwarderZoneStatistics{DomainNames: zone.DomainNames}
+			for _, upstream := range zone.UpstreamStatistics {
+				zoneStatistics.UpstreamStatistics = append(zoneStatistics.UpstreamStatistics, model.NsxtUpstreamServerStatistics{
+					UpstreamServer:   upstream.UpstreamServer,
+					QueriesSucceeded: upstream.QueriesSucceeded,
+					QueriesFailed:    upstream.QueriesFailed,
+				})
+			}
+			forwarderStatistics.ConditionalForwarderStatistics = append(forwarderStatistics.ConditionalForwarderStatistics, zoneStatistics)
+		}
+		return &forwarderStatistics, nil
+	}
+	return nil, nil
+}
+
+func getPolicyDNSForwarderStatusFromAggregate(result model.AggregateDNSForwarderStatus, enforcementPoint string) (*model.NsxTDNSForwarderStatus, error) {
+	converter := bindings.NewTypeConverter()
+	for _, statusValue := range result.StatusPerEnforcementPoint {
+		status, errs := converter.ConvertToGolang(statusValue, model.NsxTDNSForwarderStatusBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		forwarderStatus := status.(model.NsxTDNSForwarderStatus)
+		if enforcementPoint != "" && (forwarderStatus.EnforcementPointPath == nil || *forwarderStatus.EnforcementPointPath != enforcementPoint) {
+			continue
+		}
+		return &forwarderStatus, nil
+	}
+	return nil, nil
+}
+
+// getPolicyGatewayDNSForwarderStatus retrieves runtime status of DNS forwarder of Tier0 or Tier1 gateway
+func getPolicyGatewayDNSForwarderStatus(sessionContext utl.SessionContext, connector client.Connector, parent policyDNSForwarderParent, enforcementPointPath *string) (*model.NsxTDNSForwarderStatus, error) {
+	var result model.AggregateDNSForwarderStatus
+	var err error
+	if parent.isT0 {
+		client := t0_dns_forwarder.NewStatusClient(connector)
+		result, err = client.Get(parent.gwID, enforcementPointPath)
+	} else if sessionContext.ClientType == utl.Multitenancy {
+		client := project_t1_dns_forwarder.NewStatusClient(connector)
+		result, err = client.Get(utl.DefaultOrgID, sessionContext.ProjectID, parent.gwID, enforcementPointPath)
+	} else {
+		client := t1_dns_forwarder.NewStatusClient(connector)
+		result, err = client.Get(parent.gwID, enforcementPointPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return getPolicyDNSForwarderStatusFromAggregate(result, "")
+}
+
+// getPolicyVpcDNSForwarderStatus retrieves runtime status of DNS forwarder serving the VPC.
+// VPC status API does not accept enforcement point, hence the result is filtered here.
+func getPolicyVpcDNSForwarderStatus(connector client.Connector, context utl.SessionContext, enforcementPoint string) (*model.NsxTDNSForwarderStatus, error) {
+	client := vpcs.NewDnsForwarderStatusClient(connector)
+	result, err := client.Get(defaultOrgID, context.ProjectID, context.VPCID)
+	if err != nil {
+		return nil, err
+	}
+	return getPolicyDNSForwarderStatusFromAggregate(result, enforcementPoint)
+}
+
+func getDNSForwarderZoneStatisticsFromModel(zone model.NsxTDNSForwarderZoneStatistics) map[string]interface{} {
+	elem := make(map[string]interface{})
+	elem["domain_names"] = zone.DomainNames
+	var upstreams []interface{}
+	for _, upstream := range zone.UpstreamStatistics {
+		upstreamElem := make(map[string]interface{})
+		upstreamElem["address"] = upstream.UpstreamServer
+		upstreamElem["queries_succeeded"] = upstream.QueriesSucceeded
+		upstreamElem["queries_failed"] = upstream.QueriesFailed
+		upstreams = append(upstreams, upstreamElem)
+	}
+	elem["upstream_server"] = upstreams
+	return elem
+}
+
+func setPolicyDNSForwarderStatisticsInSchema(d *schema.ResourceData, statistics *model.NsxTDNSForwarderStatistics) error {
+	var usedCache []interface{}
+	var defaultForwarder []interface{}
+	var conditionalForwarders []interface{}
+	if statistics != nil {
+		d.Set("total_queries", statistics.TotalQueries)
+		d.Set("queries_answered_locally", statistics.QueriesAnsweredLocally)
+		d.Set("queries_forwarded", statistics.QueriesForwarded)
+		d.Set("cached_entries", statistics.CachedEntries)
+		d.Set("configured_cache_size", statistics.ConfiguredCacheSize)
+		d.Set("timestamp", statistics.Timestamp)
+
+		for _, cache := range statistics.UsedCacheStatistics {
+			elem := make(map[string]interface{})
+			elem["node_id"] = cache.NodeId
+			elem["cached_entries"] = cache.CachedEntries
+			elem["used_cache_size"] = cache.UsedCacheSize
+			usedCache = append(usedCache, elem)
+		}
+		if statistics.DefaultForwarderStatistics != nil {
+			defaultForwarder = append(defaultForwarder, getDNSForwarderZoneStatisticsFromModel(*statistics.DefaultForwarderStatistics))
+		}
+		for _, zone := range statistics.ConditionalForwarderStatistics {
+			conditionalForwarders = append(conditionalForwarders, getDNSForwarderZoneStatisticsFromModel(zone))
+		}
+	}
+
+	d.Set("used_cache", usedCache)
+	d.Set("default_forwarder", defaultForwarder)
+	return d.Set("conditional_forwarder", conditionalForwarders)
+}
+
+func dataSourceNsxtPolicyDNSForwarderStatisticsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+	gwPath := d.Get("gateway_path").(string)
+	enforcementPoint := d.Get("enforcement_point_path").(string)
+
+	var statistics *model.NsxTDNSForwarderStatistics
+	var status *model.NsxTDNSForwarderStatus
+	var err error
+	if context.ClientType == utl.VPC {
+		if gwPath != "" {
+			return fmt.Errorf("gateway_path can not be specified in VPC context")
+		}
+		statistics, err = getPolicyVpcDNSForwarderStatistics(connector, context, enforcementPoint)
+		if err != nil {
+			return handleDataSourceReadError(d, "VPC DNS Forwarder Statistics", context.VPCID, err)
+		}
+		status, err = getPolicyVpcDNSForwarderStatus(connector, context, enforcementPoint)
+		if err != nil {
+			return handleDataSourceReadError(d, "VPC DNS Forwarder Status", context.VPCID, err)
+		}
+		d.SetId(context.VPCID + "/dns-forwarder-statistics")
+	} else {
+		parent, err := parsePolicyDNSForwarderGatewayPath(gwPath)
+		if err != nil {
+			return fmt.Errorf("Either gateway_path or VPC context is required: %v", err)
+		}
+		if parent.isTransitGateway {
+			return fmt.Errorf("Statistics of transit gateway DNS forwarder are available per VPC, please specify VPC context instead of gateway_path")
+		}
+
+		gwContext := getParentContext(d, m, gwPath)
+		if err := parent.validateContext(gwContext); err != nil {
+			return err
+		}
+
+		enforcementPointPath := getOptionalStringPtr(d, "enforcement_point_path")
+		statistics, err = getPolicyGatewayDNSForwarderStatistics(gwContext, connector, parent, enforcementPointPath)
+		if err != nil {
+			return handleDataSourceReadError(d, "Gateway DNS Forwarder Statistics", gwPath, err)
+		}
+		status, err = getPolicyGatewayDNSForwarderStatus(gwContext, connector, parent, enforcementPointPath)
+		if err != nil {
+			return handleDataSourceReadError(d, "Gateway DNS Forwarder Status", gwPath, err)
+		}
+		d.SetId(strings.TrimSuffix(gwPath, "/") + "/dns-forwarder/statistics")
+	}
+
+	if status != nil {
+		d.Set("status", status.Status)
+		d.Set("extra_message", status.ExtraMessage)
+	} else {
+		d.Set("status", "")
+		d.Set("extra_message", "")
+	}
+	return setPolicyDNSForwarderStatisticsInSchema(d, statistics)
+}
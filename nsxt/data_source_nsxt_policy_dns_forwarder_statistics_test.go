@@ -0,0 +1,151 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func TestAccDataSourceNsxtPolicyDNSForwarderStatistics_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_dns_forwarder_statistics.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayDNSForwarderMinimalistic(false, false) + `
+data "nsxt_policy_dns_forwarder_statistics" "test" {
+  gateway_path = nsxt_policy_gateway_dns_forwarder.test.gateway_path
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "status"),
+					resource.TestCheckResourceAttrSet(testResourceName, "configured_cache_size"),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyGatewayDNSForwarderStatistics(t *testing.T) {
+	gwPath := "/orgs/default/projects/p1/infra/tier-1s/t1"
+	context := utl.SessionContext{ProjectID: "p1", ClientType: utl.Multitenancy}
+	parent, err := parsePolicyDNSForwarderGatewayPath(gwPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/policy/api/v1" + gwPath + "/dns-forwarder/statistics":
+			writeTestJSONResponse(w, http.StatusOK, `{"intent_path": "`+gwPath+`/dns-forwarder", "statistics_per_enforcement_point": [
+			  {"resource_type": "NsxTDNSForwarderStatistics", "total_queries": 100, "queries_answered_locally": 60,
+			   "queries_forwarded": 40, "cached_entries": 12, "configured_cache_size": 1024,
+			   "used_cache_statistics": [{"node_id": "n1", "cached_entries": 12, "used_cache_size": 3}],
+			   "default_forwarder_statistics": {"upstream_statistics": [{"upstream_server": "1.1.1.1", "queries_succeeded": 30, "queries_failed": 2}]},
+			   "conditional_forwarder_statistics": [{"domain_names": ["example.org"],
+			     "upstream_statistics": [{"upstream_server": "2.1.1.1", "queries_succeeded": 8}]}]}
+			]}`)
+		case "/policy/api/v1" + gwPath + "/dns-forwarder/status":
+			writeTestJSONResponse(w, http.StatusOK, `{"intent_path": "`+gwPath+`/dns-forwarder", "status_per_enforcement_point": [
+			  {"resource_type": "NsxTDNSForwarderStatus", "status": "NO_BACKUP", "extra_message": "standby down"}
+			]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	statistics, err := getPolicyGatewayDNSForwarderStatistics(context, connector, parent, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, err := getPolicyGatewayDNSForwarderStatus(context, connector, parent, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status == nil || *status.Status != "NO_BACKUP" || *status.ExtraMessage != "standby down" {
+		t.Errorf("Unexpected status %v", status)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtPolicyDNSForwarderStatistics().Schema, map[string]interface{}{})
+	if err := setPolicyDNSForwarderStatisticsInSchema(d, statistics); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("total_queries").(int) != 100 || d.Get("queries_answered_locally").(int) != 60 || d.Get("cached_entries").(int) != 12 {
+		t.Errorf("Unexpected counters %v, %v, %v", d.Get("total_queries"), d.Get("queries_answered_locally"), d.Get("cached_entries"))
+	}
+	if d.Get("used_cache.0.node_id").(string) != "n1" || d.Get("used_cache.0.used_cache_size").(int) != 3 {
+		t.Errorf("Unexpected used cache %v", d.Get("used_cache"))
+	}
+	if d.Get("default_forwarder.0.upstream_server.0.address").(string) != "1.1.1.1" || d.Get("default_forwarder.0.upstream_server.0.queries_failed").(int) != 2 {
+		t.Errorf("Unexpected default forwarder %v", d.Get("default_forwarder"))
+	}
+	if d.Get("conditional_forwarder.0.domain_names.0").(string) != "example.org" || d.Get("conditional_forwarder.0.upstream_server.0.queries_succeeded").(int) != 8 {
+		t.Errorf("Unexpected conditional forwarder %v", d.Get("conditional_forwarder"))
+	}
+}
+
+func TestPolicyVpcDNSForwarderStatistics(t *testing.T) {
+	context := utl.SessionContext{ProjectID: "p1", VPCID: "vpc1", ClientType: utl.VPC}
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/policy/api/v1/orgs/default/projects/p1/vpcs/vpc1/dns-forwarder-statistics":
+			writeTestJSONResponse(w, http.StatusOK, `{"statistics_per_enforcement_point": [
+			  {"enforcement_point_path": "/infra/sites/default/enforcement-points/default", "queries_answered_locally": 7,
+			   "conditional_forwarder_statistics": [{"domain_names": ["example.org"],
+			     "upstream_statistics": [{"upstream_server": "2.1.1.1", "queries_succeeded": 5, "queries_failed": 1}]}]}
+			]}`)
+		case "/policy/api/v1/orgs/default/projects/p1/vpcs/vpc1/dns-forwarder-status":
+			writeTestJSONResponse(w, http.StatusOK, `{"status_per_enforcement_point": [
+			  {"resource_type": "NsxTDNSForwarderStatus", "enforcement_point_path": "/infra/sites/default/enforcement-points/default", "status": "UP"}
+			]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	statistics, err := getPolicyVpcDNSForwarderStatistics(connector, context, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statistics == nil || *statistics.QueriesAnsweredLocally != 7 || len(statistics.ConditionalForwarderStatistics) != 1 {
+		t.Fatalf("Unexpected statistics %v", statistics)
+	}
+	upstream := statistics.ConditionalForwarderStatistics[0].UpstreamStatistics[0]
+	if *upstream.UpstreamServer != "2.1.1.1" || *upstream.QueriesSucceeded != 5 || *upstream.QueriesFailed != 1 {
+		t.Errorf("Unexpected upstream statistics %v", upstream)
+	}
+
+	statistics, err = getPolicyVpcDNSForwarderStatistics(connector, context, "/infra/sites/default/enforcement-points/other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statistics != nil {
+		t.Errorf("Expected no statistics for other enforcement point, got %v", statistics)
+	}
+
+	status, err := getPolicyVpcDNSForwarderStatus(connector, context, "/infra/sites/default/enforcement-points/default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status == nil || *status.Status != "UP" {
+		t.Errorf("Unexpected status %v", status)
+	}
+	status, err = getPolicyVpcDNSForwarderStatus(connector, context, "/infra/sites/default/enforcement-points/other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != nil {
+		t.Errorf("Expected no status for other enforcement point, got %v", status)
+	}
+}
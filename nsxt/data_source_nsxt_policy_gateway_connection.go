@@ -16,6 +16,8 @@ func dataSourceNsxtPolicyGatewayConnection() *schema.Resource {
 			"tier0_path":   getPolicyPathSchema(false, false, "Tier0 Gateway path"),
 			"path":         getPathSchema(),
 			"display_name": getDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getPolicySearchTagFilterSchema(),
 			"description":  getDescriptionSchema(),
 		},
 	}
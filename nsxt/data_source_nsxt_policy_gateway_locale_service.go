@@ -19,6 +19,8 @@ func dataSourceNsxtPolicyGatewayLocaleService() *schema.Resource {
 			"gateway_path": getPolicyPathSchema(true, true, "Gateway path"),
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getPolicySearchTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"bgp_path":     getComputedPolicyPathSchema("Path for BGP config"),
@@ -0,0 +1,221 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const (
+	gatewayRouteTableTypeRouting    = "routing"
+	gatewayRouteTableTypeForwarding = "forwarding"
+)
+
+var gatewayRouteTableTypeValues = []string{gatewayRouteTableTypeRouting, gatewayRouteTableTypeForwarding}
+
+var gatewayRouteSourceValues = []string{"BGP", "STATIC", "CONNECTED", "OSPF", "NSX_CONNECTED", "NSX_STATIC", "TIER0_NAT", "TIER1_NAT"}
+
+var gatewayRouteComponentTypeValues = []string{"DISTRIBUTED_ROUTER", "SERVICE_ROUTER"}
+
+func dataSourceNsxtPolicyGatewayRoutingTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGatewayRoutingTableRead,
+
+		Schema: map[string]*schema.Schema{
+			"gateway_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of Tier0 or Tier1 gateway",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"table_type": {
+				Type:         schema.TypeString,
+				Description:  "Type of table to retrieve",
+				Optional:     true,
+				Default:      gatewayRouteTableTypeRouting,
+				ValidateFunc: validation.StringInSlice(gatewayRouteTableTypeValues, false),
+			},
+			"edge_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of edge node to retrieve the table from",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"enforcement_point_path": {
+				Type:        schema.TypeString,
+				Description: "Enforcement point to retrieve the table from",
+				Optional:    true,
+			},
+			"network_prefix": {
+				Type:         schema.TypeString,
+				Description:  "Retrieve only routes for this network prefix",
+				Optional:     true,
+				ValidateFunc: validateCidr(),
+			},
+			"route_source": {
+				Type:         schema.TypeString,
+				Description:  "Retrieve only routes from this source",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(gatewayRouteSourceValues, false),
+			},
+			"component_type": {
+				Type:         schema.TypeString,
+				Description:  "Retrieve only routes of this gateway component",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(gatewayRouteComponentTypeValues, false),
+			},
+			"edge_node": {
+				Type:        schema.TypeList,
+				Description: "Table per edge node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edge_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the edge node",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of table retrieval from the edge node",
+							Computed:    true,
+						},
+						"error_message": {
+							Type:        schema.TypeString,
+							Description: "Error message in case table retrieval failed",
+							Computed:    true,
+						},
+						"route": {
+							Type:        schema.TypeList,
+							Description: "Route entries",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"network": {
+										Type:        schema.TypeString,
+										Description: "Network CIDR",
+										Computed:    true,
+									},
+									"next_hop": {
+										Type:        schema.TypeString,
+										Description: "Next hop address",
+										Computed:    true,
+									},
+									"route_type": {
+										Type:        schema.TypeString,
+										Description: "Route type",
+										Computed:    true,
+									},
+									"admin_distance": {
+										Type:        schema.TypeInt,
+										Description: "Admin distance",
+										Computed:    true,
+									},
+									"next_hop_gateway": {
+										Type:        schema.TypeString,
+										Description: "Policy path of next hop gateway",
+										Computed:    true,
+									},
+									"component_type": {
+										Type:        schema.TypeString,
+										Description: "Gateway component type",
+										Computed:    true,
+									},
+									"component_id": {
+										Type:        schema.TypeString,
+										Description: "Gateway component id",
+										Computed:    true,
+									},
+									"black_hole": {
+										Type:        schema.TypeBool,
+										Description: "Whether this is a black hole route",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// listPolicyGatewayRoutes retrieves all pages of routing or forwarding table of a gateway
+func listPolicyGatewayRoutes(connector client.Connector, isGlobalManager bool, gatewayPath string, tableType string, query map[string]string) ([]model.RoutingTable, error) {
+	var results []model.RoutingTable
+	tablePath := fmt.Sprintf("%s/%s-table", strings.TrimSuffix(gatewayPath, "/"), tableType)
+	pageQuery := make(map[string]string)
+	for key, value := range query {
+		pageQuery[key] = value
+	}
+
+	for {
+		page, err := policyGenericGetModel(connector, isGlobalManager, tablePath, pageQuery, model.RoutingTableListResultBindingType())
+		if err != nil {
+			return results, err
+		}
+		tables := page.(model.RoutingTableListResult)
+		results = append(results, tables.Results...)
+		if tables.Cursor == nil || *tables.Cursor == "" || len(tables.Results) == 0 {
+			return results, nil
+		}
+		pageQuery["cursor"] = *tables.Cursor
+	}
+}
+
+func setPolicyGatewayRoutesInSchema(d *schema.ResourceData, tables []model.RoutingTable) error {
+	var edgeNodes []interface{}
+	for _, table := range tables {
+		elem := make(map[string]interface{})
+		elem["edge_path"] = table.EdgeNode
+		elem["status"] = table.Status
+		elem["error_message"] = table.ErrorMessage
+		var routes []interface{}
+		for _, entry := range table.RouteEntries {
+			route := make(map[string]interface{})
+			route["network"] = entry.Network
+			route["next_hop"] = entry.NextHop
+			route["route_type"] = entry.RouteType
+			route["admin_distance"] = entry.AdminDistance
+			route["next_hop_gateway"] = entry.NextHopGateway
+			route["component_type"] = entry.LrComponentType
+			route["component_id"] = entry.LrComponentId
+			route["black_hole"] = entry.BlackHole
+			routes = append(routes, route)
+		}
+		elem["route"] = routes
+		edgeNodes = append(edgeNodes, elem)
+	}
+
+	return d.Set("edge_node", edgeNodes)
+}
+
+func dataSourceNsxtPolicyGatewayRoutingTableRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	gatewayPath := d.Get("gateway_path").(string)
+	tableType := d.Get("table_type").(string)
+	isGlobalManager := isPolicyGlobalManager(m)
+
+	query := make(map[string]string)
+	for _, param := range []string{"edge_path", "enforcement_point_path", "network_prefix", "route_source", "component_type"} {
+		if value := d.Get(param).(string); value != "" {
+			query[param] = value
+		}
+	}
+
+	tables, err := listPolicyGatewayRoutes(connector, isGlobalManager, gatewayPath, tableType, query)
+	if err != nil {
+		return handleDataSourceReadError(d, "Gateway Routing Table", gatewayPath, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s-table", gatewayPath, tableType))
+	return setPolicyGatewayRoutesInSchema(d, tables)
+}
@@ -0,0 +1,93 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtPolicyGatewayRoutingTable_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_gateway_routing_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_CLUSTER")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayRoutingTableTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "gateway_path", "nsxt_policy_tier0_gateway.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_node.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayRoutingTableTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_edge_cluster" "EC" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name      = "%s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.EC.path
+}
+
+data "nsxt_policy_gateway_routing_table" "test" {
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+}`, getEdgeClusterName(), name)
+}
+
+func TestPolicyGatewayRoutingTable(t *testing.T) {
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/policy/api/v1/infra/tier-0s/t0/forwarding-table" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("route_source") != "BGP" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Query().Get("cursor") == "" {
+			writeTestJSONResponse(w, http.StatusOK, `{"cursor": "1", "results": [
+			  {"edge_node": "/infra/sites/default/enforcement-points/default/edge-clusters/ec/edge-nodes/0",
+			   "route_entries": [{"network": "10.0.0.0/24", "next_hop": "192.168.0.1", "route_type": "b", "admin_distance": 20}]}]}`)
+			return
+		}
+		writeTestJSONResponse(w, http.StatusOK, `{"results": [
+		  {"edge_node": "/infra/sites/default/enforcement-points/default/edge-clusters/ec/edge-nodes/1",
+		   "status": "TIMEOUT", "error_message": "timed out"}]}`)
+	})
+
+	tables, err := listPolicyGatewayRoutes(connector, false, "/infra/tier-0s/t0", gatewayRouteTableTypeForwarding, map[string]string{"route_source": "BGP"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtPolicyGatewayRoutingTable().Schema, map[string]interface{}{})
+	if err := setPolicyGatewayRoutesInSchema(d, tables); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("edge_node.#").(int) != 2 {
+		t.Fatalf("Expected 2 edge nodes, got %d", d.Get("edge_node.#").(int))
+	}
+	if d.Get("edge_node.0.route.#").(int) != 1 || d.Get("edge_node.0.route.0.network").(string) != "10.0.0.0/24" || d.Get("edge_node.0.route.0.admin_distance").(int) != 20 {
+		t.Errorf("Unexpected routes %v", d.Get("edge_node.0.route"))
+	}
+	if d.Get("edge_node.1.route.#").(int) != 0 || d.Get("edge_node.1.status").(string) != "TIMEOUT" {
+		t.Errorf("Unexpected edge node %v", d.Get("edge_node.1"))
+	}
+}
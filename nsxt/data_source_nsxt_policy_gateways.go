@@ -0,0 +1,34 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var policyGatewayTypeValues = []string{"Tier0", "Tier1"}
+
+func dataSourceNsxtPolicyGateways() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGatewaysRead,
+
+		Schema: getPolicyDataSourceListSchema(false, map[string]*schema.Schema{
+			"gateway_type": {
+				Type:         schema.TypeString,
+				Description:  "Type of gateways to list. If not specified, both Tier0 and Tier1 gateways are listed",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(policyGatewayTypeValues, false),
+			},
+		}),
+	}
+}
+
+func dataSourceNsxtPolicyGatewaysRead(d *schema.ResourceData, m interface{}) error {
+	resourceTypes := policyGatewayTypeValues
+	if gatewayType := d.Get("gateway_type").(string); gatewayType != "" {
+		resourceTypes = []string{gatewayType}
+	}
+	return policyDataSourceListRead(d, m, resourceTypes, nil)
+}
@@ -0,0 +1,55 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGateways_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_gateways.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewaysReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.path", "nsxt_policy_tier1_gateway.test", "path"),
+					resource.TestCheckResourceAttr("data.nsxt_policy_gateways.tier0", "items.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewaysReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_gateways" "test" {
+  display_name_regex = "^%s$"
+
+  depends_on = [nsxt_policy_tier1_gateway.test]
+}
+
+data "nsxt_policy_gateways" "tier0" {
+  gateway_type       = "Tier0"
+  display_name_regex = "^%s$"
+
+  depends_on = [nsxt_policy_tier1_gateway.test]
+}`, name, name, name)
+}
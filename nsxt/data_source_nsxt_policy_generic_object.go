@@ -0,0 +1,73 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyGenericObject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGenericObjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the object",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"body": {
+				Type:        schema.TypeString,
+				Description: "JSON body of the object",
+				Computed:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Display name of the object",
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the object",
+				Computed:    true,
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Description: "Resource type of the object",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyGenericObjectRead(d *schema.ResourceData, m interface{}) error {
+	policyPath := d.Get("path").(string)
+
+	obj, err := policyGenericGet(getPolicyConnector(m), isPolicyGlobalManager(m), policyPath)
+	if err != nil {
+		return handleDataSourceReadError(d, "GenericObject", policyPath, err)
+	}
+
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(policyPath)
+	d.Set("body", string(body))
+	if displayName, ok := obj["display_name"].(string); ok {
+		d.Set("display_name", displayName)
+	}
+	if description, ok := obj["description"].(string); ok {
+		d.Set("description", description)
+	}
+	if resourceType, ok := obj["resource_type"].(string); ok {
+		d.Set("resource_type", resourceType)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGenericObject_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_generic_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGenericObjectReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "test"),
+					resource.TestCheckResourceAttr(testResourceName, "resource_type", "IpAddressPool"),
+					resource.TestCheckResourceAttrSet(testResourceName, "body"),
+					resource.TestCheckResourceAttrPair(testResourceName, "path", "nsxt_policy_ip_pool.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGenericObjectReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_ip_pool" "test" {
+  display_name = "%s"
+  description  = "test"
+}
+
+data "nsxt_policy_generic_object" "test" {
+  path = nsxt_policy_ip_pool.test.path
+}`, name)
+}
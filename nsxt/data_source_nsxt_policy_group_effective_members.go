@@ -0,0 +1,334 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const (
+	groupMemberTypeVirtualMachines = "virtual_machines"
+	groupMemberTypeIPAddresses     = "ip_addresses"
+	groupMemberTypeMACAddresses    = "mac_addresses"
+	groupMemberTypeSegments        = "segments"
+	groupMemberTypeSegmentPorts    = "segment_ports"
+	groupMemberTypeVIFs            = "vifs"
+	groupMemberTypePhysicalServers = "physical_servers"
+)
+
+var groupMemberTypeValues = []string{
+	groupMemberTypeVirtualMachines,
+	groupMemberTypeIPAddresses,
+	groupMemberTypeMACAddresses,
+	groupMemberTypeSegments,
+	groupMemberTypeSegmentPorts,
+	groupMemberTypeVIFs,
+	groupMemberTypePhysicalServers,
+}
+
+// Effective members API per member type. MAC addresses are collected from VIFs.
+var groupMemberTypeAPI = map[string]string{
+	groupMemberTypeVirtualMachines: "virtual-machines",
+	groupMemberTypeIPAddresses:     "ip-addresses",
+	groupMemberTypeSegments:        "segments",
+	groupMemberTypeSegmentPorts:    "segment-ports",
+	groupMemberTypeVIFs:            "vifs",
+	groupMemberTypePhysicalServers: "physical-servers",
+}
+
+// Effective members list result model per member type
+var groupMemberTypeBindingType = map[string]func() bindings.BindingType{
+	groupMemberTypeVirtualMachines: model.RealizedVirtualMachineListResultBindingType,
+	groupMemberTypeIPAddresses:     model.PolicyGroupIPMembersListResultBindingType,
+	groupMemberTypeSegments:        model.PolicyGroupMembersListResultBindingType,
+	groupMemberTypeSegmentPorts:    model.PolicyGroupMembersListResultBindingType,
+	groupMemberTypeVIFs:            model.VirtualNetworkInterfaceListResultBindingType,
+	groupMemberTypePhysicalServers: model.PolicyGroupMembersListResultBindingType,
+}
+
+func getGroupMemberDetailsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"display_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"path": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyGroupEffectiveMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGroupEffectiveMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the group",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"member_types": {
+				Type:        schema.TypeSet,
+				Description: "Member types to retrieve. If not specified, all member types are retrieved",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(groupMemberTypeValues, false),
+				},
+			},
+			"enforcement_point_path": {
+				Type:        schema.TypeString,
+				Description: "Enforcement point to retrieve members from. Relevant for Global Manager",
+				Optional:    true,
+			},
+			groupMemberTypeVirtualMachines: {
+				Type:        schema.TypeList,
+				Description: "Virtual machines that belong to the group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			groupMemberTypeIPAddresses: {
+				Type:        schema.TypeList,
+				Description: "IP addresses that belong to the group",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			groupMemberTypeMACAddresses: {
+				Type:        schema.TypeList,
+				Description: "MAC addresses of VIFs that belong to the group",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			groupMemberTypeSegments:     getGroupMemberDetailsSchema("Segments that belong to the group"),
+			groupMemberTypeSegmentPorts: getGroupMemberDetailsSchema("Segment ports that belong to the group"),
+			groupMemberTypeVIFs: {
+				Type:        schema.TypeList,
+				Description: "VIFs that belong to the group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_vm_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			groupMemberTypePhysicalServers: getGroupMemberDetailsSchema("Physical servers that belong to the group"),
+		},
+	}
+}
+
+// listPolicyGroupEffectiveMembers retrieves all pages of effective members of given type.
+// Members are returned as SDK model objects, or strings for IP addresses.
+func listPolicyGroupEffectiveMembers(connector client.Connector, isGlobalManager bool, groupPath string, memberType string, enforcementPointPath string) ([]interface{}, error) {
+	var results []interface{}
+	membersPath := fmt.Sprintf("%s/members/%s", strings.TrimSuffix(groupPath, "/"), groupMemberTypeAPI[memberType])
+	lister := func(info *paginationInfo) error {
+		query := make(map[string]string)
+		if cursor, ok := info.LocalVarOptionals["cursor"].(string); ok && cursor != "" {
+			query["cursor"] = cursor
+		}
+		if enforcementPointPath != "" {
+			query["enforcement_point_path"] = enforcementPointPath
+		}
+		page, err := policyGenericGetModel(connector, isGlobalManager, membersPath, query, groupMemberTypeBindingType[memberType]())
+		if err != nil {
+			return err
+		}
+
+		var cursor *string
+		var resultCount *int64
+		previousCount := len(results)
+		switch listResult := page.(type) {
+		case model.RealizedVirtualMachineListResult:
+			for _, member := range listResult.Results {
+				results = append(results, member)
+			}
+			cursor, resultCount = listResult.Cursor, listResult.ResultCount
+		case model.PolicyGroupIPMembersListResult:
+			for _, member := range listResult.Results {
+				results = append(results, member)
+			}
+			cursor, resultCount = listResult.Cursor, listResult.ResultCount
+		case model.PolicyGroupMembersListResult:
+			for _, member := range listResult.Results {
+				results = append(results, member)
+			}
+			cursor, resultCount = listResult.Cursor, listResult.ResultCount
+		case model.VirtualNetworkInterfaceListResult:
+			for _, member := range listResult.Results {
+				results = append(results, member)
+			}
+			cursor, resultCount = listResult.Cursor, listResult.ResultCount
+		default:
+			return fmt.Errorf("unexpected %s members response type %T", memberType, page)
+		}
+
+		info.PageCount = int64(len(results) - previousCount)
+		info.TotalCount = 0
+		if resultCount != nil {
+			info.TotalCount = *resultCount
+		}
+		info.Cursor = ""
+		if cursor != nil {
+			info.Cursor = *cursor
+		}
+		if info.PageCount == 0 {
+			// Stop pagination if server returned empty page before reaching total count
+			info.PageCount = info.TotalCount
+		}
+		return nil
+	}
+
+	_, err := handlePagination(lister)
+	return results, err
+}
+
+func groupMemberDetailsList(results []interface{}) []interface{} {
+	var members []interface{}
+	for _, result := range results {
+		member, ok := result.(model.PolicyGroupMemberDetails)
+		if !ok {
+			continue
+		}
+		members = append(members, map[string]interface{}{
+			"id":           member.Id,
+			"display_name": member.DisplayName,
+			"path":         member.Path,
+		})
+	}
+	return members
+}
+
+func dataSourceNsxtPolicyGroupEffectiveMembersRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	groupPath := d.Get("group_path").(string)
+	enforcementPointPath := d.Get("enforcement_point_path").(string)
+	isGlobalManager := isPolicyGlobalManager(m)
+
+	memberTypes := interface2StringList(d.Get("member_types").(*schema.Set).List())
+	if len(memberTypes) == 0 {
+		memberTypes = groupMemberTypeValues
+	}
+	if stringInList(groupMemberTypeMACAddresses, memberTypes) && !stringInList(groupMemberTypeVIFs, memberTypes) {
+		memberTypes = append(memberTypes, groupMemberTypeVIFs)
+	}
+
+	// Make sure the group exists, since member APIs may return empty list for unknown group
+	if _, err := policyGenericGet(connector, isGlobalManager, groupPath); err != nil {
+		return handleDataSourceReadError(d, "Group", groupPath, err)
+	}
+
+	for _, memberType := range memberTypes {
+		if _, ok := groupMemberTypeAPI[memberType]; !ok {
+			continue
+		}
+		results, err := listPolicyGroupEffectiveMembers(connector, isGlobalManager, groupPath, memberType, enforcementPointPath)
+		if err != nil {
+			if isNotFoundError(err) {
+				// Member type is not supported by this NSX version
+				log.Printf("[WARN] Effective %s members are not available for group %s: %v", memberType, groupPath, err)
+				continue
+			}
+			return handleDataSourceReadError(d, "Group effective members", groupPath, err)
+		}
+
+		switch memberType {
+		case groupMemberTypeVirtualMachines:
+			var vms []interface{}
+			for _, result := range results {
+				if vm, ok := result.(model.RealizedVirtualMachine); ok {
+					vms = append(vms, map[string]interface{}{
+						"id":           vm.Id,
+						"display_name": vm.DisplayName,
+					})
+				}
+			}
+			d.Set(memberType, vms)
+		case groupMemberTypeIPAddresses:
+			var addresses []string
+			for _, result := range results {
+				if address, ok := result.(string); ok {
+					addresses = append(addresses, address)
+				}
+			}
+			d.Set(memberType, addresses)
+		case groupMemberTypeVIFs:
+			var vifs []interface{}
+			var macAddresses []string
+			for _, result := range results {
+				vif, ok := result.(model.VirtualNetworkInterface)
+				if !ok {
+					continue
+				}
+				vifs = append(vifs, map[string]interface{}{
+					"external_id":  vif.ExternalId,
+					"display_name": vif.DisplayName,
+					"mac_address":  vif.MacAddress,
+					"owner_vm_id":  vif.OwnerVmId,
+				})
+				if vif.MacAddress != nil && *vif.MacAddress != "" && !stringInList(*vif.MacAddress, macAddresses) {
+					macAddresses = append(macAddresses, *vif.MacAddress)
+				}
+			}
+			d.Set(memberType, vifs)
+			d.Set(groupMemberTypeMACAddresses, macAddresses)
+		default:
+			d.Set(memberType, groupMemberDetailsList(results))
+		}
+	}
+
+	d.SetId(groupPath)
+	return nil
+}
@@ -0,0 +1,128 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestAccDataSourceNsxtPolicyGroupEffectiveMembers_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicyGroupEffectiveMembersBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicyGroupEffectiveMembers_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicyGroupEffectiveMembersBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicyGroupEffectiveMembersBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_group_effective_members.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGroupEffectiveMembersTemplate(name, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "id", "nsxt_policy_group.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "virtual_machines.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGroupEffectiveMembersTemplate(name string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+%s
+  display_name = "%s"
+
+  criteria {
+    ipaddress_expression {
+      ip_addresses = ["10.0.0.1", "10.0.0.2"]
+    }
+  }
+}
+
+data "nsxt_policy_group_effective_members" "test" {
+  group_path   = nsxt_policy_group.test.path
+  member_types = ["ip_addresses", "virtual_machines"]
+}`, context, name)
+}
+
+func TestListPolicyGroupEffectiveMembers(t *testing.T) {
+	var requests []string
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.String())
+		if r.URL.Query().Get("cursor") == "" {
+			writeTestJSONResponse(w, http.StatusOK, `{"results": [{"id": "vm-1", "display_name": "web-1"}], "result_count": 2, "cursor": "next"}`)
+			return
+		}
+		writeTestJSONResponse(w, http.StatusOK, `{"results": [{"id": "vm-2", "display_name": "web-2"}], "result_count": 2}`)
+	})
+
+	results, err := listPolicyGroupEffectiveMembers(connector, false, "/infra/domains/default/groups/web", groupMemberTypeVirtualMachines, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 members, got %d", len(results))
+	}
+	expected := []string{
+		"/policy/api/v1/infra/domains/default/groups/web/members/virtual-machines",
+		"/policy/api/v1/infra/domains/default/groups/web/members/virtual-machines?cursor=next",
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("Expected requests %v, got %v", expected, requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("Expected request %s, got %s", expected[i], requests[i])
+		}
+	}
+	vm, ok := results[1].(model.RealizedVirtualMachine)
+	if !ok || vm.DisplayName == nil || *vm.DisplayName != "web-2" {
+		t.Errorf("Unexpected members %v", results)
+	}
+}
+
+func TestGroupMemberDetailsList(t *testing.T) {
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/policy/api/v1/infra/domains/default/groups/web/members/segments" {
+			writeTestJSONResponse(w, http.StatusNotFound, `{}`)
+			return
+		}
+		writeTestJSONResponse(w, http.StatusOK, `{"results": [{"id": "seg1", "display_name": "web", "path": "/infra/segments/seg1"}], "result_count": 1}`)
+	})
+
+	results, err := listPolicyGroupEffectiveMembers(connector, false, "/infra/domains/default/groups/web", groupMemberTypeSegments, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	members := groupMemberDetailsList(results)
+	if len(members) != 1 {
+		t.Fatalf("Expected 1 member, got %v", members)
+	}
+	member := members[0].(map[string]interface{})
+	if path := member["path"].(*string); path == nil || *path != "/infra/segments/seg1" {
+		t.Errorf("Unexpected member %v", member)
+	}
+}
@@ -0,0 +1,22 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGroupsRead,
+
+		Schema: getPolicyDataSourceListSchema(true, map[string]*schema.Schema{
+			"domain": getDataSourceDomainNameSchema(),
+		}),
+	}
+}
+
+func dataSourceNsxtPolicyGroupsRead(d *schema.ResourceData, m interface{}) error {
+	return policyDataSourceListRead(d, m, []string{"Group"}, getPolicyDataSourceListDomainQuery(d, m))
+}
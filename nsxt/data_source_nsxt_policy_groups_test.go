@@ -0,0 +1,83 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGroups_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicyGroupsBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicyGroups_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicyGroupsBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicyGroupsBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_groups.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGroupsReadTemplate(name, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", name+"-1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.1.display_name", name+"-2"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "items.0.path"),
+					resource.TestCheckResourceAttr("data.nsxt_policy_groups.regex", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.nsxt_policy_groups.regex", "items.0.display_name", name+"-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGroupsReadTemplate(name string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+%s
+  count        = 2
+  display_name = "%s-${count.index + 1}"
+
+  tag {
+    scope = "test-scope"
+    tag   = "%s"
+  }
+}
+
+data "nsxt_policy_groups" "test" {
+%s
+  tag {
+    scope = "test-scope"
+    tag   = "%s"
+  }
+
+  depends_on = [nsxt_policy_group.test]
+}
+
+data "nsxt_policy_groups" "regex" {
+%s
+  display_name_regex = "^%s-2$"
+
+  depends_on = [nsxt_policy_group.test]
+}`, context, name, name, context, name, context, name)
+}
@@ -20,6 +20,8 @@ func dataSourceNsxtPolicyIPSecVpnLocalEndpoint() *schema.Resource {
 			"id":           getDataSourceIDSchema(),
 			"service_path": getPolicyPathSchema(false, false, "Policy path for IPSec VPN service"),
 			"display_name": getDataSourceDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getPolicySearchTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"local_address": {
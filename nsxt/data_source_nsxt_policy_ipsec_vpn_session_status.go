@@ -0,0 +1,346 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var ipSecVpnSessionRuntimeStatusValues = []string{
+	model.IPSecVpnSessionStatusNsxt_RUNTIME_STATUS_UP,
+	model.IPSecVpnSessionStatusNsxt_RUNTIME_STATUS_DOWN,
+	model.IPSecVpnSessionStatusNsxt_RUNTIME_STATUS_DEGRADED,
+}
+
+func getVpnSessionStatusWaitSchema(statusValues []string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Wait until session reaches this runtime status",
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(statusValues, false),
+	}
+}
+
+func getVpnSessionStatusTimeoutSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Timeout in seconds to wait for the runtime status",
+		Optional:     true,
+		Default:      600,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+func getIPSecVpnTrafficCountersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bytes_in":            getComputedIntSchema("Number of bytes received"),
+		"bytes_out":           getComputedIntSchema("Number of bytes sent"),
+		"packets_in":          getComputedIntSchema("Number of packets received"),
+		"packets_out":         getComputedIntSchema("Number of packets sent"),
+		"dropped_packets_in":  getComputedIntSchema("Number of incoming packets dropped"),
+		"dropped_packets_out": getComputedIntSchema("Number of outgoing packets dropped"),
+	}
+}
+
+func dataSourceNsxtPolicyIPSecVpnSessionStatus() *schema.Resource {
+	tunnelSchema := getIPSecVpnTrafficCountersSchema()
+	tunnelSchema["rule_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Policy path of the rule of policy based session",
+		Computed:    true,
+	}
+	tunnelSchema["tunnel_interface_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Policy path of the tunnel interface of route based session",
+		Computed:    true,
+	}
+	tunnelSchema["local_subnet"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Local subnet of the tunnel",
+		Computed:    true,
+	}
+	tunnelSchema["peer_subnet"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Peer subnet of the tunnel",
+		Computed:    true,
+	}
+	tunnelSchema["tunnel_status"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Status of the tunnel",
+		Computed:    true,
+	}
+	tunnelSchema["tunnel_down_reason"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Reason of the tunnel being down",
+		Computed:    true,
+	}
+	tunnelSchema["encryption_failures"] = getComputedIntSchema("Number of packets dropped due to encryption failures")
+	tunnelSchema["decryption_failures"] = getComputedIntSchema("Number of packets dropped due to decryption failures")
+	tunnelSchema["integrity_failures"] = getComputedIntSchema("Number of packets dropped due to integrity check failures")
+	tunnelSchema["replay_errors"] = getComputedIntSchema("Number of packets dropped due to replay check failures")
+
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyIPSecVpnSessionStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"session_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the IPSec VPN session",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"enforcement_point_path": {
+				Type:        schema.TypeString,
+				Description: "Enforcement point to retrieve status from",
+				Optional:    true,
+			},
+			"wait_for_status": getVpnSessionStatusWaitSchema(ipSecVpnSessionRuntimeStatusValues),
+			"timeout":         getVpnSessionStatusTimeoutSchema(),
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Display name of the session",
+				Computed:    true,
+			},
+			"runtime_status": {
+				Type:        schema.TypeString,
+				Description: "Runtime status of the session",
+				Computed:    true,
+			},
+			"ike_status": {
+				Type:        schema.TypeString,
+				Description: "State of the IKE session",
+				Computed:    true,
+			},
+			"ike_fail_reason": {
+				Type:        schema.TypeString,
+				Description: "Reason of IKE session failure",
+				Computed:    true,
+			},
+			"total_tunnels":         getComputedIntSchema("Total number of tunnels of the session"),
+			"negotiated_tunnels":    getComputedIntSchema("Number of negotiated tunnels"),
+			"failed_tunnels":        getComputedIntSchema("Number of failed tunnels"),
+			"last_update_timestamp": getComputedIntSchema("Timestamp when the status was last updated"),
+			"traffic_counters": {
+				Type:        schema.TypeList,
+				Description: "Aggregate traffic counters of the session",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: getIPSecVpnTrafficCountersSchema(),
+				},
+			},
+			"ike_traffic": {
+				Type:        schema.TypeList,
+				Description: "IKE traffic statistics of the session",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_in":    getComputedIntSchema("Number of IKE bytes received"),
+						"bytes_out":   getComputedIntSchema("Number of IKE bytes sent"),
+						"packets_in":  getComputedIntSchema("Number of IKE packets received"),
+						"packets_out": getComputedIntSchema("Number of IKE packets sent"),
+						"fail_count":  getComputedIntSchema("Number of IKE failures"),
+					},
+				},
+			},
+			"tunnel": {
+				Type:        schema.TypeList,
+				Description: "Status and statistics per tunnel",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: tunnelSchema,
+				},
+			},
+		},
+	}
+}
+
+// getPolicyIPSecVpnSessionStatistics retrieves traffic statistics of IPSec VPN session by policy path.
+// nil is returned if statistics are not yet available on the enforcement point.
+func getPolicyIPSecVpnSessionStatistics(connector client.Connector, sessionPath string, enforcementPointPath *string) (*model.IPSecVpnSessionStatisticsNsxt, error) {
+	servicePath, sessionID, err := parseIPSecVPNSessionPolicyPath(sessionPath)
+	if err != nil {
+		return nil, err
+	}
+	sessionClient, err := newIpsecSessionClient(servicePath)
+	if err != nil {
+		return nil, err
+	}
+	result, err := sessionClient.Statistics(connector, sessionID, enforcementPointPath)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := bindings.NewTypeConverter()
+	for _, statisticsValue := range result.Results {
+		statistics, errs := converter.ConvertToGolang(statisticsValue, model.IPSecVpnSessionStatisticsNsxtBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		sessionStatistics := statistics.(model.IPSecVpnSessionStatisticsNsxt)
+		return &sessionStatistics, nil
+	}
+	return nil, nil
+}
+
+// waitForPolicyVpnSessionStatus polls session runtime status until it reaches expected status
+func waitForPolicyVpnSessionStatus(sessionPath string, expectedStatus string, allStatuses []string, timeout int, getStatus func() (string, error)) error {
+	var pending []string
+	for _, status := range allStatuses {
+		if status != expectedStatus {
+			pending = append(pending, status)
+		}
+	}
+	// Status might not be available right after session is created
+	pending = append(pending, "UNKNOWN")
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{expectedStatus},
+		Refresh: func() (interface{}, string, error) {
+			status, err := getStatus()
+			if err != nil {
+				return nil, "", err
+			}
+			if status == "" {
+				status = "UNKNOWN"
+			}
+			return status, status, nil
+		},
+		Timeout:    time.Duration(timeout) * time.Second,
+		MinTimeout: 1 * time.Second,
+		Delay:      1 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Failed to wait for VPN session %s status %s: %v", sessionPath, expectedStatus, err)
+	}
+	return nil
+}
+
+func getIPSecVpnTrafficCountersForSchema(counters *model.IPSecVpnTrafficCounters) []interface{} {
+	if counters == nil {
+		return nil
+	}
+	elem := make(map[string]interface{})
+	elem["bytes_in"] = counters.BytesIn
+	elem["bytes_out"] = counters.BytesOut
+	elem["packets_in"] = counters.PacketsIn
+	elem["packets_out"] = counters.PacketsOut
+	elem["dropped_packets_in"] = counters.DroppedPacketsIn
+	elem["dropped_packets_out"] = counters.DroppedPacketsOut
+	return []interface{}{elem}
+}
+
+func setPolicyIPSecVpnSessionStatisticsInSchema(d *schema.ResourceData, statistics *model.IPSecVpnSessionStatisticsNsxt) error {
+	var ikeTraffic []interface{}
+	var tunnels []interface{}
+	if statistics != nil {
+		if statistics.IkeTrafficStatistics != nil {
+			elem := make(map[string]interface{})
+			elem["bytes_in"] = statistics.IkeTrafficStatistics.BytesIn
+			elem["bytes_out"] = statistics.IkeTrafficStatistics.BytesOut
+			elem["packets_in"] = statistics.IkeTrafficStatistics.PacketsIn
+			elem["packets_out"] = statistics.IkeTrafficStatistics.PacketsOut
+			elem["fail_count"] = statistics.IkeTrafficStatistics.FailCount
+			ikeTraffic = append(ikeTraffic, elem)
+		}
+		for _, policyStatistics := range statistics.PolicyStatistics {
+			for _, tunnel := range policyStatistics.TunnelStatistics {
+				elem := make(map[string]interface{})
+				elem["rule_path"] = policyStatistics.RulePath
+				elem["tunnel_interface_path"] = policyStatistics.TunnelInterfacePath
+				elem["local_subnet"] = tunnel.LocalSubnet
+				elem["peer_subnet"] = tunnel.PeerSubnet
+				elem["tunnel_status"] = tunnel.TunnelStatus
+				elem["tunnel_down_reason"] = tunnel.TunnelDownReason
+				elem["bytes_in"] = tunnel.BytesIn
+				elem["bytes_out"] = tunnel.BytesOut
+				elem["packets_in"] = tunnel.PacketsIn
+				elem["packets_out"] = tunnel.PacketsOut
+				elem["dropped_packets_in"] = tunnel.DroppedPacketsIn
+				elem["dropped_packets_out"] = tunnel.DroppedPacketsOut
+				elem["encryption_failures"] = tunnel.EncryptionFailures
+				elem["decryption_failures"] = tunnel.DecryptionFailures
+				elem["integrity_failures"] = tunnel.IntegrityFailures
+				elem["replay_errors"] = tunnel.ReplayErrors
+				tunnels = append(tunnels, elem)
+			}
+		}
+	}
+
+	d.Set("ike_traffic", ikeTraffic)
+	return d.Set("tunnel", tunnels)
+}
+
+func setPolicyIPSecVpnSessionStatusInSchema(d *schema.ResourceData, status *model.IPSecVpnSessionStatusNsxt) {
+	if status == nil {
+		d.Set("runtime_status", "")
+		return
+	}
+	d.Set("display_name", status.DisplayName)
+	d.Set("runtime_status", status.RuntimeStatus)
+	d.Set("total_tunnels", status.TotalTunnels)
+	d.Set("negotiated_tunnels", status.NegotiatedTunnels)
+	d.Set("failed_tunnels", status.FailedTunnels)
+	d.Set("last_update_timestamp", status.LastUpdateTimestamp)
+	if status.IkeStatus != nil {
+		d.Set("ike_status", status.IkeStatus.IkeSessionState)
+		d.Set("ike_fail_reason", status.IkeStatus.FailReason)
+	}
+	d.Set("traffic_counters", getIPSecVpnTrafficCountersForSchema(status.AggregateTrafficCounters))
+}
+
+func dataSourceNsxtPolicyIPSecVpnSessionStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+	sessionPath := d.Get("session_path").(string)
+	if _, _, err := parseIPSecVPNSessionPolicyPath(sessionPath); err != nil {
+		return err
+	}
+
+	enforcementPointPath := getOptionalStringPtr(d, "enforcement_point_path")
+	getStatus := func() (*model.IPSecVpnSessionStatusNsxt, error) {
+		status, err := getPolicyIPSecVpnSessionStatus(connector, sessionPath, enforcementPointPath)
+		if err != nil {
+			return nil, handleDataSourceReadError(d, "IPSec VPN Session Status", sessionPath, err)
+		}
+		return status, nil
+	}
+
+	var status *model.IPSecVpnSessionStatusNsxt
+	var err error
+	if waitForStatus := d.Get("wait_for_status").(string); waitForStatus != "" {
+		err = waitForPolicyVpnSessionStatus(sessionPath, waitForStatus, ipSecVpnSessionRuntimeStatusValues, d.Get("timeout").(int), func() (string, error) {
+			status, err = getStatus()
+			if err != nil || status == nil || status.RuntimeStatus == nil {
+				return "", err
+			}
+			return *status.RuntimeStatus, nil
+		})
+	} else {
+		status, err = getStatus()
+	}
+	if err != nil {
+		return err
+	}
+
+	statistics, err := getPolicyIPSecVpnSessionStatistics(connector, sessionPath, enforcementPointPath)
+	if err != nil {
+		return handleDataSourceReadError(d, "IPSec VPN Session Statistics", sessionPath, err)
+	}
+
+	d.SetId(sessionPath + "/detailed-status")
+	setPolicyIPSecVpnSessionStatusInSchema(d, status)
+	return setPolicyIPSecVpnSessionStatisticsInSchema(d, statistics)
+}
@@ -0,0 +1,112 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtPolicyIPSecVpnSessionStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_ipsec_vpn_session_status.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_CLUSTER")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnSessionRouteBasedMinimalistic() + `
+data "nsxt_policy_ipsec_vpn_session_status" "test" {
+  session_path = nsxt_policy_ipsec_vpn_session.test.path
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "runtime_status"),
+					resource.TestCheckResourceAttrSet(testResourceName, "tunnel.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyIPSecVpnSessionStatus(t *testing.T) {
+	sessionPath := "/infra/tier-0s/t0/ipsec-vpn-services/svc/sessions/s1"
+	enforcementPointPath := "/infra/sites/default/enforcement-points/default"
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("enforcement_point_path") != enforcementPointPath {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/policy/api/v1" + sessionPath + "/detailed-status":
+			writeTestJSONResponse(w, http.StatusOK, `{"intent_path": "`+sessionPath+`", "results": [
+			  {"resource_type": "IPSecVpnSessionStatusNsxT", "runtime_status": "DEGRADED", "total_tunnels": 2, "failed_tunnels": 1,
+			   "ike_status": {"ike_session_state": "UP"}, "aggregate_traffic_counters": {"bytes_in": 100, "bytes_out": 200}}
+			]}`)
+		case "/policy/api/v1" + sessionPath + "/statistics":
+			writeTestJSONResponse(w, http.StatusOK, `{"intent_path": "`+sessionPath+`", "results": [
+			  {"resource_type": "IPSecVpnSessionStatisticsNsxT", "ike_traffic_statistics": {"fail_count": 3},
+			   "policy_statistics": [{"rule_path": "`+sessionPath+`/rules/r1", "tunnel_statistics": [
+			     {"local_subnet": "10.0.0.0/24", "peer_subnet": "10.1.0.0/24", "tunnel_status": "UP", "bytes_in": 10},
+			     {"local_subnet": "10.0.1.0/24", "peer_subnet": "10.1.1.0/24", "tunnel_status": "DOWN", "tunnel_down_reason": "Peer not responding"}
+			   ]}]}
+			]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	status, err := getPolicyIPSecVpnSessionStatus(connector, sessionPath, &enforcementPointPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statistics, err := getPolicyIPSecVpnSessionStatistics(connector, sessionPath, &enforcementPointPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtPolicyIPSecVpnSessionStatus().Schema, map[string]interface{}{})
+	setPolicyIPSecVpnSessionStatusInSchema(d, status)
+	if err := setPolicyIPSecVpnSessionStatisticsInSchema(d, statistics); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Get("runtime_status").(string) != "DEGRADED" || d.Get("ike_status").(string) != "UP" || d.Get("failed_tunnels").(int) != 1 {
+		t.Errorf("Unexpected session status %v, %v", d.Get("runtime_status"), d.Get("ike_status"))
+	}
+	if d.Get("traffic_counters.0.bytes_out").(int) != 200 {
+		t.Errorf("Unexpected traffic counters %v", d.Get("traffic_counters"))
+	}
+	if d.Get("ike_traffic.0.fail_count").(int) != 3 {
+		t.Errorf("Unexpected IKE traffic %v", d.Get("ike_traffic"))
+	}
+	if d.Get("tunnel.#").(int) != 2 {
+		t.Fatalf("Expected 2 tunnels, got %d", d.Get("tunnel.#").(int))
+	}
+	if d.Get("tunnel.1.tunnel_down_reason").(string) != "Peer not responding" || d.Get("tunnel.0.rule_path").(string) != sessionPath+"/rules/r1" {
+		t.Errorf("Unexpected tunnel statistics %v", d.Get("tunnel"))
+	}
+}
+
+func TestWaitForPolicyVpnSessionStatus(t *testing.T) {
+	statuses := []string{"", "DOWN", "UP"}
+	calls := 0
+	err := waitForPolicyVpnSessionStatus("session", "UP", ipSecVpnSessionRuntimeStatusValues, 30, func() (string, error) {
+		status := statuses[calls]
+		calls++
+		return status, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 status checks, got %d", calls)
+	}
+}
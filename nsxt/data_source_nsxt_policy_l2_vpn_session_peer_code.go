@@ -0,0 +1,146 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyL2VpnSessionPeerCode() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyL2VpnSessionPeerCodeRead,
+
+		Schema: map[string]*schema.Schema{
+			"session_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the L2 VPN session in SERVER mode",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"enforcement_point_path": {
+				Type:        schema.TypeString,
+				Description: "Enforcement point to retrieve peer codes from",
+				Optional:    true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to wait for peer codes to be generated",
+				Optional:     true,
+				Default:      120,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"peer_code": {
+				Type:        schema.TypeString,
+				Description: "Peer code of the first transport tunnel",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"transport_tunnel": {
+				Type:        schema.TypeList,
+				Description: "Peer code per transport tunnel",
+				Computed:    true,
+				Sensitive:   true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the transport tunnel",
+							Computed:    true,
+						},
+						"peer_code": {
+							Type:        schema.TypeString,
+							Description: "Peer code to configure client side of the transport tunnel",
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getPolicyL2VpnSessionPeerCodes retrieves peer codes of L2 VPN session transport tunnels by session policy path
+func getPolicyL2VpnSessionPeerCodes(connector client.Connector, sessionPath string, enforcementPointPath *string) ([]model.L2VPNSessionTransportTunnelPeerCode, error) {
+	sessionClient, err := newL2VpnSessionRuntimeClient(sessionPath)
+	if err != nil {
+		return nil, err
+	}
+	result, err := sessionClient.PeerConfig(connector, enforcementPointPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var peerCodes []model.L2VPNSessionTransportTunnelPeerCode
+	converter := bindings.NewTypeConverter()
+	for _, peerConfigValue := range result.Results {
+		peerConfig, errs := converter.ConvertToGolang(peerConfigValue, model.L2VPNSessionPeerConfigNsxtBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		for _, peerCode := range peerConfig.(model.L2VPNSessionPeerConfigNsxt).PeerCodes {
+			if peerCode.PeerCode != nil && *peerCode.PeerCode != "" {
+				peerCodes = append(peerCodes, peerCode)
+			}
+		}
+	}
+	return peerCodes, nil
+}
+
+func dataSourceNsxtPolicyL2VpnSessionPeerCodeRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+	sessionPath := d.Get("session_path").(string)
+	if _, _, err := parseL2VPNSessionPolicyPath(sessionPath); err != nil {
+		return err
+	}
+
+	enforcementPointPath := getOptionalStringPtr(d, "enforcement_point_path")
+
+	// Peer codes are generated once the session is realized on the edge
+	var peerCodes []model.L2VPNSessionTransportTunnelPeerCode
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			peerCodes, err = getPolicyL2VpnSessionPeerCodes(connector, sessionPath, enforcementPointPath)
+			if err != nil {
+				return nil, "", handleDataSourceReadError(d, "L2 VPN Session Peer Code", sessionPath, err)
+			}
+			if len(peerCodes) == 0 {
+				return peerCodes, "PENDING", nil
+			}
+			return peerCodes, "AVAILABLE", nil
+		},
+		Timeout:    time.Duration(d.Get("timeout").(int)) * time.Second,
+		MinTimeout: 1 * time.Second,
+		Delay:      0,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Failed to retrieve peer code for L2 VPN session %s: %v", sessionPath, err)
+	}
+
+	var tunnels []interface{}
+	for _, peerCode := range peerCodes {
+		elem := make(map[string]interface{})
+		elem["path"] = peerCode.TransportTunnelPath
+		elem["peer_code"] = peerCode.PeerCode
+		tunnels = append(tunnels, elem)
+	}
+
+	d.SetId(sessionPath + "/peer-config")
+	d.Set("peer_code", peerCodes[0].PeerCode)
+	return d.Set("transport_tunnel", tunnels)
+}
@@ -0,0 +1,77 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyL2VpnSessionPeerCode_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_l2_vpn_session_peer_code.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_CLUSTER")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL2VpnSessionMinimalistic(false) + `
+data "nsxt_policy_l2_vpn_session_peer_code" "test" {
+  session_path = nsxt_policy_l2_vpn_session.test.path
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "peer_code"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_tunnel.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "transport_tunnel.0.path"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetPolicyL2VpnSessionPeerCodes(t *testing.T) {
+	sessionPath := "/infra/tier-0s/t0/l2vpn-services/svc/sessions/s1"
+	tunnelPath := "/infra/tier-0s/t0/ipsec-vpn-services/svc/sessions/ipsec1"
+	peerCode := ""
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/policy/api/v1"+sessionPath+"/peer-config" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeTestJSONResponse(w, http.StatusOK, `{"intent_path": "`+sessionPath+`", "results": [
+		  {"resource_type": "L2VPNSessionPeerConfigNsxT", "peer_codes": [
+		    {"transport_tunnel_path": "`+tunnelPath+`", "peer_code": "`+peerCode+`"}
+		  ]}
+		]}`)
+	})
+
+	// Peer code is empty until the session is realized
+	peerCodes, err := getPolicyL2VpnSessionPeerCodes(connector, sessionPath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peerCodes) != 0 {
+		t.Errorf("Expected no peer codes, got %v", peerCodes)
+	}
+
+	peerCode = "MCxkNjc4"
+	peerCodes, err = getPolicyL2VpnSessionPeerCodes(connector, sessionPath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peerCodes) != 1 || *peerCodes[0].PeerCode != peerCode || *peerCodes[0].TransportTunnelPath != tunnelPath {
+		t.Errorf("Unexpected peer codes %v", peerCodes)
+	}
+
+	if _, err := getPolicyL2VpnSessionPeerCodes(connector, "/infra/tier-0s/t0/l2vpn-services/svc/sessions/s2", nil); err == nil {
+		t.Errorf("Expected error for non-existing session")
+	}
+}
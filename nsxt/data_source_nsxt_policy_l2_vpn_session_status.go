@@ -0,0 +1,386 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t0_l2vpn_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/l2vpn_services/sessions"
+	t0_l2vpn_nested_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/l2vpn_services/sessions"
+	t1_l2vpn_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/l2vpn_services/sessions"
+	t1_l2vpn_nested_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services/l2vpn_services/sessions"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var l2VpnSessionRuntimeStatusValues = []string{
+	model.L2VPNSessionStatusNsxt_RUNTIME_STATUS_UP,
+	model.L2VPNSessionStatusNsxt_RUNTIME_STATUS_DOWN,
+}
+
+func getL2VpnTrafficCountersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"bytes_in":              getComputedIntSchema("Number of bytes received"),
+		"bytes_out":             getComputedIntSchema("Number of bytes sent"),
+		"packets_in":            getComputedIntSchema("Number of packets received"),
+		"packets_out":           getComputedIntSchema("Number of packets sent"),
+		"packets_receive_error": getComputedIntSchema("Number of incoming packets dropped"),
+		"packets_sent_error":    getComputedIntSchema("Number of outgoing packets dropped"),
+	}
+}
+
+func dataSourceNsxtPolicyL2VpnSessionStatus() *schema.Resource {
+	segmentTrafficSchema := getL2VpnTrafficCountersSchema()
+	segmentTrafficSchema["segment_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Policy path of the segment",
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyL2VpnSessionStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"session_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the L2 VPN session",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"enforcement_point_path": {
+				Type:        schema.TypeString,
+				Description: "Enforcement point to retrieve status from",
+				Optional:    true,
+			},
+			"wait_for_status": getVpnSessionStatusWaitSchema(l2VpnSessionRuntimeStatusValues),
+			"timeout":         getVpnSessionStatusTimeoutSchema(),
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Display name of the session",
+				Computed:    true,
+			},
+			"runtime_status": {
+				Type:        schema.TypeString,
+				Description: "Runtime status of the session",
+				Computed:    true,
+			},
+			"transport_tunnel": {
+				Type:        schema.TypeList,
+				Description: "Status of IPSec transport tunnels of the session",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the transport tunnel",
+							Computed:    true,
+						},
+						"runtime_status": {
+							Type:        schema.TypeString,
+							Description: "Runtime status of the transport tunnel",
+							Computed:    true,
+						},
+						"ike_status": {
+							Type:        schema.TypeString,
+							Description: "State of the IKE session",
+							Computed:    true,
+						},
+						"ike_fail_reason": {
+							Type:        schema.TypeString,
+							Description: "Reason of IKE session failure",
+							Computed:    true,
+						},
+						"total_tunnels":      getComputedIntSchema("Total number of tunnels"),
+						"negotiated_tunnels": getComputedIntSchema("Number of negotiated tunnels"),
+						"failed_tunnels":     getComputedIntSchema("Number of failed tunnels"),
+					},
+				},
+			},
+			"tap_traffic": {
+				Type:        schema.TypeList,
+				Description: "Traffic counters per tap interface of the session",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: getL2VpnTrafficCountersSchema(),
+				},
+			},
+			"segment_traffic": {
+				Type:        schema.TypeList,
+				Description: "Traffic counters per segment stretched by the session",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: segmentTrafficSchema,
+				},
+			},
+		},
+	}
+}
+
+func parseL2VPNSessionPolicyPath(path string) (string, string, error) {
+	// Path should be like <service path>/sessions/<session id>
+	segs := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	segCount := len(segs)
+	if segCount < 3 || segs[segCount-2] != "sessions" {
+		return "", "", fmt.Errorf("Invalid L2 VPN session path %s", path)
+	}
+	servicePath := strings.Join(segs[:segCount-2], "/")
+	if _, _, _, _, err := parseL2VPNServicePolicyPath(servicePath); err != nil {
+		return "", "", fmt.Errorf("Invalid L2 VPN session path %s", path)
+	}
+
+	return servicePath, segs[segCount-1], nil
+}
+
+type l2vpnSessionRuntimeClient struct {
+	isT0            bool
+	gwID            string
+	localeServiceID string
+	serviceID       string
+	sessionID       string
+}
+
+func newL2VpnSessionRuntimeClient(sessionPath string) (*l2vpnSessionRuntimeClient, error) {
+	servicePath, sessionID, err := parseL2VPNSessionPolicyPath(sessionPath)
+	if err != nil {
+		return nil, err
+	}
+	isT0, gwID, localeServiceID, serviceID, err := parseL2VPNServicePolicyPath(servicePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &l2vpnSessionRuntimeClient{
+		isT0:            isT0,
+		gwID:            gwID,
+		localeServiceID: localeServiceID,
+		serviceID:       serviceID,
+		sessionID:       sessionID,
+	}, nil
+}
+
+func (c *l2vpnSessionRuntimeClient) DetailedStatus(connector client.Connector, enforcementPointPath *string) (model.AggregateL2VPNSessionStatus, error) {
+	if c.isT0 {
+		if len(c.localeServiceID) > 0 {
+			client := t0_l2vpn_nested_sessions.NewDetailedStatusClient(connector)
+			return client.Get(c.gwID, c.localeServiceID, c.serviceID, c.sessionID, enforcementPointPath, nil)
+		}
+		client := t0_l2vpn_sessions.NewDetailedStatusClient(connector)
+		return client.Get(c.gwID, c.serviceID, c.sessionID, enforcementPointPath, nil)
+	}
+
+	if len(c.localeServiceID) > 0 {
+		client := t1_l2vpn_nested_sessions.NewDetailedStatusClient(connector)
+		return client.Get(c.gwID, c.localeServiceID, c.serviceID, c.sessionID, enforcementPointPath, nil)
+	}
+	client := t1_l2vpn_sessions.NewDetailedStatusClient(connector)
+	return client.Get(c.gwID, c.serviceID, c.sessionID, enforcementPointPath, nil)
+}
+
+func (c *l2vpnSessionRuntimeClient) Statistics(connector client.Connector, enforcementPointPath *string) (model.AggregateL2VPNSessionStatistics, error) {
+	if c.isT0 {
+		if len(c.localeServiceID) > 0 {
+			client := t0_l2vpn_nested_sessions.NewStatisticsClient(connector)
+			return client.Get(c.gwID, c.localeServiceID, c.serviceID, c.sessionID, enforcementPointPath, nil)
+		}
+		client := t0_l2vpn_sessions.NewStatisticsClient(connector)
+		return client.Get(c.gwID, c.serviceID, c.sessionID, enforcementPointPath, nil)
+	}
+
+	if len(c.localeServiceID) > 0 {
+		client := t1_l2vpn_nested_sessions.NewStatisticsClient(connector)
+		return client.Get(c.gwID, c.localeServiceID, c.serviceID, c.sessionID, enforcementPointPath, nil)
+	}
+	client := t1_l2vpn_sessions.NewStatisticsClient(connector)
+	return client.Get(c.gwID, c.serviceID, c.sessionID, enforcementPointPath, nil)
+}
+
+func (c *l2vpnSessionRuntimeClient) PeerConfig(connector client.Connector, enforcementPointPath *string) (model.AggregateL2VPNSessionPeerConfig, error) {
+	if c.isT0 {
+		if len(c.localeServiceID) > 0 {
+			client := t0_l2vpn_nested_sessions.NewPeerConfigClient(connector)
+			return client.Get(c.gwID, c.localeServiceID, c.serviceID, c.sessionID, enforcementPointPath)
+		}
+		client := t0_l2vpn_sessions.NewPeerConfigClient(connector)
+		return client.Get(c.gwID, c.serviceID, c.sessionID, enforcementPointPath)
+	}
+
+	if len(c.localeServiceID) > 0 {
+		client := t1_l2vpn_nested_sessions.NewPeerConfigClient(connector)
+		return client.Get(c.gwID, c.localeServiceID, c.serviceID, c.sessionID, enforcementPointPath)
+	}
+	client := t1_l2vpn_sessions.NewPeerConfigClient(connector)
+	return client.Get(c.gwID, c.serviceID, c.sessionID, enforcementPointPath)
+}
+
+// getPolicyL2VpnSessionStatus retrieves runtime status of L2 VPN session by policy path.
+// nil is returned if the status is not yet available on the enforcement point.
+func getPolicyL2VpnSessionStatus(connector client.Connector, sessionPath string, enforcementPointPath *string) (*model.L2VPNSessionStatusNsxt, error) {
+	sessionClient, err := newL2VpnSessionRuntimeClient(sessionPath)
+	if err != nil {
+		return nil, err
+	}
+	result, err := sessionClient.DetailedStatus(connector, enforcementPointPath)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := bindings.NewTypeConverter()
+	for _, statusValue := range result.Results {
+		status, errs := converter.ConvertToGolang(statusValue, model.L2VPNSessionStatusNsxtBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		sessionStatus := status.(model.L2VPNSessionStatusNsxt)
+		return &sessionStatus, nil
+	}
+	return nil, nil
+}
+
+// getPolicyL2VpnSessionStatistics retrieves traffic statistics of L2 VPN session by policy path.
+// nil is returned if statistics are not yet available on the enforcement point.
+func getPolicyL2VpnSessionStatistics(connector client.Connector, sessionPath string, enforcementPointPath *string) (*model.L2VPNSessionStatisticsNsxt, error) {
+	sessionClient, err := newL2VpnSessionRuntimeClient(sessionPath)
+	if err != nil {
+		return nil, err
+	}
+	result, err := sessionClient.Statistics(connector, enforcementPointPath)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := bindings.NewTypeConverter()
+	for _, statisticsValue := range result.Results {
+		statistics, errs := converter.ConvertToGolang(statisticsValue, model.L2VPNSessionStatisticsNsxtBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		sessionStatistics := statistics.(model.L2VPNSessionStatisticsNsxt)
+		return &sessionStatistics, nil
+	}
+	return nil, nil
+}
+
+func setPolicyL2VpnSessionStatusInSchema(d *schema.ResourceData, status *model.L2VPNSessionStatusNsxt) error {
+	var tunnels []interface{}
+	if status == nil {
+		d.Set("runtime_status", "")
+		return d.Set("transport_tunnel", tunnels)
+	}
+
+	d.Set("display_name", status.DisplayName)
+	d.Set("runtime_status", status.RuntimeStatus)
+
+	converter := bindings.NewTypeConverter()
+	for _, tunnelValue := range status.TransportTunnels {
+		baseObj, errs := converter.ConvertToGolang(tunnelValue, model.L2VPNSessionTransportTunnelStatusBindingType())
+		if len(errs) > 0 {
+			return errs[0]
+		}
+		if baseObj.(model.L2VPNSessionTransportTunnelStatus).ResourceType != model.L2VPNSessionTransportTunnelStatus_RESOURCE_TYPE_IPSECVPNTRANSPORTSTATUS {
+			continue
+		}
+		tunnelObj, errs := converter.ConvertToGolang(tunnelValue, model.IPSecVpnTransportStatusBindingType())
+		if len(errs) > 0 {
+			return errs[0]
+		}
+		tunnel := tunnelObj.(model.IPSecVpnTransportStatus)
+		elem := make(map[string]interface{})
+		elem["path"] = tunnel.TransportTunnelPath
+		if tunnel.SessionStatus != nil {
+			elem["runtime_status"] = tunnel.SessionStatus.RuntimeStatus
+			elem["total_tunnels"] = tunnel.SessionStatus.TotalTunnels
+			elem["negotiated_tunnels"] = tunnel.SessionStatus.NegotiatedTunnels
+			elem["failed_tunnels"] = tunnel.SessionStatus.FailedTunnels
+			if tunnel.SessionStatus.IkeStatus != nil {
+				elem["ike_status"] = tunnel.SessionStatus.IkeStatus.IkeSessionState
+				elem["ike_fail_reason"] = tunnel.SessionStatus.IkeStatus.FailReason
+			}
+		}
+		tunnels = append(tunnels, elem)
+	}
+
+	return d.Set("transport_tunnel", tunnels)
+}
+
+func setPolicyL2VpnSessionStatisticsInSchema(d *schema.ResourceData, statistics *model.L2VPNSessionStatisticsNsxt) error {
+	var tapTraffic []interface{}
+	var segmentTraffic []interface{}
+	if statistics != nil {
+		for _, tap := range statistics.TapTrafficCounters {
+			elem := make(map[string]interface{})
+			elem["bytes_in"] = tap.BytesIn
+			elem["bytes_out"] = tap.BytesOut
+			elem["packets_in"] = tap.PacketsIn
+			elem["packets_out"] = tap.PacketsOut
+			elem["packets_receive_error"] = tap.PacketsReceiveError
+			elem["packets_sent_error"] = tap.PacketsSentError
+			tapTraffic = append(tapTraffic, elem)
+		}
+		for _, segment := range statistics.TrafficStatisticsPerSegment {
+			elem := make(map[string]interface{})
+			elem["segment_path"] = segment.SegmentPath
+			elem["bytes_in"] = segment.BytesIn
+			elem["bytes_out"] = segment.BytesOut
+			elem["packets_in"] = segment.PacketsIn
+			elem["packets_out"] = segment.PacketsOut
+			elem["packets_receive_error"] = segment.PacketsReceiveError
+			elem["packets_sent_error"] = segment.PacketsSentError
+			segmentTraffic = append(segmentTraffic, elem)
+		}
+	}
+
+	d.Set("tap_traffic", tapTraffic)
+	return d.Set("segment_traffic", segmentTraffic)
+}
+
+func dataSourceNsxtPolicyL2VpnSessionStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+	sessionPath := d.Get("session_path").(string)
+	if _, _, err := parseL2VPNSessionPolicyPath(sessionPath); err != nil {
+		return err
+	}
+
+	enforcementPointPath := getOptionalStringPtr(d, "enforcement_point_path")
+	getStatus := func() (*model.L2VPNSessionStatusNsxt, error) {
+		status, err := getPolicyL2VpnSessionStatus(connector, sessionPath, enforcementPointPath)
+		if err != nil {
+			return nil, handleDataSourceReadError(d, "L2 VPN Session Status", sessionPath, err)
+		}
+		return status, nil
+	}
+
+	var status *model.L2VPNSessionStatusNsxt
+	var err error
+	if waitForStatus := d.Get("wait_for_status").(string); waitForStatus != "" {
+		err = waitForPolicyVpnSessionStatus(sessionPath, waitForStatus, l2VpnSessionRuntimeStatusValues, d.Get("timeout").(int), func() (string, error) {
+			status, err = getStatus()
+			if err != nil || status == nil || status.RuntimeStatus == nil {
+				return "", err
+			}
+			return *status.RuntimeStatus, nil
+		})
+	} else {
+		status, err = getStatus()
+	}
+	if err != nil {
+		return err
+	}
+
+	statistics, err := getPolicyL2VpnSessionStatistics(connector, sessionPath, enforcementPointPath)
+	if err != nil {
+		return handleDataSourceReadError(d, "L2 VPN Session Statistics", sessionPath, err)
+	}
+
+	d.SetId(sessionPath + "/detailed-status")
+	if err := setPolicyL2VpnSessionStatusInSchema(d, status); err != nil {
+		return err
+	}
+	return setPolicyL2VpnSessionStatisticsInSchema(d, statistics)
+}
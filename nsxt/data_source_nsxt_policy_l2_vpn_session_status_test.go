@@ -0,0 +1,105 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtPolicyL2VpnSessionStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_l2_vpn_session_status.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_CLUSTER")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL2VpnSessionMinimalistic(false) + `
+data "nsxt_policy_l2_vpn_session_status" "test" {
+  session_path = nsxt_policy_l2_vpn_session.test.path
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "runtime_status"),
+					resource.TestCheckResourceAttrSet(testResourceName, "transport_tunnel.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseL2VPNSessionPolicyPath(t *testing.T) {
+	servicePath, sessionID, err := parseL2VPNSessionPolicyPath("/infra/tier-0s/t0/l2vpn-services/svc/sessions/s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if servicePath != "/infra/tier-0s/t0/l2vpn-services/svc" || sessionID != "s1" {
+		t.Errorf("Unexpected parse result %s, %s", servicePath, sessionID)
+	}
+
+	if _, _, err := parseL2VPNSessionPolicyPath("/infra/tier-0s/t0/ipsec-vpn-services/svc/sessions/s1"); err == nil {
+		t.Errorf("Expected error for IPSec VPN session path")
+	}
+}
+
+func TestPolicyL2VpnSessionStatus(t *testing.T) {
+	sessionPath := "/infra/tier-0s/t0/locale-services/default/l2vpn-services/svc/sessions/s1"
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/policy/api/v1" + sessionPath + "/detailed-status":
+			writeTestJSONResponse(w, http.StatusOK, `{"intent_path": "`+sessionPath+`", "results": [
+			  {"resource_type": "L2VPNSessionStatusNsxT", "runtime_status": "DOWN", "transport_tunnels": [
+			    {"resource_type": "IPSecVpnTransportStatus", "transport_tunnel_path": "/infra/tier-0s/t0/ipsec-vpn-services/svc/sessions/ipsec1",
+			     "session_status": {"resource_type": "IPSecVpnSessionStatusNsxT", "runtime_status": "DOWN",
+			       "ike_status": {"ike_session_state": "NEGOTIATING", "fail_reason": "No proposal chosen"}}}
+			  ]}
+			]}`)
+		case "/policy/api/v1" + sessionPath + "/statistics":
+			writeTestJSONResponse(w, http.StatusOK, `{"intent_path": "`+sessionPath+`", "results": [
+			  {"resource_type": "L2VPNSessionStatisticsNsxT", "tap_traffic_counters": [{"bytes_in": 5, "packets_sent_error": 1}],
+			   "traffic_statistics_per_segment": [{"segment_path": "/infra/segments/s1", "bytes_out": 42}]}
+			]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	status, err := getPolicyL2VpnSessionStatus(connector, sessionPath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statistics, err := getPolicyL2VpnSessionStatistics(connector, sessionPath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtPolicyL2VpnSessionStatus().Schema, map[string]interface{}{})
+	if err := setPolicyL2VpnSessionStatusInSchema(d, status); err != nil {
+		t.Fatal(err)
+	}
+	if err := setPolicyL2VpnSessionStatisticsInSchema(d, statistics); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Get("runtime_status").(string) != "DOWN" {
+		t.Errorf("Unexpected runtime status %v", d.Get("runtime_status"))
+	}
+	if d.Get("transport_tunnel.#").(int) != 1 || d.Get("transport_tunnel.0.ike_fail_reason").(string) != "No proposal chosen" {
+		t.Errorf("Unexpected transport tunnels %v", d.Get("transport_tunnel"))
+	}
+	if d.Get("tap_traffic.0.bytes_in").(int) != 5 || d.Get("tap_traffic.0.packets_sent_error").(int) != 1 {
+		t.Errorf("Unexpected tap traffic %v", d.Get("tap_traffic"))
+	}
+	if d.Get("segment_traffic.0.segment_path").(string) != "/infra/segments/s1" || d.Get("segment_traffic.0.bytes_out").(int) != 42 {
+		t.Errorf("Unexpected segment traffic %v", d.Get("segment_traffic"))
+	}
+}
@@ -0,0 +1,182 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t0_nat_rules "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/nat/nat_rules"
+	t1_nat_rules "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/nat/nat_rules"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_t1_nat_rules "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/tier_1s/nat/nat_rules"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func dataSourceNsxtPolicyNATRuleStatistics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyNATRuleStatisticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"context":      getContextSchema(false, false, false),
+			"gateway_path": getPolicyPathSchema(true, false, "Policy path of Tier0 or Tier1 gateway"),
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "NAT section of the gateway",
+				Optional:     true,
+				Default:      model.PolicyNat_NAT_TYPE_USER,
+				ValidateFunc: validation.StringInSlice(policyNATRuleTypeValues, false),
+			},
+			"rule_paths": {
+				Type:        schema.TypeSet,
+				Description: "Retrieve statistics only for rules with these paths",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+			"enforcement_point_path": {
+				Type:        schema.TypeString,
+				Description: "Enforcement point to retrieve statistics from",
+				Optional:    true,
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "Statistics per NAT rule",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the NAT rule",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the NAT rule",
+							Computed:    true,
+						},
+						"active_sessions":       getComputedIntSchema("Number of active sessions matching the rule"),
+						"total_bytes":           getComputedIntSchema("Number of bytes processed by the rule"),
+						"total_packets":         getComputedIntSchema("Number of packets processed by the rule"),
+						"last_update_timestamp": getComputedIntSchema("Timestamp of the statistics, in ms"),
+						"warning_message": {
+							Type:        schema.TypeString,
+							Description: "Warning reported with the statistics, if any",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getPolicyNATRuleStatistics retrieves statistics of NAT rule, with counters summed over all
+// enforcement points and edge nodes reporting the rule. Gateway level NAT statistics API only
+// reports totals per gateway, hence statistics are retrieved per rule.
+// nil is returned if statistics are not yet available for the rule.
+func getPolicyNATRuleStatistics(sessionContext utl.SessionContext, connector client.Connector, gwID string, isT0 bool, natType string, rule model.PolicyNatRule, enforcementPointPath *string) (map[string]interface{}, error) {
+	var result model.PolicyNatRuleStatisticsListResult
+	var err error
+	if isT0 {
+		client := t0_nat_rules.NewStatisticsClient(connector)
+		result, err = client.List(gwID, natType, *rule.Id, nil, nil, enforcementPointPath)
+	} else if sessionContext.ClientType == utl.Multitenancy {
+		client := project_t1_nat_rules.NewStatisticsClient(connector)
+		result, err = client.List(utl.DefaultOrgID, sessionContext.ProjectID, gwID, natType, *rule.Id, nil, nil, enforcementPointPath)
+	} else {
+		client := t1_nat_rules.NewStatisticsClient(connector)
+		result, err = client.List(gwID, natType, *rule.Id, nil, nil, enforcementPointPath)
+	}
+	if err != nil || len(result.Results) == 0 {
+		return nil, err
+	}
+
+	elem := map[string]interface{}{
+		"path":                  *rule.Path,
+		"active_sessions":       int64(0),
+		"total_bytes":           int64(0),
+		"total_packets":         int64(0),
+		"last_update_timestamp": int64(0),
+		"warning_message":       "",
+	}
+	for _, epStatistics := range result.Results {
+		addPolicyNATRuleStatistics(elem, epStatistics.RuleStatistics)
+	}
+	return elem, nil
+}
+
+func addPolicyNATRuleStatistics(elem map[string]interface{}, statisticsList []model.PolicyNatRuleStatistics) {
+	var warnings []string
+	if elem["warning_message"].(string) != "" {
+		warnings = append(warnings, elem["warning_message"].(string))
+	}
+	for _, statistics := range statisticsList {
+		if statistics.ActiveSessions != nil {
+			elem["active_sessions"] = elem["active_sessions"].(int64) + *statistics.ActiveSessions
+		}
+		if statistics.TotalBytes != nil {
+			elem["total_bytes"] = elem["total_bytes"].(int64) + *statistics.TotalBytes
+		}
+		if statistics.TotalPackets != nil {
+			elem["total_packets"] = elem["total_packets"].(int64) + *statistics.TotalPackets
+		}
+		if statistics.LastUpdateTimestamp != nil && *statistics.LastUpdateTimestamp > elem["last_update_timestamp"].(int64) {
+			elem["last_update_timestamp"] = *statistics.LastUpdateTimestamp
+		}
+		if statistics.WarningMessage != nil && *statistics.WarningMessage != "" {
+			warnings = append(warnings, *statistics.WarningMessage)
+		}
+	}
+	elem["warning_message"] = strings.Join(warnings, "; ")
+}
+
+func dataSourceNsxtPolicyNATRuleStatisticsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	connector := getPolicyConnector(m)
+	context, gwID, isT0, err := getPolicyNATRulesGateway(d, m)
+	if err != nil {
+		return err
+	}
+	natType := d.Get("type").(string)
+
+	enforcementPointPath := getOptionalStringPtr(d, "enforcement_point_path")
+	rulePaths := make(map[string]bool)
+	for _, path := range d.Get("rule_paths").(*schema.Set).List() {
+		rulePaths[path.(string)] = true
+	}
+
+	rules, err := listNsxtPolicyNATRules(context, connector, gwID, isT0, natType)
+	if err != nil {
+		return handleListError("NAT Rules", err)
+	}
+
+	sectionPath := getPolicyNATRulesID(d.Get("gateway_path").(string), natType)
+	var ruleList []interface{}
+	for _, rule := range rules {
+		if rule.Id == nil || rule.Path == nil || (len(rulePaths) > 0 && !rulePaths[*rule.Path]) {
+			continue
+		}
+		elem, err := getPolicyNATRuleStatistics(context, connector, gwID, isT0, natType, rule, enforcementPointPath)
+		if err != nil && !isNotFoundError(err) {
+			return handleDataSourceReadError(d, "NAT Rule Statistics", *rule.Path, err)
+		}
+		if elem == nil {
+			// Statistics are not yet reported for this rule
+			elem = map[string]interface{}{"path": *rule.Path}
+		}
+		elem["display_name"] = rule.DisplayName
+		ruleList = append(ruleList, elem)
+	}
+
+	d.SetId(sectionPath + "/statistics")
+	return d.Set("rule", ruleList)
+}
@@ -0,0 +1,93 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func TestAccDataSourceNsxtPolicyNATRuleStatistics_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "data.nsxt_policy_nat_rule_statistics.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyNATRulesTier1Template(name, true) + `
+data "nsxt_policy_nat_rule_statistics" "test" {
+  gateway_path = nsxt_policy_nat_rules.test.gateway_path
+  rule_paths   = [nsxt_policy_nat_rules.test.rule.1.path]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", name+"-dnat"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.total_packets"),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyNATRuleStatistics(t *testing.T) {
+	sectionPath := "/orgs/default/projects/p1/infra/tier-1s/t1/nat/USER"
+	rulePath := sectionPath + "/nat-rules/r1"
+	otherRulePath := sectionPath + "/nat-rules/r2"
+	context := utl.SessionContext{ProjectID: "p1", ClientType: utl.Multitenancy}
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/policy/api/v1" + rulePath + "/statistics":
+			writeTestJSONResponse(w, http.StatusOK, `{"results": [
+			  {"enforcement_point_path": "/infra/sites/default/enforcement-points/default", "rule_path": "`+rulePath+`",
+			   "rule_statistics": [
+			     {"active_sessions": 3, "total_bytes": 1000, "total_packets": 10, "last_update_timestamp": 1700000000000},
+			     {"active_sessions": 1, "total_bytes": 500, "total_packets": 5, "last_update_timestamp": 1700000005000,
+			      "warning_message": "edge node unreachable"}
+			   ]}
+			]}`)
+		case "/policy/api/v1" + otherRulePath + "/statistics":
+			writeTestJSONResponse(w, http.StatusOK, `{"results": []}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ruleID := "r1"
+	rule := model.PolicyNatRule{Id: &ruleID, Path: &rulePath}
+	elem, err := getPolicyNATRuleStatistics(context, connector, "t1", false, "USER", rule, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elem["active_sessions"].(int64) != 4 || elem["total_bytes"].(int64) != 1500 || elem["total_packets"].(int64) != 15 {
+		t.Errorf("Unexpected counters %v, %v, %v", elem["active_sessions"], elem["total_bytes"], elem["total_packets"])
+	}
+	if elem["last_update_timestamp"].(int64) != 1700000005000 {
+		t.Errorf("Unexpected timestamp %v", elem["last_update_timestamp"])
+	}
+	if elem["warning_message"].(string) != "edge node unreachable" || elem["path"].(string) != rulePath {
+		t.Errorf("Unexpected rule statistics %v", elem)
+	}
+
+	otherRuleID := "r2"
+	otherRule := model.PolicyNatRule{Id: &otherRuleID, Path: &otherRulePath}
+	elem, err = getPolicyNATRuleStatistics(context, connector, "t1", false, "USER", otherRule, nil)
+	if err != nil || elem != nil {
+		t.Errorf("Expected no statistics for rule r2, got %v, %v", elem, err)
+	}
+
+	if _, err := getPolicyNATRuleStatistics(context, connector, "t1", false, "NAT64", rule, nil); err == nil {
+		t.Errorf("Expected error for missing section")
+	}
+}
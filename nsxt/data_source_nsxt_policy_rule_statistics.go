@@ -0,0 +1,205 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_gateway_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/gateway_policies"
+	gm_security_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/security_policies"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	global_gateway_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/global_infra/domains/gateway_policies"
+	global_security_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/global_infra/domains/security_policies"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/gateway_policies"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/security_policies"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_gateway_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/domains/gateway_policies"
+	project_security_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/domains/security_policies"
+	vpc_gateway_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/gateway_policies"
+	vpc_security_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/security_policies"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func dataSourceNsxtPolicyRuleStatistics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyRuleStatisticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of security policy or gateway policy",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"enforcement_point_path": {
+				Type:        schema.TypeString,
+				Description: "Enforcement point to retrieve statistics from",
+				Optional:    true,
+			},
+			"gateway_path": {
+				Type:         schema.TypeString,
+				Description:  "Retrieve statistics only for rules applied on this gateway",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "Statistics per rule",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the rule",
+							Computed:    true,
+						},
+						"internal_rule_id": {
+							Type:        schema.TypeString,
+							Description: "Realized id of the rule",
+							Computed:    true,
+						},
+						"enforcement_point": {
+							Type:        schema.TypeString,
+							Description: "Enforcement point statistics were retrieved from",
+							Computed:    true,
+						},
+						"gateway_path": {
+							Type:        schema.TypeString,
+							Description: "Gateway the rule is applied on, for gateway policy rules",
+							Computed:    true,
+						},
+						"hit_count":            getComputedIntSchema("Aggregated number of hits received by the rule"),
+						"packet_count":         getComputedIntSchema("Aggregated number of packets processed by the rule"),
+						"byte_count":           getComputedIntSchema("Aggregated number of bytes processed by the rule"),
+						"session_count":        getComputedIntSchema("Aggregated number of sessions processed by the rule"),
+						"max_session_count":    getComputedIntSchema("Maximum value of session count of all rules of the type"),
+						"total_session_count":  getComputedIntSchema("Aggregated number of sessions processed by all rules"),
+						"popularity_index":     getComputedIntSchema("Session count divided by age of the rule"),
+						"max_popularity_index": getComputedIntSchema("Maximum value of popularity index of all rules of the type"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// listPolicyRuleStatistics retrieves statistics of security policy or gateway policy,
+// using SDK client that matches policy context
+func listPolicyRuleStatistics(sessionContext utl.SessionContext, connector client.Connector, policyPath string, enforcementPointPath *string) (model.SecurityPolicyStatisticsListResult, error) {
+	var result model.SecurityPolicyStatisticsListResult
+	isGatewayPolicy := strings.Contains(policyPath, "/gateway-policies/")
+	parents, err := parseStandardPolicyPath(policyPath)
+	if err != nil {
+		return result, err
+	}
+	if len(parents) < 2 {
+		return result, fmt.Errorf("Unexpected policy path %s", policyPath)
+	}
+	// Domain id for infra policies, VPC id for VPC policies
+	parentID := parents[len(parents)-2]
+	policyID := parents[len(parents)-1]
+
+	switch sessionContext.ClientType {
+	case utl.Local:
+		if strings.HasPrefix(policyPath, "/global-infra/") {
+			// Policy created on Global Manager, statistics are retrieved from local realization
+			if isGatewayPolicy {
+				return global_gateway_policies.NewStatisticsClient(connector).List(parentID, policyID, nil, enforcementPointPath)
+			}
+			return global_security_policies.NewStatisticsClient(connector).List(parentID, policyID, nil, enforcementPointPath)
+		}
+		if isGatewayPolicy {
+			return gateway_policies.NewStatisticsClient(connector).List(parentID, policyID, nil, enforcementPointPath)
+		}
+		return security_policies.NewStatisticsClient(connector).List(parentID, policyID, nil, enforcementPointPath)
+	case utl.Multitenancy:
+		if isGatewayPolicy {
+			return project_gateway_policies.NewStatisticsClient(connector).List(utl.DefaultOrgID, sessionContext.ProjectID, parentID, policyID, nil, enforcementPointPath)
+		}
+		return project_security_policies.NewStatisticsClient(connector).List(utl.DefaultOrgID, sessionContext.ProjectID, parentID, policyID, nil, enforcementPointPath)
+	case utl.VPC:
+		if isGatewayPolicy {
+			return vpc_gateway_policies.NewStatisticsClient(connector).List(utl.DefaultOrgID, sessionContext.ProjectID, sessionContext.VPCID, policyID, nil, enforcementPointPath)
+		}
+		return vpc_security_policies.NewStatisticsClient(connector).List(utl.DefaultOrgID, sessionContext.ProjectID, sessionContext.VPCID, policyID, nil, enforcementPointPath)
+	case utl.Global:
+		var gmResult gm_model.SecurityPolicyStatisticsListResult
+		if isGatewayPolicy {
+			gmResult, err = gm_gateway_policies.NewStatisticsClient(connector).List(parentID, policyID, nil, enforcementPointPath)
+		} else {
+			gmResult, err = gm_security_policies.NewStatisticsClient(connector).List(parentID, policyID, nil, enforcementPointPath)
+		}
+		if err != nil {
+			return result, err
+		}
+		converted, err := convertModelBindingType(gmResult, gm_model.SecurityPolicyStatisticsListResultBindingType(), model.SecurityPolicyStatisticsListResultBindingType())
+		if err != nil {
+			return result, err
+		}
+		return converted.(model.SecurityPolicyStatisticsListResult), nil
+	}
+
+	return result, fmt.Errorf("Unsupported client type for policy path %s", policyPath)
+}
+
+func dataSourceNsxtPolicyRuleStatisticsRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	policyPath := d.Get("policy_path").(string)
+	gatewayPath := d.Get("gateway_path").(string)
+
+	if !strings.Contains(policyPath, "/security-policies/") && !strings.Contains(policyPath, "/gateway-policies/") {
+		return fmt.Errorf("Policy path %s is expected to point to security policy or gateway policy", policyPath)
+	}
+
+	var enforcementPointPath *string
+	if value := d.Get("enforcement_point_path").(string); value != "" {
+		enforcementPointPath = &value
+	}
+
+	stats, err := listPolicyRuleStatistics(getParentContext(d, m, policyPath), connector, policyPath, enforcementPointPath)
+	if err != nil {
+		return handleDataSourceReadError(d, "Policy Rule Statistics", policyPath, err)
+	}
+
+	d.SetId(policyPath)
+	return setPolicyRuleStatisticsInSchema(d, stats, gatewayPath)
+}
+
+func setPolicyRuleStatisticsInSchema(d *schema.ResourceData, stats model.SecurityPolicyStatisticsListResult, gatewayPath string) error {
+	var rules []interface{}
+	for _, epStats := range stats.Results {
+		if epStats.Statistics == nil {
+			continue
+		}
+		for _, ruleStats := range epStats.Statistics.Results {
+			ruleGatewayPath := epStats.Statistics.LrPath
+			if ruleStats.LrPath != nil {
+				ruleGatewayPath = ruleStats.LrPath
+			}
+			if gatewayPath != "" && (ruleGatewayPath == nil || *ruleGatewayPath != gatewayPath) {
+				continue
+			}
+			elem := make(map[string]interface{})
+			elem["path"] = ruleStats.Rule
+			elem["internal_rule_id"] = ruleStats.InternalRuleId
+			elem["enforcement_point"] = epStats.EnforcementPoint
+			elem["gateway_path"] = ruleGatewayPath
+			elem["hit_count"] = ruleStats.HitCount
+			elem["packet_count"] = ruleStats.PacketCount
+			elem["byte_count"] = ruleStats.ByteCount
+			elem["session_count"] = ruleStats.SessionCount
+			elem["max_session_count"] = ruleStats.MaxSessionCount
+			elem["total_session_count"] = ruleStats.TotalSessionCount
+			elem["popularity_index"] = ruleStats.PopularityIndex
+			elem["max_popularity_index"] = ruleStats.MaxPopularityIndex
+			rules = append(rules, elem)
+		}
+	}
+
+	return d.Set("rule", rules)
+}
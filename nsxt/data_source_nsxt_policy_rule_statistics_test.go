@@ -0,0 +1,93 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func TestAccDataSourceNsxtPolicyRuleStatistics_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_rule_statistics.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyRuleStatisticsTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "rule.0.path", "nsxt_policy_security_policy.test", "rule.0.path"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.hit_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyRuleStatisticsTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_security_policy" "test" {
+  display_name = "%s"
+  category     = "Application"
+
+  rule {
+    display_name = "%s"
+    action       = "ALLOW"
+  }
+}
+
+data "nsxt_policy_rule_statistics" "test" {
+  policy_path = nsxt_policy_security_policy.test.path
+}`, name, name)
+}
+
+func TestPolicyRuleStatistics(t *testing.T) {
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/policy/api/v1/infra/domains/default/gateway-policies/gw/statistics" {
+			writeTestJSONResponse(w, http.StatusNotFound, `{}`)
+			return
+		}
+		writeTestJSONResponse(w, http.StatusOK, `{"result_count": 2, "results": [
+		  {"enforcement_point": "/infra/sites/default/enforcement-points/default",
+		   "statistics": {"lr_path": "/infra/tier-1s/t1", "results": [{"rule": "/infra/domains/default/gateway-policies/gw/rules/r1", "hit_count": 5, "max_popularity_index": 3}]}},
+		  {"enforcement_point": "/infra/sites/default/enforcement-points/default",
+		   "statistics": {"lr_path": "/infra/tier-1s/t2", "results": [{"rule": "/infra/domains/default/gateway-policies/gw/rules/r1", "hit_count": 0}]}}
+		]}`)
+	})
+
+	stats, err := listPolicyRuleStatistics(utl.SessionContext{ClientType: utl.Local}, connector, "/infra/domains/default/gateway-policies/gw", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtPolicyRuleStatistics().Schema, map[string]interface{}{})
+	if err := setPolicyRuleStatisticsInSchema(d, stats, "/infra/tier-1s/t1"); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("rule.#").(int) != 1 {
+		t.Fatalf("Expected 1 rule, got %d", d.Get("rule.#").(int))
+	}
+	if d.Get("rule.0.hit_count").(int) != 5 || d.Get("rule.0.max_popularity_index").(int) != 3 || d.Get("rule.0.session_count").(int) != 0 {
+		t.Errorf("Unexpected rule statistics %v", d.Get("rule"))
+	}
+
+	if err := setPolicyRuleStatisticsInSchema(d, stats, ""); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("rule.#").(int) != 2 {
+		t.Errorf("Expected 2 rules, got %d", d.Get("rule.#").(int))
+	}
+}
@@ -0,0 +1,33 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNsxtPolicySecurityPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySecurityPoliciesRead,
+
+		Schema: getPolicyDataSourceListSchema(true, map[string]*schema.Schema{
+			"domain": getDataSourceDomainNameSchema(),
+			"category": {
+				Type:         schema.TypeString,
+				Description:  "Category",
+				ValidateFunc: validation.StringInSlice(securityPolicyCategoryValues, false),
+				Optional:     true,
+			},
+		}),
+	}
+}
+
+func dataSourceNsxtPolicySecurityPoliciesRead(d *schema.ResourceData, m interface{}) error {
+	query := getPolicyDataSourceListDomainQuery(d, m)
+	if category := d.Get("category").(string); category != "" {
+		query["category"] = category
+	}
+	return policyDataSourceListRead(d, m, []string{"SecurityPolicy"}, query)
+}
@@ -0,0 +1,73 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySecurityPolicies_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicySecurityPoliciesBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicySecurityPolicies_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicySecurityPoliciesBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicySecurityPoliciesBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_security_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySecurityPoliciesReadTemplate(name, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.path", "nsxt_policy_security_policy.test", "path"),
+					resource.TestCheckResourceAttr("data.nsxt_policy_security_policies.category", "items.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySecurityPoliciesReadTemplate(name string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_security_policy" "test" {
+%s
+  display_name = "%s"
+  category     = "Application"
+}
+
+data "nsxt_policy_security_policies" "test" {
+%s
+  display_name_regex = "^%s$"
+
+  depends_on = [nsxt_policy_security_policy.test]
+}
+
+data "nsxt_policy_security_policies" "category" {
+%s
+  display_name_regex = "^%s$"
+  category           = "Emergency"
+
+  depends_on = [nsxt_policy_security_policy.test]
+}`, context, name, context, name, context, name)
+}
@@ -0,0 +1,225 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var segmentPortRuntimeSourceValues = []string{"realtime", "cached"}
+
+func getSegmentPortBindingSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ip_address": {
+					Type:        schema.TypeString,
+					Description: "IP address",
+					Computed:    true,
+				},
+				"mac_address": {
+					Type:        schema.TypeString,
+					Description: "MAC address",
+					Computed:    true,
+				},
+				"vlan": {
+					Type:        schema.TypeInt,
+					Description: "VLAN ID",
+					Computed:    true,
+				},
+				"source": {
+					Type:        schema.TypeString,
+					Description: "Source of the binding",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentPortRuntime() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentPortRuntimeRead,
+
+		Schema: map[string]*schema.Schema{
+			"port_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the segment port",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"transport_node_id": {
+				Type:        schema.TypeString,
+				Description: "Transport node to retrieve statistics from",
+				Optional:    true,
+			},
+			"enforcement_point_path": {
+				Type:        schema.TypeString,
+				Description: "Enforcement point to retrieve runtime state from",
+				Optional:    true,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Description:  "Data source type for status and statistics",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(segmentPortRuntimeSourceValues, false),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Operational status of the port",
+				Computed:    true,
+			},
+			"attachment_state": {
+				Type:        schema.TypeString,
+				Description: "Attachment state of the port",
+				Computed:    true,
+			},
+			"attachment_id": {
+				Type:        schema.TypeString,
+				Description: "VIF attachment ID",
+				Computed:    true,
+			},
+			"attacher": {
+				Type:        schema.TypeList,
+				Description: "Entities attached to the port",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity": {
+							Type:        schema.TypeString,
+							Description: "VM or vmknic entity attached to the port",
+							Computed:    true,
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Description: "Host of the entity",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"transport_node_ids": {
+				Type:        schema.TypeList,
+				Description: "Transport nodes the port is realized on",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"realized_binding":   getSegmentPortBindingSchema("Address bindings realized on the port"),
+			"discovered_binding": getSegmentPortBindingSchema("Address bindings discovered on the port"),
+			"rx_bytes":           getComputedIntSchema("Total bytes received"),
+			"rx_packets":         getComputedIntSchema("Total packets received"),
+			"rx_dropped_packets": getComputedIntSchema("Dropped packets received"),
+			"tx_bytes":           getComputedIntSchema("Total bytes transmitted"),
+			"tx_packets":         getComputedIntSchema("Total packets transmitted"),
+			"tx_dropped_packets": getComputedIntSchema("Dropped packets transmitted"),
+		},
+	}
+}
+
+// getPolicySegmentPortRuntime retrieves status, state and statistics of segment port
+func getPolicySegmentPortRuntime(segmentClient *policySegmentRuntimeClient, portID string, enforcementPointPath *string, source *string, transportNodeID *string) (model.SegmentPortStatus, model.SegmentPortState, model.SegmentPortStatistics, error) {
+	var state model.SegmentPortState
+	var stats model.SegmentPortStatistics
+
+	status, err := segmentClient.PortStatus(portID, enforcementPointPath, source, transportNodeID)
+	if err != nil {
+		return status, state, stats, err
+	}
+
+	state, err = segmentClient.PortState(portID, enforcementPointPath, source)
+	if err != nil {
+		return status, state, stats, err
+	}
+
+	stats, err = segmentClient.PortStatistics(portID, enforcementPointPath, source, transportNodeID)
+	return status, state, stats, err
+}
+
+func getSegmentPortBindingsList(bindings []model.AddressBindingEntry) []interface{} {
+	var result []interface{}
+	for _, binding := range bindings {
+		elem := make(map[string]interface{})
+		if binding.Binding != nil {
+			elem["ip_address"] = binding.Binding.IpAddress
+			elem["mac_address"] = binding.Binding.MacAddress
+			elem["vlan"] = binding.Binding.Vlan
+		}
+		elem["source"] = binding.Source
+		result = append(result, elem)
+	}
+	return result
+}
+
+func setSegmentPortCounterInSchema(d *schema.ResourceData, totalAttr string, droppedAttr string, counter *model.DataCounter) {
+	if counter == nil {
+		return
+	}
+	if totalAttr != "" {
+		d.Set(totalAttr, counter.Total)
+	}
+	if droppedAttr != "" {
+		d.Set(droppedAttr, counter.Dropped)
+	}
+}
+
+func setPolicySegmentPortRuntimeInSchema(d *schema.ResourceData, status model.SegmentPortStatus, state model.SegmentPortState, stats model.SegmentPortStatistics) {
+	d.Set("status", status.Status)
+
+	var attachers []interface{}
+	if state.Attachment != nil {
+		d.Set("attachment_state", state.Attachment.State)
+		d.Set("attachment_id", state.Attachment.Id)
+		for _, attacher := range state.Attachment.Attachers {
+			attachers = append(attachers, map[string]interface{}{
+				"entity": attacher.Entity,
+				"host":   attacher.Host,
+			})
+		}
+	}
+	d.Set("attacher", attachers)
+	d.Set("transport_node_ids", state.TransportNodeIds)
+	d.Set("realized_binding", getSegmentPortBindingsList(state.RealizedBindings))
+	d.Set("discovered_binding", getSegmentPortBindingsList(state.DiscoveredBindings))
+
+	setSegmentPortCounterInSchema(d, "rx_bytes", "", stats.RxBytes)
+	setSegmentPortCounterInSchema(d, "rx_packets", "rx_dropped_packets", stats.RxPackets)
+	setSegmentPortCounterInSchema(d, "tx_bytes", "", stats.TxBytes)
+	setSegmentPortCounterInSchema(d, "tx_packets", "tx_dropped_packets", stats.TxPackets)
+}
+
+func dataSourceNsxtPolicySegmentPortRuntimeRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	portPath := strings.TrimSuffix(d.Get("port_path").(string), "/")
+
+	idx := strings.LastIndex(portPath, "/ports/")
+	if idx < 0 {
+		return fmt.Errorf("Port path %s is expected to point to segment port", portPath)
+	}
+	segmentPath := portPath[:idx]
+	portID := portPath[idx+len("/ports/"):]
+
+	segmentClient, err := newPolicySegmentRuntimeClient(getParentContext(d, m, segmentPath), connector, segmentPath)
+	if err != nil {
+		return err
+	}
+
+	status, state, stats, err := getPolicySegmentPortRuntime(segmentClient, portID, getOptionalStringPtr(d, "enforcement_point_path"), getOptionalStringPtr(d, "source"), getOptionalStringPtr(d, "transport_node_id"))
+	if err != nil {
+		return handleDataSourceReadError(d, "Segment Port Runtime", portPath, err)
+	}
+
+	d.SetId(portPath)
+	setPolicySegmentPortRuntimeInSchema(d, status, state, stats)
+	return nil
+}
@@ -0,0 +1,59 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func TestPolicySegmentPortRuntime(t *testing.T) {
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/policy/api/v1/orgs/default/projects/dev/infra/tier-1s/t1/segments/seg/ports/p1/status":
+			writeTestJSONResponse(w, http.StatusOK, `{"logical_port_id": "lp1", "status": "UP"}`)
+		case "/policy/api/v1/orgs/default/projects/dev/infra/tier-1s/t1/segments/seg/ports/p1/state":
+			if r.URL.Query().Get("transport_node_id") != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			writeTestJSONResponse(w, http.StatusOK, `{"attachment": {"id": "vif1", "state": "ATTACHED", "attachers": [{"entity": "vm1", "host": "host1"}]},
+			  "transport_node_ids": ["tn1"],
+			  "realized_bindings": [{"binding": {"ip_address": "192.168.1.10", "mac_address": "00:50:56:00:00:01", "vlan": 0}, "source": "ARP_SNOOPING"}]}`)
+		case "/policy/api/v1/orgs/default/projects/dev/infra/tier-1s/t1/segments/seg/ports/p1/statistics":
+			writeTestJSONResponse(w, http.StatusOK, `{"logical_port_id": "lp1", "rx_bytes": {"total": 1000}, "rx_packets": {"total": 10, "dropped": 1}, "tx_packets": {"total": 20}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	sessionContext := utl.SessionContext{ClientType: utl.Multitenancy, ProjectID: "dev"}
+	segmentClient, err := newPolicySegmentRuntimeClient(sessionContext, connector, "/orgs/default/projects/dev/infra/tier-1s/t1/segments/seg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, state, stats, err := getPolicySegmentPortRuntime(segmentClient, "p1", nil, nil, strPtr("tn1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtPolicySegmentPortRuntime().Schema, map[string]interface{}{})
+	setPolicySegmentPortRuntimeInSchema(d, status, state, stats)
+	if d.Get("status").(string) != "UP" || d.Get("attachment_state").(string) != "ATTACHED" || d.Get("attachment_id").(string) != "vif1" {
+		t.Errorf("Unexpected port state: status %v, attachment %v", d.Get("status"), d.Get("attachment_state"))
+	}
+	if d.Get("attacher.#").(int) != 1 || d.Get("attacher.0.host").(string) != "host1" {
+		t.Errorf("Unexpected attachers %v", d.Get("attacher"))
+	}
+	if d.Get("realized_binding.#").(int) != 1 || d.Get("realized_binding.0.ip_address").(string) != "192.168.1.10" || d.Get("realized_binding.0.source").(string) != "ARP_SNOOPING" {
+		t.Errorf("Unexpected bindings %v", d.Get("realized_binding"))
+	}
+	if d.Get("rx_bytes").(int) != 1000 || d.Get("rx_packets").(int) != 10 || d.Get("rx_dropped_packets").(int) != 1 || d.Get("tx_packets").(int) != 20 || d.Get("tx_bytes").(int) != 0 {
+		t.Errorf("Unexpected statistics %v %v %v %v", d.Get("rx_bytes"), d.Get("rx_packets"), d.Get("rx_dropped_packets"), d.Get("tx_packets"))
+	}
+}
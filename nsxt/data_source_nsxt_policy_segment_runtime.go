@@ -0,0 +1,425 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/segments"
+	gm_segment_ports "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/segments/ports"
+	gm_t1_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_1s/segments"
+	gm_t1_segment_ports "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_1s/segments/ports"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	global_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/global_infra/segments"
+	global_segment_ports "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/global_infra/segments/ports"
+	global_t1_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/global_infra/tier_1s/segments"
+	global_t1_segment_ports "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/global_infra/tier_1s/segments/ports"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments"
+	segment_ports "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments/ports"
+	t1_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/segments"
+	t1_segment_ports "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/segments/ports"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/segments"
+	project_segment_ports "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/segments/ports"
+	project_t1_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/tier_1s/segments"
+	project_t1_segment_ports "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/tier_1s/segments/ports"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func dataSourceNsxtPolicySegmentRuntime() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentRuntimeRead,
+
+		Schema: map[string]*schema.Schema{
+			"segment_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the segment",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"transport_node_id": {
+				Type:        schema.TypeString,
+				Description: "Transport node to retrieve MAC table from",
+				Optional:    true,
+			},
+			"host_transport_node_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of host transport node to retrieve ARP table from",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"edge_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of edge node to retrieve ARP table from",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"enforcement_point_path": {
+				Type:        schema.TypeString,
+				Description: "Enforcement point to retrieve tables from",
+				Optional:    true,
+			},
+			"mac_table": {
+				Type:        schema.TypeList,
+				Description: "MAC table of the segment",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address",
+							Computed:    true,
+						},
+						"vtep_ip": {
+							Type:        schema.TypeString,
+							Description: "IPv4 address of the tunnel endpoint",
+							Computed:    true,
+						},
+						"vtep_ipv6": {
+							Type:        schema.TypeString,
+							Description: "IPv6 address of the tunnel endpoint",
+							Computed:    true,
+						},
+						"vtep_mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address of the tunnel endpoint",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"arp_table": {
+				Type:        schema.TypeList,
+				Description: "ARP and ND table of the segment",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:        schema.TypeString,
+							Description: "IP address",
+							Computed:    true,
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// policySegmentRuntimeClient wraps segment runtime APIs, which exist separately for each policy
+// context, and for segments connected to Tier1 gateway
+type policySegmentRuntimeClient struct {
+	connector  client.Connector
+	clientType utl.ClientType
+	// Segment created on Global Manager, as realized on this Local Manager
+	globalInfra bool
+	projectID   string
+	tier1ID     string
+	segmentID   string
+}
+
+func newPolicySegmentRuntimeClient(sessionContext utl.SessionContext, connector client.Connector, segmentPath string) (*policySegmentRuntimeClient, error) {
+	if sessionContext.ClientType == utl.VPC || !strings.Contains(segmentPath, "/segments/") {
+		return nil, fmt.Errorf("Path %s is expected to point to segment", segmentPath)
+	}
+	parents, err := parseStandardPolicyPath(segmentPath)
+	if err != nil {
+		return nil, err
+	}
+	if sessionContext.ClientType == utl.Multitenancy {
+		// Skip org and project ids
+		parents = parents[2:]
+	}
+	c := &policySegmentRuntimeClient{
+		connector:   connector,
+		clientType:  sessionContext.ClientType,
+		globalInfra: strings.HasPrefix(segmentPath, "/global-infra/"),
+		projectID:   sessionContext.ProjectID,
+	}
+	switch len(parents) {
+	case 1:
+		c.segmentID = parents[0]
+	case 2:
+		c.tier1ID = parents[0]
+		c.segmentID = parents[1]
+	default:
+		return nil, fmt.Errorf("Unexpected segment path %s", segmentPath)
+	}
+	return c, nil
+}
+
+func (c *policySegmentRuntimeClient) MacTable(cursor *string, enforcementPointPath *string, transportNodeID *string) (model.SegmentMacAddressListResult, error) {
+	switch c.clientType {
+	case utl.Local:
+		if c.globalInfra {
+			if c.tier1ID != "" {
+				return global_t1_segments.NewMacTableClient(c.connector).List(c.tier1ID, c.segmentID, cursor, enforcementPointPath, nil, nil, nil, nil, nil, transportNodeID)
+			}
+			return global_segments.NewMacTableClient(c.connector).List(c.segmentID, cursor, enforcementPointPath, nil, nil, nil, nil, nil, transportNodeID)
+		}
+		if c.tier1ID != "" {
+			return t1_segments.NewMacTableClient(c.connector).List(c.tier1ID, c.segmentID, cursor, enforcementPointPath, nil, nil, nil, nil, nil, transportNodeID)
+		}
+		return segments.NewMacTableClient(c.connector).List(c.segmentID, cursor, enforcementPointPath, nil, nil, nil, nil, nil, transportNodeID)
+	case utl.Multitenancy:
+		if c.tier1ID != "" {
+			return project_t1_segments.NewMacTableClient(c.connector).List(utl.DefaultOrgID, c.projectID, c.tier1ID, c.segmentID, cursor, enforcementPointPath, nil, nil, nil, nil, nil, transportNodeID)
+		}
+		return project_segments.NewMacTableClient(c.connector).List(utl.DefaultOrgID, c.projectID, c.segmentID, cursor, enforcementPointPath, nil, nil, nil, nil, nil, transportNodeID)
+	}
+
+	var gmResult gm_model.SegmentMacAddressListResult
+	var err error
+	if c.tier1ID != "" {
+		gmResult, err = gm_t1_segments.NewMacTableClient(c.connector).List(c.tier1ID, c.segmentID, cursor, enforcementPointPath, nil, nil, nil, nil, nil, transportNodeID)
+	} else {
+		gmResult, err = gm_segments.NewMacTableClient(c.connector).List(c.segmentID, cursor, enforcementPointPath, nil, nil, nil, nil, nil, transportNodeID)
+	}
+	if err != nil {
+		return model.SegmentMacAddressListResult{}, err
+	}
+	converted, err := convertModelBindingType(gmResult, gm_model.SegmentMacAddressListResultBindingType(), model.SegmentMacAddressListResultBindingType())
+	if err != nil {
+		return model.SegmentMacAddressListResult{}, err
+	}
+	return converted.(model.SegmentMacAddressListResult), nil
+}
+
+func (c *policySegmentRuntimeClient) ArpTable(cursor *string, edgePath *string, enforcementPointPath *string, hostTransportNodePath *string) (model.InterfaceArpTable, error) {
+	switch c.clientType {
+	case utl.Local:
+		if c.globalInfra {
+			if c.tier1ID != "" {
+				return global_t1_segments.NewArpTableClient(c.connector).List(c.tier1ID, c.segmentID, cursor, edgePath, enforcementPointPath, hostTransportNodePath, nil, nil, nil, nil)
+			}
+			return global_segments.NewArpTableClient(c.connector).List(c.segmentID, cursor, edgePath, enforcementPointPath, hostTransportNodePath, nil, nil, nil, nil)
+		}
+		if c.tier1ID != "" {
+			return t1_segments.NewArpTableClient(c.connector).List(c.tier1ID, c.segmentID, cursor, edgePath, enforcementPointPath, hostTransportNodePath, nil, nil, nil, nil)
+		}
+		return segments.NewArpTableClient(c.connector).List(c.segmentID, cursor, edgePath, enforcementPointPath, hostTransportNodePath, nil, nil, nil, nil)
+	case utl.Multitenancy:
+		if c.tier1ID != "" {
+			return project_t1_segments.NewArpTableClient(c.connector).List(utl.DefaultOrgID, c.projectID, c.tier1ID, c.segmentID, cursor, edgePath, enforcementPointPath, hostTransportNodePath, nil, nil, nil, nil)
+		}
+		return project_segments.NewArpTableClient(c.connector).List(utl.DefaultOrgID, c.projectID, c.segmentID, cursor, edgePath, enforcementPointPath, hostTransportNodePath, nil, nil, nil, nil)
+	}
+
+	var gmResult gm_model.InterfaceArpTable
+	var err error
+	if c.tier1ID != "" {
+		gmResult, err = gm_t1_segments.NewArpTableClient(c.connector).List(c.tier1ID, c.segmentID, cursor, edgePath, enforcementPointPath, hostTransportNodePath, nil, nil, nil, nil)
+	} else {
+		gmResult, err = gm_segments.NewArpTableClient(c.connector).List(c.segmentID, cursor, edgePath, enforcementPointPath, hostTransportNodePath, nil, nil, nil, nil)
+	}
+	if err != nil {
+		return model.InterfaceArpTable{}, err
+	}
+	converted, err := convertModelBindingType(gmResult, gm_model.InterfaceArpTableBindingType(), model.InterfaceArpTableBindingType())
+	if err != nil {
+		return model.InterfaceArpTable{}, err
+	}
+	return converted.(model.InterfaceArpTable), nil
+}
+
+func (c *policySegmentRuntimeClient) PortStatus(portID string, enforcementPointPath *string, source *string, transportNodeID *string) (model.SegmentPortStatus, error) {
+	switch c.clientType {
+	case utl.Local:
+		if c.globalInfra {
+			if c.tier1ID != "" {
+				return global_t1_segment_ports.NewStatusClient(c.connector).Get(c.tier1ID, c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+			}
+			return global_segment_ports.NewStatusClient(c.connector).Get(c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+		}
+		if c.tier1ID != "" {
+			return t1_segment_ports.NewStatusClient(c.connector).Get(c.tier1ID, c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+		}
+		return segment_ports.NewStatusClient(c.connector).Get(c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+	case utl.Multitenancy:
+		if c.tier1ID != "" {
+			return project_t1_segment_ports.NewStatusClient(c.connector).Get(utl.DefaultOrgID, c.projectID, c.tier1ID, c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+		}
+		return project_segment_ports.NewStatusClient(c.connector).Get(utl.DefaultOrgID, c.projectID, c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+	}
+
+	var gmResult gm_model.SegmentPortStatus
+	var err error
+	if c.tier1ID != "" {
+		gmResult, err = gm_t1_segment_ports.NewStatusClient(c.connector).Get(c.tier1ID, c.segmentID, portID, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+	} else {
+		gmResult, err = gm_segment_ports.NewStatusClient(c.connector).Get(c.segmentID, portID, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+	}
+	if err != nil {
+		return model.SegmentPortStatus{}, err
+	}
+	converted, err := convertModelBindingType(gmResult, gm_model.SegmentPortStatusBindingType(), model.SegmentPortStatusBindingType())
+	if err != nil {
+		return model.SegmentPortStatus{}, err
+	}
+	return converted.(model.SegmentPortStatus), nil
+}
+
+func (c *policySegmentRuntimeClient) PortState(portID string, enforcementPointPath *string, source *string) (model.SegmentPortState, error) {
+	switch c.clientType {
+	case utl.Local:
+		if c.globalInfra {
+			if c.tier1ID != "" {
+				return global_t1_segment_ports.NewStateClient(c.connector).Get(c.tier1ID, c.segmentID, portID, enforcementPointPath, source)
+			}
+			return global_segment_ports.NewStateClient(c.connector).Get(c.segmentID, portID, enforcementPointPath, source)
+		}
+		if c.tier1ID != "" {
+			return t1_segment_ports.NewStateClient(c.connector).Get(c.tier1ID, c.segmentID, portID, enforcementPointPath, source)
+		}
+		return segment_ports.NewStateClient(c.connector).Get(c.segmentID, portID, enforcementPointPath, source)
+	case utl.Multitenancy:
+		if c.tier1ID != "" {
+			return project_t1_segment_ports.NewStateClient(c.connector).Get(utl.DefaultOrgID, c.projectID, c.tier1ID, c.segmentID, portID, enforcementPointPath, source)
+		}
+		return project_segment_ports.NewStateClient(c.connector).Get(utl.DefaultOrgID, c.projectID, c.segmentID, portID, enforcementPointPath, source)
+	}
+
+	var gmResult gm_model.SegmentPortState
+	var err error
+	if c.tier1ID != "" {
+		gmResult, err = gm_t1_segment_ports.NewStateClient(c.connector).Get(c.tier1ID, c.segmentID, portID, enforcementPointPath, source)
+	} else {
+		gmResult, err = gm_segment_ports.NewStateClient(c.connector).Get(c.segmentID, portID, enforcementPointPath, source)
+	}
+	if err != nil {
+		return model.SegmentPortState{}, err
+	}
+	converted, err := convertModelBindingType(gmResult, gm_model.SegmentPortStateBindingType(), model.SegmentPortStateBindingType())
+	if err != nil {
+		return model.SegmentPortState{}, err
+	}
+	return converted.(model.SegmentPortState), nil
+}
+
+func (c *policySegmentRuntimeClient) PortStatistics(portID string, enforcementPointPath *string, source *string, transportNodeID *string) (model.SegmentPortStatistics, error) {
+	switch c.clientType {
+	case utl.Local:
+		if c.globalInfra {
+			if c.tier1ID != "" {
+				return global_t1_segment_ports.NewStatisticsClient(c.connector).Get(c.tier1ID, c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+			}
+			return global_segment_ports.NewStatisticsClient(c.connector).Get(c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+		}
+		if c.tier1ID != "" {
+			return t1_segment_ports.NewStatisticsClient(c.connector).Get(c.tier1ID, c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+		}
+		return segment_ports.NewStatisticsClient(c.connector).Get(c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+	case utl.Multitenancy:
+		if c.tier1ID != "" {
+			return project_t1_segment_ports.NewStatisticsClient(c.connector).Get(utl.DefaultOrgID, c.projectID, c.tier1ID, c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+		}
+		return project_segment_ports.NewStatisticsClient(c.connector).Get(utl.DefaultOrgID, c.projectID, c.segmentID, portID, nil, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+	}
+
+	var gmResult gm_model.SegmentPortStatistics
+	var err error
+	if c.tier1ID != "" {
+		gmResult, err = gm_t1_segment_ports.NewStatisticsClient(c.connector).Get(c.tier1ID, c.segmentID, portID, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+	} else {
+		gmResult, err = gm_segment_ports.NewStatisticsClient(c.connector).Get(c.segmentID, portID, nil, nil, enforcementPointPath, nil, nil, nil, nil, nil, source, nil, transportNodeID)
+	}
+	if err != nil {
+		return model.SegmentPortStatistics{}, err
+	}
+	converted, err := convertModelBindingType(gmResult, gm_model.SegmentPortStatisticsBindingType(), model.SegmentPortStatisticsBindingType())
+	if err != nil {
+		return model.SegmentPortStatistics{}, err
+	}
+	return converted.(model.SegmentPortStatistics), nil
+}
+
+// listPolicySegmentMacTable retrieves all pages of segment MAC table
+func listPolicySegmentMacTable(segmentClient *policySegmentRuntimeClient, enforcementPointPath *string, transportNodeID *string) ([]model.MacTableEntry, error) {
+	var results []model.MacTableEntry
+	var cursor *string
+	for {
+		table, err := segmentClient.MacTable(cursor, enforcementPointPath, transportNodeID)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, table.Results...)
+		if table.Cursor == nil || *table.Cursor == "" || len(table.Results) == 0 {
+			return results, nil
+		}
+		cursor = table.Cursor
+	}
+}
+
+// listPolicySegmentArpTable retrieves all pages of segment ARP table
+func listPolicySegmentArpTable(segmentClient *policySegmentRuntimeClient, edgePath *string, enforcementPointPath *string, hostTransportNodePath *string) ([]model.InterfaceArpEntry, error) {
+	var results []model.InterfaceArpEntry
+	var cursor *string
+	for {
+		table, err := segmentClient.ArpTable(cursor, edgePath, enforcementPointPath, hostTransportNodePath)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, table.Results...)
+		if table.Cursor == nil || *table.Cursor == "" || len(table.Results) == 0 {
+			return results, nil
+		}
+		cursor = table.Cursor
+	}
+}
+
+func setPolicySegmentRuntimeInSchema(d *schema.ResourceData, macEntries []model.MacTableEntry, arpEntries []model.InterfaceArpEntry) error {
+	var macTable []interface{}
+	for _, entry := range macEntries {
+		elem := make(map[string]interface{})
+		elem["mac_address"] = entry.MacAddress
+		elem["vtep_ip"] = entry.VtepIp
+		elem["vtep_ipv6"] = entry.VtepIpv6
+		elem["vtep_mac_address"] = entry.VtepMacAddress
+		macTable = append(macTable, elem)
+	}
+	if err := d.Set("mac_table", macTable); err != nil {
+		return err
+	}
+
+	var arpTable []interface{}
+	for _, entry := range arpEntries {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = entry.Ip
+		elem["mac_address"] = entry.MacAddress
+		arpTable = append(arpTable, elem)
+	}
+	return d.Set("arp_table", arpTable)
+}
+
+func dataSourceNsxtPolicySegmentRuntimeRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	segmentPath := d.Get("segment_path").(string)
+
+	segmentClient, err := newPolicySegmentRuntimeClient(getParentContext(d, m, segmentPath), connector, segmentPath)
+	if err != nil {
+		return err
+	}
+
+	enforcementPointPath := getOptionalStringPtr(d, "enforcement_point_path")
+	macEntries, err := listPolicySegmentMacTable(segmentClient, enforcementPointPath, getOptionalStringPtr(d, "transport_node_id"))
+	if err != nil {
+		return handleDataSourceReadError(d, "Segment MAC Table", segmentPath, err)
+	}
+	arpEntries, err := listPolicySegmentArpTable(segmentClient, getOptionalStringPtr(d, "edge_path"), enforcementPointPath, getOptionalStringPtr(d, "host_transport_node_path"))
+	if err != nil {
+		return handleDataSourceReadError(d, "Segment ARP Table", segmentPath, err)
+	}
+
+	d.SetId(segmentPath)
+	return setPolicySegmentRuntimeInSchema(d, macEntries, arpEntries)
+}
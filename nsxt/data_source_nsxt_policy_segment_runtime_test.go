@@ -0,0 +1,98 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func TestAccDataSourceNsxtPolicySegmentRuntime_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_segment_runtime.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentRuntimeTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "id", "nsxt_policy_segment.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "mac_table.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentRuntimeTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_transport_zone" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_segment" "test" {
+  display_name        = "%s"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+}
+
+data "nsxt_policy_segment_runtime" "test" {
+  segment_path = nsxt_policy_segment.test.path
+}`, getOverlayTransportZoneName(), name)
+}
+
+func TestPolicySegmentRuntime(t *testing.T) {
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/policy/api/v1/infra/segments/seg/mac-table":
+			if r.URL.Query().Get("transport_node_id") != "tn1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if r.URL.Query().Get("cursor") == "" {
+				writeTestJSONResponse(w, http.StatusOK, `{"cursor": "1", "results": [{"mac_address": "00:50:56:00:00:01", "vtep_ip": "10.0.0.1"}]}`)
+				return
+			}
+			writeTestJSONResponse(w, http.StatusOK, `{"results": [{"mac_address": "00:50:56:00:00:02", "vtep_ip": "10.0.0.2"}]}`)
+		case "/policy/api/v1/infra/segments/seg/arp-table":
+			writeTestJSONResponse(w, http.StatusOK, `{"results": [{"ip": "192.168.1.10", "mac_address": "00:50:56:00:00:01"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	segmentClient, err := newPolicySegmentRuntimeClient(utl.SessionContext{ClientType: utl.Local}, connector, "/infra/segments/seg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	macEntries, err := listPolicySegmentMacTable(segmentClient, nil, strPtr("tn1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	arpEntries, err := listPolicySegmentArpTable(segmentClient, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtPolicySegmentRuntime().Schema, map[string]interface{}{})
+	if err := setPolicySegmentRuntimeInSchema(d, macEntries, arpEntries); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("mac_table.#").(int) != 2 || d.Get("mac_table.1.vtep_ip").(string) != "10.0.0.2" {
+		t.Errorf("Unexpected MAC table %v", d.Get("mac_table"))
+	}
+	if d.Get("arp_table.#").(int) != 1 || d.Get("arp_table.0.ip_address").(string) != "192.168.1.10" {
+		t.Errorf("Unexpected ARP table %v", d.Get("arp_table"))
+	}
+}
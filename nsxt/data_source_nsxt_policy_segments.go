@@ -0,0 +1,20 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicySegments() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentsRead,
+
+		Schema: getPolicyDataSourceListSchema(false, nil),
+	}
+}
+
+func dataSourceNsxtPolicySegmentsRead(d *schema.ResourceData, m interface{}) error {
+	return policyDataSourceListRead(d, m, []string{"Segment"}, nil)
+}
@@ -0,0 +1,60 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegments_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_segments.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.path", "nsxt_policy_segment.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.tag.0.tag", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentsReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_transport_zone" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_segment" "test" {
+  display_name        = "%s"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+
+  tag {
+    scope = "test-scope"
+    tag   = "%s"
+  }
+}
+
+data "nsxt_policy_segments" "test" {
+  tag {
+    tag = "%s"
+  }
+
+  depends_on = [nsxt_policy_segment.test]
+}`, getOverlayTransportZoneName(), name, name, name)
+}
@@ -5,6 +5,7 @@ package nsxt
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -71,6 +72,28 @@ func TestAccDataSourceNsxtPolicyService_byPrefix(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceNsxtPolicyService_matchMode(t *testing.T) {
+	testResourceName := "data.nsxt_policy_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNsxtPolicyServiceReadMatchModeTemplate("Heart", "exact"),
+				ExpectError: regexp.MustCompile("was not found"),
+			},
+			{
+				Config: testAccNsxtPolicyServiceReadMatchModeTemplate("^Heart[a-z]+$", "regex"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", "Heartbeat"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSourceNsxtPolicyService_spaces(t *testing.T) {
 	serviceName := "Enterprise Manager Servlet port SSL"
 	testResourceName := "data.nsxt_policy_service.test"
@@ -150,6 +173,14 @@ data "nsxt_policy_service" "test" {
 }`, name)
 }
 
+func testAccNsxtPolicyServiceReadMatchModeTemplate(name string, matchMode string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_service" "test" {
+  display_name = "%s"
+  match_mode   = "%s"
+}`, name, matchMode)
+}
+
 func testAccNsxtPolicyServiceReadIDTemplate(id string) string {
 	return fmt.Sprintf(`
 data "nsxt_policy_service" "test" {
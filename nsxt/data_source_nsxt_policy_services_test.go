@@ -0,0 +1,59 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyServices_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_services.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyServicesReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "items.0.path", "nsxt_policy_service.test", "path"),
+					resource.TestCheckResourceAttr("data.nsxt_policy_services.query", "items.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyServicesReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_service" "test" {
+  display_name = "%s"
+  description  = "%s"
+
+  l4_port_set_entry {
+    protocol          = "TCP"
+    destination_ports = ["8080"]
+  }
+}
+
+data "nsxt_policy_services" "test" {
+  display_name_regex = "^%s$"
+
+  depends_on = [nsxt_policy_service.test]
+}
+
+data "nsxt_policy_services" "query" {
+  query = "display_name:%s AND description:%s"
+
+  depends_on = [nsxt_policy_service.test]
+}`, name, name, name, name, name)
+}
@@ -21,6 +21,8 @@ func dataSourceNsxtUplinkHostSwitchProfile() *schema.Resource {
 			"id":           getDataSourceIDSchema(),
 			"path":         getPathSchema(),
 			"display_name": getDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getPolicySearchTagFilterSchema(),
 			"description":  getDescriptionSchema(),
 			"realized_id": {
 				Type:        schema.TypeString,
@@ -5,10 +5,13 @@ package nsxt
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/fabric"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
@@ -31,7 +34,6 @@ func dataSourceNsxtPolicyVMs() *schema.Resource {
 		Read: dataSourceNsxtPolicyVMsRead,
 
 		Schema: map[string]*schema.Schema{
-			// TODO: add option to filter by display name regex
 			"value_type": {
 				Type:         schema.TypeString,
 				Description:  "Type of data populated in map value",
@@ -50,6 +52,29 @@ func dataSourceNsxtPolicyVMs() *schema.Resource {
 				Description: "Operating system",
 				Optional:    true,
 			},
+			"display_name_regex": {
+				Type:         schema.TypeString,
+				Description:  "Regular expression to filter VMs by display name",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"tag": getPolicySearchTagFilterSchema(),
+			"host_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the host VMs are running on",
+				Optional:    true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the compute collection VMs are running in",
+				Optional:    true,
+			},
+			"include_vif_attachments": {
+				Type:        schema.TypeBool,
+				Description: "Populate VIF attachments of each VM",
+				Optional:    true,
+				Default:     false,
+			},
 			"items": {
 				Type:        schema.TypeMap,
 				Description: "Mapping of VM instance ID by display name",
@@ -58,35 +83,66 @@ func dataSourceNsxtPolicyVMs() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"vm": {
+				Type:        schema.TypeList,
+				Description: "List of VMs matching the filters",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": getComputedDisplayNameSchema(),
+						"external_id":  getDataSourceStringSchema("External ID of the Virtual Machine"),
+						"bios_id":      getDataSourceStringSchema("BIOS UUID of the Virtual Machine"),
+						"instance_id":  getDataSourceStringSchema("Instance UUID of the Virtual Machine"),
+						"power_state":  getDataSourceStringSchema("Power state of the Virtual Machine"),
+						"guest_os":     getDataSourceStringSchema("Operating system of the Virtual Machine"),
+						"host_id":      getDataSourceStringSchema("ID of the host the Virtual Machine is running on"),
+						"compute_ids": {
+							Type:        schema.TypeList,
+							Description: "Compute IDs of the Virtual Machine",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"vif_attachment_ids": {
+							Type:        schema.TypeList,
+							Description: "VIF attachment IDs of the Virtual Machine",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"tag": getComputedTagsSchema(),
+					},
+				},
+			},
 			"context": getContextSchema(false, false, false),
 		},
 	}
 }
 
-func dataSourceNsxtPolicyVMsRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	valueType := d.Get("value_type").(string)
-	state := d.Get("state").(string)
-	osPrefix := d.Get("guest_os").(string)
-	vmMap := make(map[string]interface{})
-
-	allVMs, err := listAllPolicyVirtualMachines(getSessionContext(d, m), connector, m)
-	if err != nil {
-		return fmt.Errorf("Error reading Virtual Machines: %v", err)
-	}
+type policyVMFilter struct {
+	state      string
+	osPrefix   string
+	nameRegex  *regexp.Regexp
+	tags       []policySearchTag
+	hostID     string
+	hostFilter func(hostID string) (bool, error)
+}
 
-	for _, vm := range allVMs {
-		if state != "" {
-			if vm.PowerState != nil && *vm.PowerState != stateMap[state] {
+func filterPolicyVMs(vms []model.VirtualMachine, filter policyVMFilter) ([]model.VirtualMachine, error) {
+	var results []model.VirtualMachine
+	for _, vm := range vms {
+		if filter.state != "" {
+			if vm.PowerState != nil && *vm.PowerState != stateMap[filter.state] {
 				continue
 			}
 		}
 
-		if osPrefix != "" {
+		if filter.osPrefix != "" {
 			if vm.GuestInfo != nil && vm.GuestInfo.OsName != nil {
 				osName := strings.ToLower(*vm.GuestInfo.OsName)
-				if !strings.HasPrefix(osName, strings.ToLower(osPrefix)) {
+				if !strings.HasPrefix(osName, strings.ToLower(filter.osPrefix)) {
 					continue
 				}
 			}
@@ -94,7 +150,99 @@ func dataSourceNsxtPolicyVMsRead(d *schema.ResourceData, m interface{}) error {
 		if vm.DisplayName == nil {
 			continue
 		}
+		if filter.nameRegex != nil && !filter.nameRegex.MatchString(*vm.DisplayName) {
+			continue
+		}
+		if !policySearchTagsMatch(getPolicySearchTags(vm.Tags), filter.tags) {
+			continue
+		}
+		if filter.hostID != "" && (vm.HostId == nil || *vm.HostId != filter.hostID) {
+			continue
+		}
+		if filter.hostFilter != nil {
+			if vm.HostId == nil {
+				continue
+			}
+			match, err := filter.hostFilter(*vm.HostId)
+			if err != nil {
+				return nil, err
+			}
+			if !match {
+				continue
+			}
+		}
+		results = append(results, vm)
+	}
+
+	return results, nil
+}
+
+// getPolicyVMClusterHostFilter returns filter that checks whether host belongs to given
+// compute collection, based on discovered nodes. Results are cached per host.
+func getPolicyVMClusterHostFilter(connector client.Connector, clusterID string) func(string) (bool, error) {
+	client := fabric.NewDiscoveredNodesClient(connector)
+	cache := make(map[string]bool)
+	return func(hostID string) (bool, error) {
+		if match, ok := cache[hostID]; ok {
+			return match, nil
+		}
+		nodes, err := client.List(nil, nil, nil, nil, nil, nil, nil, &hostID, nil, nil, nil, &clusterID, nil, nil)
+		if err != nil {
+			return false, fmt.Errorf("Error reading discovered node for host %s: %v", hostID, err)
+		}
+		cache[hostID] = len(nodes.Results) > 0
+		return cache[hostID], nil
+	}
+}
+
+func dataSourceNsxtPolicyVMsRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	valueType := d.Get("value_type").(string)
+	vmMap := make(map[string]interface{})
+	filter := policyVMFilter{
+		state:    d.Get("state").(string),
+		osPrefix: d.Get("guest_os").(string),
+		tags:     getPolicySearchTagFiltersFromSchema(d, "tag"),
+		hostID:   d.Get("host_id").(string),
+	}
+	if expr := d.Get("display_name_regex").(string); expr != "" {
+		nameRegex, err := regexp.Compile(expr)
+		if err != nil {
+			return fmt.Errorf("Invalid display_name_regex %s: %v", expr, err)
+		}
+		filter.nameRegex = nameRegex
+	}
+	if clusterID := d.Get("cluster_id").(string); clusterID != "" {
+		// Cluster membership is resolved via discovered nodes of local manager fabric
+		if isPolicyGlobalManager(m) {
+			return localManagerOnlyError()
+		}
+		filter.hostFilter = getPolicyVMClusterHostFilter(connector, clusterID)
+	}
+
+	allVMs, err := listAllPolicyVirtualMachines(getSessionContext(d, m), connector, m)
+	if err != nil {
+		return fmt.Errorf("Error reading Virtual Machines: %v", err)
+	}
+
+	vms, err := filterPolicyVMs(allVMs, filter)
+	if err != nil {
+		return err
+	}
+
+	var vifs []model.VirtualNetworkInterface
+	if d.Get("include_vif_attachments").(bool) {
+		vifs, err = listAllPolicyVifs(m)
+		if err != nil {
+			return fmt.Errorf("Error reading VIFs: %v", err)
+		}
+	}
+
+	var vmList []interface{}
+	for _, vm := range vms {
 		computeIDMap := collectSeparatedStringListToMap(vm.ComputeIds, ":")
+		vmList = append(vmList, getPolicyVMElem(vm, computeIDMap, vifs))
 		if valueType == "instance_id" {
 			vmMap[*vm.DisplayName] = computeIDMap[nsxtPolicyInstanceUUIDKey]
 		} else if valueType == "bios_id" {
@@ -110,6 +258,39 @@ func dataSourceNsxtPolicyVMsRead(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(newUUID())
 	d.Set("items", vmMap)
+	d.Set("vm", vmList)
 
 	return nil
 }
+
+func getPolicyVMElem(vm model.VirtualMachine, computeIDMap map[string]string, vifs []model.VirtualNetworkInterface) map[string]interface{} {
+	elem := make(map[string]interface{})
+	elem["display_name"] = vm.DisplayName
+	elem["external_id"] = vm.ExternalId
+	elem["bios_id"] = computeIDMap[nsxtPolicyBiosUUIDKey]
+	elem["instance_id"] = computeIDMap[nsxtPolicyInstanceUUIDKey]
+	elem["power_state"] = vm.PowerState
+	if vm.GuestInfo != nil {
+		elem["guest_os"] = vm.GuestInfo.OsName
+	}
+	elem["host_id"] = vm.HostId
+	elem["compute_ids"] = vm.ComputeIds
+
+	var vifAttachmentIDs []string
+	for _, vif := range vifs {
+		if vif.LportAttachmentId != nil && vif.OwnerVmId != nil && vm.ExternalId != nil && *vif.OwnerVmId == *vm.ExternalId {
+			vifAttachmentIDs = append(vifAttachmentIDs, *vif.LportAttachmentId)
+		}
+	}
+	elem["vif_attachment_ids"] = vifAttachmentIDs
+
+	var tags []interface{}
+	for _, tag := range vm.Tags {
+		tags = append(tags, map[string]interface{}{
+			"scope": tag.Scope,
+			"tag":   tag.Tag,
+		})
+	}
+	elem["tag"] = tags
+	return elem
+}
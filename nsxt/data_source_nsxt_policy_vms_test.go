@@ -5,9 +5,11 @@ package nsxt
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
 func TestAccDataSourceNsxtPolicyVMs_basic(t *testing.T) {
@@ -115,3 +117,73 @@ resource "nsxt_policy_group" "check" {
   display_name = length(data.nsxt_policy_vms.test.items)
 }`
 }
+
+func TestAccDataSourceNsxtPolicyVMs_list(t *testing.T) {
+	testResourceName := "data.nsxt_policy_vms.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_VM_NAME")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyVMsTemplateList(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "vm.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "vm.0.display_name", getTestVMName()),
+					resource.TestCheckResourceAttrPair(testResourceName, "vm.0.external_id", "data.nsxt_policy_vm.check", "external_id"),
+					resource.TestCheckResourceAttrPair(testResourceName, "vm.0.bios_id", "data.nsxt_policy_vm.check", "bios_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "vm.0.host_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyVMsTemplateList() string {
+	return fmt.Sprintf(`
+data "nsxt_policy_vms" "test" {
+  display_name_regex      = "^%s$"
+  include_vif_attachments = true
+}
+
+data "nsxt_policy_vm" "check" {
+  display_name = "%s"
+}`, regexp.QuoteMeta(getTestVMName()), getTestVMName())
+}
+
+func TestFilterPolicyVMs(t *testing.T) {
+	newVM := func(name string, hostID string, scope string, tag string) model.VirtualMachine {
+		return model.VirtualMachine{
+			DisplayName: &name,
+			HostId:      &hostID,
+			Tags:        []model.Tag{{Scope: &scope, Tag: &tag}},
+		}
+	}
+	vms := []model.VirtualMachine{
+		newVM("web-1", "host-1", "app", "web"),
+		newVM("web-1", "host-2", "app", "web"),
+		newVM("db-1", "host-1", "app", "db"),
+	}
+
+	check := func(filter policyVMFilter, expected int) {
+		t.Helper()
+		results, err := filterPolicyVMs(vms, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != expected {
+			t.Errorf("Expected %d VMs, got %d", expected, len(results))
+		}
+	}
+
+	check(policyVMFilter{}, 3)
+	check(policyVMFilter{nameRegex: regexp.MustCompile("^web-")}, 2)
+	check(policyVMFilter{tags: []policySearchTag{{scope: "app", tag: "db"}}}, 1)
+	check(policyVMFilter{tags: []policySearchTag{{scope: "app"}}, hostID: "host-1"}, 2)
+	check(policyVMFilter{hostFilter: func(hostID string) (bool, error) { return hostID == "host-2", nil }}, 1)
+	check(policyVMFilter{nameRegex: regexp.MustCompile("web"), tags: []policySearchTag{{tag: "db"}}}, 0)
+}
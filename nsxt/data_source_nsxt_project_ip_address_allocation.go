@@ -19,6 +19,8 @@ func dataSourceNsxtProjectIpAddressAllocation() *schema.Resource {
 				Required:    true,
 			},
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getPolicySearchTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"context":      getContextSchema(true, false, false),
@@ -30,6 +30,8 @@ func dataSourceNsxtVpcNat() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(vpcNatTypes, false),
 			},
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
+			"match_mode":   getDataSourceMatchModeSchema(),
+			"tag":          getPolicySearchTagFilterSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"context":      getContextSchema(true, false, true),
@@ -18,6 +18,8 @@ import (
 	gm_tier0s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s"
 	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	t0_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+	t1_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
@@ -112,6 +114,13 @@ func getPolicyLocaleServiceSchema(isTier1 bool) *schema.Schema {
 	}
 	if isTier1 {
 		delete(elemSchema, "redistribution_config")
+		elemSchema["enable_multicast"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Enable multicast on this locale service",
+			Optional:    true,
+		}
+	} else {
+		elemSchema["multicast"] = getPolicyLocaleServiceMulticastSchema()
 	}
 
 	result := &schema.Schema{
@@ -127,6 +136,37 @@ func getPolicyLocaleServiceSchema(isTier1 bool) *schema.Schema {
 	return result
 }
 
+func getPolicyLocaleServiceMulticastSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Multicast configuration for this locale service. Multicast is enabled when this block is present",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"igmp_profile_path": {
+					Type:         schema.TypeString,
+					Description:  "Policy path of IGMP profile",
+					Optional:     true,
+					ValidateFunc: validatePolicyPath(),
+				},
+				"pim_profile_path": {
+					Type:         schema.TypeString,
+					Description:  "Policy path of PIM profile",
+					Optional:     true,
+					ValidateFunc: validatePolicyPath(),
+				},
+				"replication_multicast_range": {
+					Type:         schema.TypeString,
+					Description:  "Multicast address range in CIDR format used for replication of multicast traffic",
+					Optional:     true,
+					ValidateFunc: validateCidr(),
+				},
+			},
+		},
+	}
+}
+
 func getIpv6ProfilePathsFromSchema(d *schema.ResourceData) []string {
 	var profiles []string
 	if d.Get("ipv6_ndra_profile_path") != "" {
@@ -160,6 +200,25 @@ func setIpv6ProfilePathsInSchema(d *schema.ResourceData, paths []string) error {
 	return nil
 }
 
+func getPolicyGatewayDhcpConfigPathSchema(gwType string) *schema.Schema {
+	return getPolicyPathSchema(false, false, fmt.Sprintf("Policy path to DHCP server or relay configuration to use for this %s", gwType))
+}
+
+func getPolicyGatewayDhcpConfigPathsFromSchema(d *schema.ResourceData) []string {
+	dhcpPath := d.Get("dhcp_config_path").(string)
+	if dhcpPath == "" {
+		// Empty list is needed to clear DHCP config on update
+		return []string{}
+	}
+	return []string{dhcpPath}
+}
+
+func setPolicyGatewayDhcpConfigPathsInSchema(d *schema.ResourceData, paths []string) {
+	if len(paths) > 0 {
+		d.Set("dhcp_config_path", paths[0])
+	}
+}
+
 func getGatewayInterfaceSubnetsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
@@ -305,6 +364,7 @@ func initGatewayLocaleServices(context utl.SessionContext, d *schema.ResourceDat
 	}
 	lsType := "LocaleServices"
 	idMap := make(map[string]bool)
+	oldMulticastServices := getOldLocaleServiceMulticastIDs(d)
 	for _, service := range services {
 		cfg := service.(map[string]interface{})
 		edgeClusterPath := cfg["edge_cluster_path"].(string)
@@ -351,6 +411,20 @@ func initGatewayLocaleServices(context utl.SessionContext, d *schema.ResourceDat
 			serviceStruct.Revision = obj.Revision
 			serviceStruct.HaVipConfigs = obj.HaVipConfigs
 		}
+
+		multicastChild, err := initLocaleServiceMulticastChild(cfg, oldMulticastServices[serviceID])
+		if err != nil {
+			return localeServices, err
+		}
+		if multicastChild != nil {
+			if context.ClientType != utl.Local {
+				return localeServices, fmt.Errorf("Multicast configuration on locale_service is only supported with NSX Local Manager")
+			}
+			if !isLocaleServiceMulticastSupported(cfg) {
+				return localeServices, fmt.Errorf("Multicast configuration on locale_service requires NSX version %s or higher", getLocaleServiceMulticastVersion(cfg))
+			}
+			serviceStruct.Children = []*data.StructValue{multicastChild}
+		}
 		dataValue, err := initChildLocaleService(&serviceStruct, false)
 		if err != nil {
 			return localeServices, err
@@ -376,6 +450,184 @@ func initGatewayLocaleServices(context utl.SessionContext, d *schema.ResourceDat
 	return localeServices, nil
 }
 
+// getOldLocaleServiceMulticastIDs returns IDs of locale services that had multicast
+// enabled in previous state, so that multicast can be disabled when removed from intent
+func getOldLocaleServiceMulticastIDs(d *schema.ResourceData) map[string]bool {
+	result := make(map[string]bool)
+	oldServices, _ := d.GetChange("locale_service")
+	if oldServices == nil {
+		return result
+	}
+	for _, service := range oldServices.(*schema.Set).List() {
+		cfg := service.(map[string]interface{})
+		enabled := false
+		if multicast, ok := cfg["multicast"]; ok {
+			enabled = len(multicast.([]interface{})) > 0
+		} else if multicast, ok := cfg["enable_multicast"]; ok {
+			enabled = multicast.(bool)
+		}
+		if !enabled {
+			continue
+		}
+		id := cfg["nsx_id"].(string)
+		if id == "" {
+			id = getPolicyIDFromPath(cfg["path"].(string))
+		}
+		if id != "" {
+			result[id] = true
+		}
+	}
+
+	return result
+}
+
+// initLocaleServiceMulticastChild builds multicast child for locale service H-API
+// call, or returns nil if multicast is neither configured nor needs to be disabled
+func initLocaleServiceMulticastChild(cfg map[string]interface{}, wasEnabled bool) (*data.StructValue, error) {
+	id := "multicast"
+	converter := bindings.NewTypeConverter()
+	if t1Enabled, isTier1 := cfg["enable_multicast"]; isTier1 {
+		enabled := t1Enabled.(bool)
+		if !enabled && !wasEnabled {
+			return nil, nil
+		}
+		resourceType := "PolicyTier1MulticastConfig"
+		childConfig := model.ChildPolicyTier1MulticastConfig{
+			ResourceType: "ChildPolicyTier1MulticastConfig",
+			PolicyTier1MulticastConfig: &model.PolicyTier1MulticastConfig{
+				Id:           &id,
+				ResourceType: &resourceType,
+				Enabled:      &enabled,
+			},
+		}
+		dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPolicyTier1MulticastConfigBindingType())
+		if errors != nil {
+			return nil, fmt.Errorf("Error converting child Multicast Configuration: %v", errors[0])
+		}
+		return dataValue.(*data.StructValue), nil
+	}
+
+	multicast := cfg["multicast"].([]interface{})
+	enabled := len(multicast) > 0
+	if !enabled && !wasEnabled {
+		return nil, nil
+	}
+	resourceType := "PolicyMulticastConfig"
+	config := model.PolicyMulticastConfig{
+		Id:           &id,
+		ResourceType: &resourceType,
+		Enabled:      &enabled,
+	}
+	if enabled && multicast[0] != nil {
+		multicastCfg := multicast[0].(map[string]interface{})
+		if igmpProfilePath := multicastCfg["igmp_profile_path"].(string); igmpProfilePath != "" {
+			config.IgmpProfilePath = &igmpProfilePath
+		}
+		if pimProfilePath := multicastCfg["pim_profile_path"].(string); pimProfilePath != "" {
+			config.PimProfilePath = &pimProfilePath
+		}
+		if replicationRange := multicastCfg["replication_multicast_range"].(string); replicationRange != "" {
+			config.ReplicationMulticastRange = &replicationRange
+		}
+	}
+	childConfig := model.ChildPolicyMulticastConfig{
+		ResourceType:          "ChildPolicyMulticastConfig",
+		PolicyMulticastConfig: &config,
+	}
+	dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPolicyMulticastConfigBindingType())
+	if errors != nil {
+		return nil, fmt.Errorf("Error converting child Multicast Configuration: %v", errors[0])
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+// getLocaleServiceMulticastVersion returns minimal NSX version supporting multicast
+// configuration on the locale service, which differs for Tier0 and Tier1 gateways
+func getLocaleServiceMulticastVersion(cfg map[string]interface{}) string {
+	if _, isTier1 := cfg["enable_multicast"]; isTier1 {
+		return "4.0.0"
+	}
+	return "3.0.0"
+}
+
+func isLocaleServiceMulticastSupported(cfg map[string]interface{}) bool {
+	return util.NsxVersionHigherOrEqual(getLocaleServiceMulticastVersion(cfg))
+}
+
+// getLocaleServiceMulticastIntent returns multicast configuration of locale services
+// in current state, keyed by edge cluster path of the locale service
+func getLocaleServiceMulticastIntent(d *schema.ResourceData) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{})
+	services, ok := d.Get("locale_service").(*schema.Set)
+	if !ok {
+		return result
+	}
+	for _, service := range services.List() {
+		cfg := service.(map[string]interface{})
+		multicast, ok := cfg["multicast"].([]interface{})
+		if !ok || len(multicast) == 0 {
+			continue
+		}
+		// empty multicast block is represented by nil element
+		intent := make(map[string]interface{})
+		if multicast[0] != nil {
+			intent = multicast[0].(map[string]interface{})
+		}
+		result[cfg["edge_cluster_path"].(string)] = intent
+	}
+	return result
+}
+
+// setLocaleServiceMulticastInMap reads multicast config of locale service into the
+// locale_service schema map. Multicast config is only supported on Local Manager.
+// Profile paths that are not specified in intent are filled with defaults by NSX,
+// and are kept empty here to avoid permanent diff.
+func setLocaleServiceMulticastInMap(connector client.Connector, gwID string, serviceID string, isTier1 bool, intent map[string]interface{}, cfgMap map[string]interface{}) error {
+	if isTier1 {
+		cfgMap["enable_multicast"] = false
+		if !util.NsxVersionHigherOrEqual("4.0.0") {
+			return nil
+		}
+		client := t1_locale_services.NewMulticastClient(connector)
+		config, err := client.Get(gwID, serviceID)
+		if err != nil {
+			if isNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		cfgMap["enable_multicast"] = config.Enabled != nil && *config.Enabled
+		return nil
+	}
+
+	var multicast []interface{}
+	cfgMap["multicast"] = multicast
+	if !util.NsxVersionHigherOrEqual("3.0.0") {
+		return nil
+	}
+	client := t0_locale_services.NewMulticastClient(connector)
+	config, err := client.Get(gwID, serviceID)
+	if err != nil && !isNotFoundError(err) {
+		return err
+	}
+	if err == nil && config.Enabled != nil && *config.Enabled {
+		elem := make(map[string]interface{})
+		elem["igmp_profile_path"] = config.IgmpProfilePath
+		elem["pim_profile_path"] = config.PimProfilePath
+		elem["replication_multicast_range"] = config.ReplicationMulticastRange
+		if intent != nil {
+			for _, attr := range []string{"igmp_profile_path", "pim_profile_path"} {
+				if value, _ := intent[attr].(string); value == "" {
+					elem[attr] = ""
+				}
+			}
+		}
+		multicast = append(multicast, elem)
+	}
+	cfgMap["multicast"] = multicast
+	return nil
+}
+
 func getPolicyGatewayIntersiteConfigFromSchema(d *schema.ResourceData) *model.IntersiteGatewayConfig {
 	cfg, isSet := d.GetOk("intersite_config")
 	if !isSet {
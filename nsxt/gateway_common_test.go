@@ -0,0 +1,135 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
+)
+
+func TestInitLocaleServiceMulticastChild(t *testing.T) {
+	converter := bindings.NewTypeConverter()
+
+	// Tier0 without multicast and no previous config - nothing to send
+	child, err := initLocaleServiceMulticastChild(map[string]interface{}{"multicast": []interface{}{}}, false)
+	if err != nil || child != nil {
+		t.Fatalf("expected no multicast child, got %v, %v", child, err)
+	}
+
+	// Tier0 multicast removed from intent - should be disabled
+	child, err = initLocaleServiceMulticastChild(map[string]interface{}{"multicast": []interface{}{}}, true)
+	if err != nil || child == nil {
+		t.Fatalf("expected multicast child, got %v", err)
+	}
+	obj, errs := converter.ConvertToGolang(child, model.ChildPolicyMulticastConfigBindingType())
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	config := obj.(model.ChildPolicyMulticastConfig).PolicyMulticastConfig
+	if *config.Enabled {
+		t.Errorf("expected multicast to be disabled")
+	}
+
+	// Tier0 multicast configured
+	cfg := map[string]interface{}{
+		"multicast": []interface{}{map[string]interface{}{
+			"igmp_profile_path":           "/infra/igmp-profiles/default",
+			"pim_profile_path":            "",
+			"replication_multicast_range": "233.1.0.0/16",
+		}},
+	}
+	child, err = initLocaleServiceMulticastChild(cfg, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, errs = converter.ConvertToGolang(child, model.ChildPolicyMulticastConfigBindingType())
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	config = obj.(model.ChildPolicyMulticastConfig).PolicyMulticastConfig
+	if !*config.Enabled || *config.IgmpProfilePath != "/infra/igmp-profiles/default" || config.PimProfilePath != nil || *config.ReplicationMulticastRange != "233.1.0.0/16" {
+		t.Errorf("unexpected multicast config %v", config)
+	}
+
+	// Tier1 multicast enabled
+	child, err = initLocaleServiceMulticastChild(map[string]interface{}{"enable_multicast": true}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, errs = converter.ConvertToGolang(child, model.ChildPolicyTier1MulticastConfigBindingType())
+	if errs != nil {
+		t.Fatal(errs[0])
+	}
+	if !*obj.(model.ChildPolicyTier1MulticastConfig).PolicyTier1MulticastConfig.Enabled {
+		t.Errorf("expected tier1 multicast to be enabled")
+	}
+}
+
+func TestSetLocaleServiceMulticastInMap(t *testing.T) {
+	requests := 0
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/policy/api/v1/infra/tier-0s/t0/locale-services/ls/multicast" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeTestJSONResponse(w, http.StatusOK, `{"id": "multicast", "enabled": true, "igmp_profile_path": "/infra/igmp-profiles/default",
+		  "pim_profile_path": "/infra/pim-profiles/pim1", "replication_multicast_range": "233.1.0.0/16"}`)
+	})
+
+	savedVersion := util.NsxVersion
+	defer func() { util.NsxVersion = savedVersion }()
+	util.NsxVersion = "4.1.0"
+
+	// IGMP profile is not set in intent, and NSX default should not be reported
+	intent := map[string]interface{}{"igmp_profile_path": "", "pim_profile_path": "/infra/pim-profiles/pim1"}
+	cfgMap := make(map[string]interface{})
+	if err := setLocaleServiceMulticastInMap(connector, "t0", "ls", false, intent, cfgMap); err != nil {
+		t.Fatal(err)
+	}
+	multicast := cfgMap["multicast"].([]interface{})
+	if len(multicast) != 1 {
+		t.Fatalf("expected multicast config, got %v", multicast)
+	}
+	elem := multicast[0].(map[string]interface{})
+	if elem["igmp_profile_path"] != "" || *elem["pim_profile_path"].(*string) != "/infra/pim-profiles/pim1" {
+		t.Errorf("unexpected multicast profiles %v", elem)
+	}
+
+	// Empty multicast block in intent
+	cfgMap = make(map[string]interface{})
+	if err := setLocaleServiceMulticastInMap(connector, "t0", "ls", false, map[string]interface{}{}, cfgMap); err != nil {
+		t.Fatal(err)
+	}
+	elem = cfgMap["multicast"].([]interface{})[0].(map[string]interface{})
+	if elem["igmp_profile_path"] != "" || elem["pim_profile_path"] != "" {
+		t.Errorf("unexpected multicast profiles %v", elem)
+	}
+
+	// No intent, for example on import - all values are reported
+	cfgMap = make(map[string]interface{})
+	if err := setLocaleServiceMulticastInMap(connector, "t0", "ls", false, nil, cfgMap); err != nil {
+		t.Fatal(err)
+	}
+	elem = cfgMap["multicast"].([]interface{})[0].(map[string]interface{})
+	if *elem["igmp_profile_path"].(*string) != "/infra/igmp-profiles/default" {
+		t.Errorf("unexpected multicast profiles %v", elem)
+	}
+
+	// Tier1 multicast is not supported with this NSX version, and should not be read
+	util.NsxVersion = "3.2.0"
+	requests = 0
+	cfgMap = make(map[string]interface{})
+	if err := setLocaleServiceMulticastInMap(connector, "t1", "ls", true, nil, cfgMap); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 0 || cfgMap["enable_multicast"].(bool) {
+		t.Errorf("expected no multicast read, got %d requests", requests)
+	}
+}
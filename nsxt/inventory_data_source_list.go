@@ -0,0 +1,116 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+)
+
+// Inventory data sources list objects discovered by NSX, such as container objects
+// reported by NCP/Antrea and physical servers, with optional filters
+
+type inventoryListItem struct {
+	id          *string
+	displayName *string
+	description *string
+	tags        []model.Tag
+	attributes  map[string]interface{}
+}
+
+func getInventoryDataSourceListSchema(extraFilters map[string]*schema.Schema, itemAttributes map[string]*schema.Schema) map[string]*schema.Schema {
+	itemSchema := map[string]*schema.Schema{
+		"id":           getDataSourceStringSchema("Unique identifier of the object"),
+		"display_name": getDataSourceStringSchema("Display name of the object"),
+		"description":  getDataSourceStringSchema("Description of the object"),
+		"tag":          getComputedTagsSchema(),
+	}
+	for key, value := range itemAttributes {
+		itemSchema[key] = value
+	}
+
+	result := map[string]*schema.Schema{
+		"display_name_regex": {
+			Type:         schema.TypeString,
+			Description:  "Regular expression to filter objects by display name",
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"tag": getPolicySearchTagFilterSchema(),
+		"items": {
+			Type:        schema.TypeList,
+			Description: "List of objects matching the filters",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: itemSchema,
+			},
+		},
+	}
+	for key, value := range extraFilters {
+		result[key] = value
+	}
+	return result
+}
+
+// inventoryDataSourceListFilter filters objects by display name regex and tags, and sorts them by display name
+func inventoryDataSourceListFilter(objects []inventoryListItem, nameRegex *regexp.Regexp, tags []policySearchTag) []inventoryListItem {
+	var results []inventoryListItem
+	for _, obj := range objects {
+		if nameRegex != nil && (obj.displayName == nil || !nameRegex.MatchString(*obj.displayName)) {
+			continue
+		}
+		if !policySearchTagsMatch(getPolicySearchTags(obj.tags), tags) {
+			continue
+		}
+		results = append(results, obj)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].displayName == nil || results[j].displayName == nil {
+			return results[j].displayName != nil
+		}
+		return *results[i].displayName < *results[j].displayName
+	})
+	return results
+}
+
+func inventoryDataSourceListSet(d *schema.ResourceData, objects []inventoryListItem) error {
+	var nameRegex *regexp.Regexp
+	if expr := d.Get("display_name_regex").(string); expr != "" {
+		var err error
+		nameRegex, err = regexp.Compile(expr)
+		if err != nil {
+			return fmt.Errorf("Invalid display_name_regex %s: %v", expr, err)
+		}
+	}
+	tags := getPolicySearchTagFiltersFromSchema(d, "tag")
+
+	var items []interface{}
+	for _, obj := range inventoryDataSourceListFilter(objects, nameRegex, tags) {
+		item := make(map[string]interface{})
+		item["id"] = obj.id
+		item["display_name"] = obj.displayName
+		item["description"] = obj.description
+		var tagList []interface{}
+		for _, tag := range obj.tags {
+			tagList = append(tagList, map[string]interface{}{
+				"scope": tag.Scope,
+				"tag":   tag.Tag,
+			})
+		}
+		item["tag"] = tagList
+		for key, value := range obj.attributes {
+			item[key] = value
+		}
+		items = append(items, item)
+	}
+
+	d.SetId(newUUID())
+	return d.Set("items", items)
+}
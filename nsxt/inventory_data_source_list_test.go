@@ -0,0 +1,57 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestInventoryDataSourceListFilter(t *testing.T) {
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/fabric/container-projects" || r.URL.Query().Get("container_cluster_id") != "c1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("cursor") == "" {
+			writeTestJSONResponse(w, http.StatusOK, `{"cursor": "1", "results": [
+			  {"external_id": "ns2", "display_name": "web", "container_cluster_id": "c1", "tags": [{"scope": "env", "tag": "prod"}]},
+			  {"external_id": "ns3", "display_name": "kube-system", "container_cluster_id": "c1"}]}`)
+			return
+		}
+		writeTestJSONResponse(w, http.StatusOK, `{"results": [
+		  {"external_id": "ns1", "display_name": "app", "container_cluster_id": "c1", "tags": [{"scope": "env", "tag": "prod"}]}]}`)
+	})
+
+	clusterID := "c1"
+	namespaces, err := listNsxtContainerNamespaces(connector, &clusterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(namespaces) != 3 {
+		t.Fatalf("Expected 3 namespaces, got %d", len(namespaces))
+	}
+
+	results := inventoryDataSourceListFilter(namespaces, nil, []policySearchTag{{scope: "env", tag: "prod"}})
+	if len(results) != 2 || *results[0].displayName != "app" || *results[1].displayName != "web" {
+		t.Errorf("Unexpected filter results %v", results)
+	}
+	results = inventoryDataSourceListFilter(namespaces, regexp.MustCompile("^kube-"), nil)
+	if len(results) != 1 || *results[0].id != "ns3" {
+		t.Errorf("Unexpected filter results %v", results)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceNsxtContainerNamespaces().Schema, map[string]interface{}{
+		"display_name_regex": "^(app|web)$",
+	})
+	if err := inventoryDataSourceListSet(d, namespaces); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("items.#").(int) != 2 || d.Get("items.0.id").(string) != "ns1" || d.Get("items.0.container_cluster_id").(string) != "c1" || d.Get("items.0.tag.0.tag").(string) != "prod" {
+		t.Errorf("Unexpected items %v", d.Get("items"))
+	}
+}
@@ -0,0 +1,218 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// Bulk apply mode collects hierarchical children patched by concurrent resource
+// operations within short time window, and sends them to NSX in single H-API
+// request. Each operation waits for the result of the request that carried its child.
+
+// policyBulkBatch is a set of children pending for single hierarchical request
+type policyBulkBatch struct {
+	context  utl.SessionContext
+	children []*data.StructValue
+	results  []chan error
+}
+
+type policyBulkApplier struct {
+	window  time.Duration
+	maxSize int
+	mutex   sync.Mutex
+	batches map[string]*policyBulkBatch
+}
+
+func newPolicyBulkApplier(windowMs int, maxSize int) *policyBulkApplier {
+	return &policyBulkApplier{
+		window:  time.Duration(windowMs) * time.Millisecond,
+		maxSize: maxSize,
+		batches: make(map[string]*policyBulkBatch),
+	}
+}
+
+func policyBulkApplyEnabled(m interface{}) bool {
+	return m.(nsxtClients).PolicyBulkApplier != nil
+}
+
+// policyBulkInfraPatch patches Infra child, such as ChildResourceReference to a Domain.
+// In bulk apply mode, the child is batched together with children of concurrent operations.
+func policyBulkInfraPatch(context utl.SessionContext, child *data.StructValue, m interface{}) error {
+	connector := getPolicyConnector(m)
+	applier := m.(nsxtClients).PolicyBulkApplier
+	if applier == nil || context.ClientType == utl.VPC {
+		return policyInfraPatch(context, policyBulkInfra([]*data.StructValue{child}), connector, false)
+	}
+
+	return applier.patch(context, connector, child)
+}
+
+func policyBulkApplyKey(context utl.SessionContext) string {
+	return fmt.Sprintf("%d/%s", context.ClientType, context.ProjectID)
+}
+
+func (b *policyBulkApplier) patch(context utl.SessionContext, connector client.Connector, child *data.StructValue) error {
+	result := make(chan error, 1)
+	key := policyBulkApplyKey(context)
+
+	b.mutex.Lock()
+	batch, ok := b.batches[key]
+	if !ok {
+		batch = &policyBulkBatch{context: context}
+		b.batches[key] = batch
+		time.AfterFunc(b.window, func() {
+			b.flush(key, batch, connector)
+		})
+	}
+	batch.children = append(batch.children, child)
+	batch.results = append(batch.results, result)
+	if b.maxSize > 0 && len(batch.children) >= b.maxSize {
+		delete(b.batches, key)
+		go b.apply(batch, connector)
+	}
+	b.mutex.Unlock()
+
+	return <-result
+}
+
+func (b *policyBulkApplier) flush(key string, batch *policyBulkBatch, connector client.Connector) {
+	b.mutex.Lock()
+	if b.batches[key] != batch {
+		// Batch was already applied due to size limit
+		b.mutex.Unlock()
+		return
+	}
+	delete(b.batches, key)
+	b.mutex.Unlock()
+
+	b.apply(batch, connector)
+}
+
+func (b *policyBulkApplier) apply(batch *policyBulkBatch, connector client.Connector) {
+	count := len(batch.children)
+	log.Printf("[INFO] Applying %d policy objects in single hierarchical request", count)
+	err := policyInfraPatch(batch.context, policyBulkInfra(batch.children), connector, false)
+	if err == nil || count == 1 {
+		for _, result := range batch.results {
+			result <- err
+		}
+		return
+	}
+
+	// Failure of bulk request can not be attributed to specific object,
+	// hence objects are applied one by one to report error for each
+	log.Printf("[WARN] Hierarchical request for %d policy objects failed: %v. Applying objects one by one", count, err)
+	for i, child := range batch.children {
+		batch.results[i] <- policyInfraPatch(batch.context, policyBulkInfra([]*data.StructValue{child}), connector, false)
+	}
+}
+
+func policyBulkInfra(children []*data.StructValue) model.Infra {
+	return model.Infra{
+		Children:     policyBulkMergeChildren(children),
+		ResourceType: strPtr("Infra"),
+	}
+}
+
+func policyBulkStringField(value *data.StructValue, field string) string {
+	if !value.HasField(field) {
+		return ""
+	}
+	fieldValue, _ := value.Field(field)
+	if optional, ok := fieldValue.(*data.OptionalValue); ok {
+		if !optional.IsSet() {
+			return ""
+		}
+		fieldValue = optional.Value()
+	}
+	if str, ok := fieldValue.(*data.StringValue); ok {
+		return str.Value()
+	}
+	return ""
+}
+
+func policyBulkChildrenField(value *data.StructValue) []*data.StructValue {
+	var result []*data.StructValue
+	if !value.HasField("children") {
+		return result
+	}
+	fieldValue, _ := value.Field("children")
+	if optional, ok := fieldValue.(*data.OptionalValue); ok {
+		if !optional.IsSet() {
+			return result
+		}
+		fieldValue = optional.Value()
+	}
+	if list, ok := fieldValue.(*data.ListValue); ok {
+		for _, item := range list.List() {
+			if child, ok := item.(*data.StructValue); ok {
+				result = append(result, child)
+			}
+		}
+	}
+	return result
+}
+
+type policyBulkReference struct {
+	position int
+	count    int
+	children []*data.StructValue
+}
+
+// policyBulkMergeChildren merges references to same parent object, for example groups and
+// policies in same domain, so that each parent appears in the hierarchy only once.
+// Original children are not modified, so that they can be reused for individual requests.
+func policyBulkMergeChildren(children []*data.StructValue) []*data.StructValue {
+	var result []*data.StructValue
+	references := make(map[string]*policyBulkReference)
+	for _, child := range children {
+		if policyBulkStringField(child, "resource_type") != "ChildResourceReference" {
+			result = append(result, child)
+			continue
+		}
+		key := fmt.Sprintf("%s/%s", policyBulkStringField(child, "target_type"), policyBulkStringField(child, "id"))
+		reference, ok := references[key]
+		if !ok {
+			reference = &policyBulkReference{position: len(result)}
+			references[key] = reference
+			result = append(result, child)
+		}
+		reference.count++
+		reference.children = append(reference.children, policyBulkChildrenField(child)...)
+	}
+
+	for _, reference := range references {
+		if reference.count == 1 {
+			continue
+		}
+		original := result[reference.position]
+		merged := data.NewStructValue(original.Name(), nil)
+		for name, value := range original.Fields() {
+			merged.SetField(name, value)
+		}
+		list := data.NewListValue()
+		for _, child := range policyBulkMergeChildren(reference.children) {
+			list.Add(child)
+		}
+		var childrenValue data.DataValue = list
+		if value, err := original.Field("children"); err == nil {
+			if _, ok := value.(*data.OptionalValue); ok {
+				childrenValue = data.NewOptionalValue(list)
+			}
+		}
+		merged.SetField("children", childrenValue)
+		result[reference.position] = merged
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type testBulkApplyRequest struct {
+	Children []struct {
+		ResourceType string `json:"resource_type"`
+		ID           string `json:"id"`
+		Children     []struct {
+			ResourceType string `json:"resource_type"`
+		} `json:"children"`
+	} `json:"children"`
+}
+
+func testBulkApplyServer(t *testing.T, failFirst bool) (client.Connector, *[]testBulkApplyRequest) {
+	var mutex sync.Mutex
+	var requests []testBulkApplyRequest
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var request testBulkApplyRequest
+		if err := json.Unmarshal(body, &request); err != nil {
+			t.Errorf("Failed to decode request %s: %v", string(body), err)
+		}
+		mutex.Lock()
+		requests = append(requests, request)
+		fail := failFirst && len(requests) == 1
+		mutex.Unlock()
+
+		if fail {
+			writeTestJSONResponse(w, http.StatusBadRequest, `{"error_code": 500012, "error_message": "invalid"}`)
+			return
+		}
+		writeTestJSONResponse(w, http.StatusOK, "")
+	})
+	return connector, &requests
+}
+
+func testBulkApplyPatchGroups(t *testing.T, applier *policyBulkApplier, connector client.Connector, domains []string) []error {
+	errors := make([]error, len(domains))
+	var wg sync.WaitGroup
+	for i, domain := range domains {
+		group := model.Group{
+			Id:           strPtr(newUUID()),
+			ResourceType: strPtr("Group"),
+		}
+		child, err := createChildDomainWithGroup(domain, group)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errors[i] = applier.patch(utl.SessionContext{ClientType: utl.Local}, connector, child)
+		}(i)
+	}
+	wg.Wait()
+	return errors
+}
+
+func TestPolicyBulkApply(t *testing.T) {
+	connector, requests := testBulkApplyServer(t, false)
+	applier := newPolicyBulkApplier(100, 10)
+
+	errors := testBulkApplyPatchGroups(t, applier, connector, []string{"default", "default", "default", "other"})
+	for _, err := range errors {
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	}
+
+	if len(*requests) != 1 {
+		t.Fatalf("Expected 1 request, got %d", len(*requests))
+	}
+	children := (*requests)[0].Children
+	if len(children) != 2 {
+		t.Fatalf("Expected 2 domain references, got %d", len(children))
+	}
+	for _, child := range children {
+		expected := 1
+		if child.ID == "default" {
+			expected = 3
+		}
+		if len(child.Children) != expected {
+			t.Errorf("Expected %d children for domain %s, got %d", expected, child.ID, len(child.Children))
+		}
+	}
+}
+
+func TestPolicyBulkApplyMaxSize(t *testing.T) {
+	connector, requests := testBulkApplyServer(t, false)
+	applier := newPolicyBulkApplier(100, 2)
+
+	testBulkApplyPatchGroups(t, applier, connector, []string{"default", "default", "default", "default"})
+	if len(*requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(*requests))
+	}
+}
+
+func TestPolicyBulkApplyFailure(t *testing.T) {
+	connector, requests := testBulkApplyServer(t, true)
+	applier := newPolicyBulkApplier(100, 10)
+
+	errors := testBulkApplyPatchGroups(t, applier, connector, []string{"default", "default"})
+	for _, err := range errors {
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	}
+	// Failed bulk request is followed by individual requests
+	if len(*requests) != 3 {
+		t.Fatalf("Expected 3 requests, got %d", len(*requests))
+	}
+}
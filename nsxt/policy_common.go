@@ -670,6 +670,14 @@ func getComputedPolicyPathSchema(description string) *schema.Schema {
 	}
 }
 
+func getComputedIntSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: description,
+		Computed:    true,
+	}
+}
+
 func getElemPolicyPathSchemaWithFlags(isOptional, isComputed, isRequired bool) *schema.Schema {
 	s := schema.Schema{
 		Type:         schema.TypeString,
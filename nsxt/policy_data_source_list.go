@@ -0,0 +1,209 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// Plural data sources list policy objects of given type via search API, with optional filters
+
+func getPolicyDataSourceListContextSchema(isVPC bool) *schema.Schema {
+	contextSchema := getContextSchema(false, false, isVPC)
+	if isVPC {
+		// VPC is optional for plural data sources, since objects may be listed on project level
+		vpcSchema := contextSchema.Elem.(*schema.Resource).Schema["vpc_id"]
+		vpcSchema.Required = false
+		vpcSchema.Optional = true
+	}
+	return contextSchema
+}
+
+func getComputedTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Tags of the object",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scope": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tag": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func getPolicyDataSourceListSchema(isVPC bool, extra map[string]*schema.Schema) map[string]*schema.Schema {
+	result := map[string]*schema.Schema{
+		"query": {
+			Type:        schema.TypeString,
+			Description: "Search query to filter objects, in NSX search syntax",
+			Optional:    true,
+		},
+		"display_name_regex": {
+			Type:         schema.TypeString,
+			Description:  "Regular expression to filter objects by display name",
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"tag":     getPolicySearchTagFilterSchema(),
+		"context": getPolicyDataSourceListContextSchema(isVPC),
+		"items": {
+			Type:        schema.TypeList,
+			Description: "List of objects matching the filters",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Description: "Unique identifier of the object",
+						Computed:    true,
+					},
+					"display_name": {
+						Type:        schema.TypeString,
+						Description: "Display name of the object",
+						Computed:    true,
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Description: "Description of the object",
+						Computed:    true,
+					},
+					"path": {
+						Type:        schema.TypeString,
+						Description: "Policy path of the object",
+						Computed:    true,
+					},
+					"tag": getComputedTagsSchema(),
+				},
+			},
+		},
+	}
+
+	for key, value := range extra {
+		result[key] = value
+	}
+	return result
+}
+
+func buildPolicyDataSourceListQuery(d *schema.ResourceData, tags []policySearchTag, additionalQuery map[string]string) string {
+	var clauses []string
+	if len(additionalQuery) > 0 {
+		clauses = append(clauses, buildQueryStringFromMap(additionalQuery))
+	}
+	if tagQuery := buildPolicyTagsQuery(tags); tagQuery != "" {
+		clauses = append(clauses, tagQuery)
+	}
+	if query := d.Get("query").(string); query != "" {
+		clauses = append(clauses, fmt.Sprintf("(%s)", query))
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+// policyDataSourceListFilter converts search results to policy resources of given types,
+// and filters them by display name regex and tags
+func policyDataSourceListFilter(results []*data.StructValue, resourceTypes []string, nameRegex *regexp.Regexp, tags []policySearchTag) ([]model.PolicyResource, error) {
+	var objects []model.PolicyResource
+	converter := bindings.NewTypeConverter()
+	for _, result := range results {
+		dataValue, errors := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errors) > 0 {
+			return nil, errors[0]
+		}
+		obj := dataValue.(model.PolicyResource)
+		if obj.ResourceType == nil || !stringInList(*obj.ResourceType, resourceTypes) {
+			continue
+		}
+		if nameRegex != nil && (obj.DisplayName == nil || !nameRegex.MatchString(*obj.DisplayName)) {
+			continue
+		}
+		if !policySearchTagsMatch(getPolicySearchTags(obj.Tags), tags) {
+			continue
+		}
+		objects = append(objects, obj)
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return *objects[i].Path < *objects[j].Path
+	})
+	return objects, nil
+}
+
+func policyDataSourceListRead(d *schema.ResourceData, m interface{}, resourceTypes []string, additionalQuery map[string]string) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+
+	var nameRegex *regexp.Regexp
+	if expr := d.Get("display_name_regex").(string); expr != "" {
+		var err error
+		nameRegex, err = regexp.Compile(expr)
+		if err != nil {
+			return fmt.Errorf("Invalid display_name_regex %s: %v", expr, err)
+		}
+	}
+	tags := getPolicySearchTagFiltersFromSchema(d, "tag")
+	query := buildPolicyDataSourceListQuery(d, tags, additionalQuery)
+
+	typeQuery := resourceTypes[0]
+	if len(resourceTypes) > 1 {
+		typeQuery = fmt.Sprintf("(%s)", strings.Join(resourceTypes, " OR "))
+	}
+	results, err := listPolicyResourcesByType(connector, context, typeQuery, &query)
+	if err != nil {
+		return fmt.Errorf("Error while searching for %s objects: %v", strings.Join(resourceTypes, ", "), err)
+	}
+
+	objects, err := policyDataSourceListFilter(results, resourceTypes, nameRegex, tags)
+	if err != nil {
+		return err
+	}
+
+	var items []interface{}
+	for _, obj := range objects {
+		item := make(map[string]interface{})
+		item["id"] = obj.Id
+		item["display_name"] = obj.DisplayName
+		item["description"] = obj.Description
+		item["path"] = obj.Path
+		var tagList []interface{}
+		for _, tag := range obj.Tags {
+			tagList = append(tagList, map[string]interface{}{
+				"scope": tag.Scope,
+				"tag":   tag.Tag,
+			})
+		}
+		item["tag"] = tagList
+		items = append(items, item)
+	}
+
+	d.SetId(newUUID())
+	return d.Set("items", items)
+}
+
+// getPolicyDataSourceListDomainQuery narrows results to given domain, unless
+// objects are listed within VPC, which has no domains
+func getPolicyDataSourceListDomainQuery(d *schema.ResourceData, m interface{}) map[string]string {
+	query := make(map[string]string)
+	if getSessionContext(d, m).ClientType != utl.VPC {
+		query["parent_path"] = "*/" + d.Get("domain").(string)
+	}
+	return query
+}
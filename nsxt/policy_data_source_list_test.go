@@ -0,0 +1,59 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func testPolicyDataSourceListResult(t *testing.T, resourceType string, name string, tags []model.Tag) *data.StructValue {
+	obj := model.PolicyResource{
+		Id:           strPtr(name),
+		DisplayName:  strPtr(name),
+		Path:         strPtr("/infra/objects/" + name),
+		ResourceType: strPtr(resourceType),
+		Tags:         tags,
+	}
+	dataValue, errs := bindings.NewTypeConverter().ConvertToVapi(obj, model.PolicyResourceBindingType())
+	if len(errs) > 0 {
+		t.Fatal(errs[0])
+	}
+	return dataValue.(*data.StructValue)
+}
+
+func TestPolicyDataSourceListFilter(t *testing.T) {
+	prodTag := []model.Tag{{Scope: strPtr("env"), Tag: strPtr("prod")}}
+	results := []*data.StructValue{
+		testPolicyDataSourceListResult(t, "Tier1", "web-2", prodTag),
+		testPolicyDataSourceListResult(t, "Tier0", "web-1", prodTag),
+		testPolicyDataSourceListResult(t, "Tier1", "db", prodTag),
+		testPolicyDataSourceListResult(t, "Tier1", "web-3", nil),
+		testPolicyDataSourceListResult(t, "Segment", "web-4", prodTag),
+	}
+
+	objects, err := policyDataSourceListFilter(results, []string{"Tier0", "Tier1"}, regexp.MustCompile("^web"), []policySearchTag{{scope: "env", tag: "prod"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 2 {
+		t.Fatalf("Expected 2 objects, got %d", len(objects))
+	}
+	// Results are sorted by path
+	if *objects[0].Id != "web-1" || *objects[1].Id != "web-2" {
+		t.Errorf("Unexpected objects %s, %s", *objects[0].Id, *objects[1].Id)
+	}
+}
+
+func TestBuildPolicyTagsQuery(t *testing.T) {
+	query := buildPolicyTagsQuery([]policySearchTag{{scope: "env", tag: "prod"}, {tag: "web"}, {}})
+	expected := "tags.scope:env AND tags.tag:prod AND tags.tag:web"
+	if query != expected {
+		t.Errorf("Expected query %s, got %s", expected, query)
+	}
+}
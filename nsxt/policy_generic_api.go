@@ -0,0 +1,213 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	vapiStdErrors_ "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	vapiBindings_ "github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	vapiCore_ "github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	vapiProtocol_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+)
+
+// Generic API client for policy objects, addressed by policy path rather than typed SDK client.
+// This allows managing objects not yet supported by the provider.
+
+const (
+	policyGenericAPIPrefix       = "/policy/api/v1"
+	policyGenericGlobalAPIPrefix = "/global-manager/api/v1"
+	policyGenericBodyField       = "body"
+)
+
+var policyGenericErrorCodes = map[string]int{
+	"com.vmware.vapi.std.errors.invalid_request":       400,
+	"com.vmware.vapi.std.errors.unauthorized":          403,
+	"com.vmware.vapi.std.errors.service_unavailable":   503,
+	"com.vmware.vapi.std.errors.internal_server_error": 500,
+	"com.vmware.vapi.std.errors.not_found":             404,
+	"com.vmware.vapi.std.errors.concurrent_change":     412,
+}
+
+func policyGenericObjectURL(policyPath string, isGlobalManager bool) string {
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(policyPath, "/"), "/") {
+		segments = append(segments, url.PathEscape(segment))
+	}
+
+	prefix := policyGenericAPIPrefix
+	if isGlobalManager {
+		prefix = policyGenericGlobalAPIPrefix
+	}
+	return fmt.Sprintf("%s/%s", prefix, strings.Join(segments, "/"))
+}
+
+func policyGenericInputType(withBody bool, query map[string]string) vapiBindings_.StructType {
+	fields := make(map[string]vapiBindings_.BindingType)
+	fieldNameMap := make(map[string]string)
+	if withBody {
+		fields[policyGenericBodyField] = vapiBindings_.NewDynamicStructType(nil)
+		fieldNameMap[policyGenericBodyField] = "Body"
+	}
+	for param := range query {
+		fields[param] = vapiBindings_.NewOptionalType(vapiBindings_.NewStringType())
+		fieldNameMap[param] = param
+	}
+	return vapiBindings_.NewStructType("operation-input", fields, reflect.TypeOf(data.StructValue{}), fieldNameMap, []vapiBindings_.Validator{})
+}
+
+func policyGenericRestMetadata(method string, objURL string, withBody bool, query map[string]string) vapiProtocol_.OperationRestMetadata {
+	fields := map[string]vapiBindings_.BindingType{}
+	fieldNameMap := map[string]string{}
+	paramsTypeMap := map[string]vapiBindings_.BindingType{}
+	queryParams := map[string]string{}
+	bodyName := ""
+	contentType := ""
+	if withBody {
+		fields[policyGenericBodyField] = vapiBindings_.NewDynamicStructType(nil)
+		fieldNameMap[policyGenericBodyField] = "Body"
+		paramsTypeMap[policyGenericBodyField] = vapiBindings_.NewDynamicStructType(nil)
+		bodyName = policyGenericBodyField
+		contentType = "application/json"
+	}
+	for param := range query {
+		fields[param] = vapiBindings_.NewOptionalType(vapiBindings_.NewStringType())
+		fieldNameMap[param] = param
+		paramsTypeMap[param] = vapiBindings_.NewOptionalType(vapiBindings_.NewStringType())
+		queryParams[param] = param
+	}
+	return vapiProtocol_.NewOperationRestMetadata(
+		fields,
+		fieldNameMap,
+		paramsTypeMap,
+		map[string]string{},
+		queryParams,
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		"",
+		bodyName,
+		method,
+		objURL,
+		contentType,
+		map[string]string{},
+		200,
+		"",
+		map[string]map[string]string{},
+		policyGenericErrorCodes)
+}
+
+// policyGenericInvoke sends REST request for policy object identified by its path
+func policyGenericInvoke(connector client.Connector, isGlobalManager bool, method string, policyPath string, body *data.StructValue) (data.DataValue, error) {
+	return policyGenericInvokeWithQuery(connector, isGlobalManager, method, policyPath, body, nil)
+}
+
+func policyGenericInvokeWithQuery(connector client.Connector, isGlobalManager bool, method string, policyPath string, body *data.StructValue, query map[string]string) (data.DataValue, error) {
+	typeConverter := connector.TypeConverter()
+	executionContext := connector.NewExecutionContext()
+	withBody := body != nil
+	objURL := policyGenericObjectURL(policyPath, isGlobalManager)
+	executionContext.SetConnectionMetadata(vapiCore_.RESTMetadataKey, policyGenericRestMetadata(method, objURL, withBody, query))
+	executionContext.SetConnectionMetadata(vapiCore_.ResponseTypeKey, vapiCore_.NewResponseType(true, false))
+
+	input := data.NewStructValue("operation-input", nil)
+	if withBody {
+		input.SetField(policyGenericBodyField, body)
+	}
+	for param, value := range query {
+		input.SetField(param, data.NewOptionalValue(data.NewStringValue(value)))
+	}
+	if errs := policyGenericInputType(withBody, query).Validate(input); len(errs) > 0 {
+		return nil, vapiBindings_.VAPIerrorsToError(errs)
+	}
+
+	methodResult := connector.GetApiProvider().Invoke("com.vmware.nsx_policy.generic", strings.ToLower(method), input, executionContext)
+	if !methodResult.IsSuccess() {
+		methodError, errorInError := typeConverter.ConvertToGolang(methodResult.Error(), vapiStdErrors_.ERROR_BINDINGS_MAP[methodResult.Error().Name()])
+		if errorInError != nil {
+			return nil, vapiBindings_.VAPIerrorsToError(errorInError)
+		}
+		return nil, methodError.(error)
+	}
+
+	return methodResult.Output(), nil
+}
+
+// policyGenericDecodeJSON decodes JSON preserving integer values
+func policyGenericDecodeJSON(body string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var result interface{}
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func policyGenericGet(connector client.Connector, isGlobalManager bool, policyPath string) (map[string]interface{}, error) {
+	return policyGenericGetWithQuery(connector, isGlobalManager, policyPath, nil)
+}
+
+func policyGenericGetWithQuery(connector client.Connector, isGlobalManager bool, policyPath string, query map[string]string) (map[string]interface{}, error) {
+	output, err := policyGenericInvokeWithQuery(connector, isGlobalManager, "GET", policyPath, nil, query)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := output.(*data.StructValue); !ok {
+		return nil, fmt.Errorf("unexpected response type %T for %s", output, policyPath)
+	}
+	response, err := cleanjson.NewDataValueToJsonEncoder().Encode(output)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := policyGenericDecodeJSON(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response for %s: %v", policyPath, err)
+	}
+	result, ok := decoded.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected response for %s", policyPath)
+	}
+	return result, nil
+}
+
+func policyGenericPatch(connector client.Connector, isGlobalManager bool, policyPath string, body map[string]interface{}) error {
+	dataValue, err := cleanjson.NewJsonToDataValueDecoder().Decode(body)
+	if err != nil {
+		return fmt.Errorf("failed to convert body for %s: %v", policyPath, err)
+	}
+	structValue, ok := dataValue.(*data.StructValue)
+	if !ok {
+		return fmt.Errorf("body for %s is expected to be an object", policyPath)
+	}
+	_, err = policyGenericInvoke(connector, isGlobalManager, "PATCH", policyPath, structValue)
+	return err
+}
+
+func policyGenericDelete(connector client.Connector, isGlobalManager bool, policyPath string) error {
+	_, err := policyGenericInvoke(connector, isGlobalManager, "DELETE", policyPath, nil)
+	return err
+}
+
+// policyGenericGetModel retrieves object by policy path, and converts it to SDK model of given
+// binding type. This allows same implementation for runtime APIs in all policy contexts.
+func policyGenericGetModel(connector client.Connector, isGlobalManager bool, policyPath string, query map[string]string, bindingType vapiBindings_.BindingType) (interface{}, error) {
+	output, err := policyGenericInvokeWithQuery(connector, isGlobalManager, "GET", policyPath, nil, query)
+	if err != nil {
+		return nil, err
+	}
+
+	converted, errs := vapiBindings_.NewTypeConverter().ConvertToGolang(output, bindingType)
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	return converted, nil
+}
@@ -0,0 +1,390 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// policyImportResource maps NSX resource type to provider resource
+type policyImportResource struct {
+	resourceName string
+	// Resource importer expects object ID rather than policy path
+	importByID bool
+}
+
+var policyImportResources = map[string]policyImportResource{
+	"Group":                {resourceName: "nsxt_policy_group"},
+	"Service":              {resourceName: "nsxt_policy_service"},
+	"SecurityPolicy":       {resourceName: "nsxt_policy_security_policy"},
+	"GatewayPolicy":        {resourceName: "nsxt_policy_gateway_policy"},
+	"PolicyContextProfile": {resourceName: "nsxt_policy_context_profile"},
+	"Segment":              {resourceName: "nsxt_policy_segment"},
+	"Tier1":                {resourceName: "nsxt_policy_tier1_gateway"},
+	"Tier0":                {resourceName: "nsxt_policy_tier0_gateway", importByID: true},
+	"IpAddressPool":        {resourceName: "nsxt_policy_ip_pool"},
+	"IpAddressBlock":       {resourceName: "nsxt_policy_ip_block"},
+	"DhcpServerConfig":     {resourceName: "nsxt_policy_dhcp_server"},
+}
+
+// PolicyImportOptions defines which objects are discovered by GeneratePolicyImport
+type PolicyImportOptions struct {
+	// NSX resource types to discover, for example Group. All supported types if empty.
+	ResourceTypes []string
+	// Project to discover objects in. Default space if empty.
+	ProjectID string
+	// Tag filters in scope:tag format, either part can be empty. Objects need to match all filters.
+	Tags []string
+	// Generate resource configuration in addition to import blocks
+	GenerateConfig bool
+}
+
+// PolicyImportResourceTypes returns NSX resource types supported by GeneratePolicyImport
+func PolicyImportResourceTypes() []string {
+	var result []string
+	for resourceType := range policyImportResources {
+		result = append(result, resourceType)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func parsePolicyImportTags(tags []string) []policySearchTag {
+	var result []policySearchTag
+	for _, tag := range tags {
+		segs := strings.SplitN(tag, ":", 2)
+		if len(segs) == 1 {
+			result = append(result, policySearchTag{tag: segs[0]})
+		} else {
+			result = append(result, policySearchTag{scope: segs[0], tag: segs[1]})
+		}
+	}
+	return result
+}
+
+// policyImportFilterResults filters out system owned objects, and objects that don't match
+// tag filters exactly, since search query may match scope and tag from different tags
+func policyImportFilterResults(results []*data.StructValue, resourceType string, tags []policySearchTag) ([]model.PolicyResource, error) {
+	var objects []model.PolicyResource
+	converter := bindings.NewTypeConverter()
+	for _, result := range results {
+		dataValue, errors := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errors) > 0 {
+			return nil, errors[0]
+		}
+		obj := dataValue.(model.PolicyResource)
+		if obj.ResourceType == nil || *obj.ResourceType != resourceType || obj.Path == nil {
+			continue
+		}
+		if (obj.SystemOwned != nil && *obj.SystemOwned) || (obj.CreateUser != nil && *obj.CreateUser == "system") {
+			continue
+		}
+		if !policySearchTagsMatch(getPolicySearchTags(obj.Tags), tags) {
+			continue
+		}
+		objects = append(objects, obj)
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return *objects[i].Path < *objects[j].Path
+	})
+	return objects, nil
+}
+
+// policyImportLabel builds unique terraform resource label from object name
+func policyImportLabel(name string, resourceName string, used map[string]bool) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	label := b.String()
+	if label == "" || (label[0] >= '0' && label[0] <= '9') || label[0] == '-' {
+		label = "_" + label
+	}
+
+	unique := label
+	for i := 2; used[resourceName+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	used[resourceName+"."+unique] = true
+	return unique
+}
+
+// GeneratePolicyImport discovers existing policy objects on NSX, and writes terraform
+// import blocks for them, optionally with resource configuration. The provider is
+// expected to be configured.
+func GeneratePolicyImport(provider *schema.Provider, options PolicyImportOptions, w io.Writer) error {
+	m := provider.Meta()
+	if m == nil {
+		return fmt.Errorf("provider is not configured")
+	}
+	connector := getPolicyConnector(m)
+
+	sessionContext := utl.SessionContext{ClientType: utl.Local}
+	if options.ProjectID != "" {
+		sessionContext = utl.SessionContext{ClientType: utl.Multitenancy, ProjectID: options.ProjectID}
+	} else if isPolicyGlobalManager(m) {
+		sessionContext = utl.SessionContext{ClientType: utl.Global}
+	}
+
+	tags := parsePolicyImportTags(options.Tags)
+	query := buildPolicyTagsQuery(tags)
+
+	resourceTypes := options.ResourceTypes
+	if len(resourceTypes) == 0 {
+		resourceTypes = PolicyImportResourceTypes()
+	}
+
+	used := make(map[string]bool)
+	for _, resourceType := range resourceTypes {
+		importResource, ok := policyImportResources[resourceType]
+		if !ok {
+			return fmt.Errorf("resource type %s is not supported, supported types are: %s", resourceType, strings.Join(PolicyImportResourceTypes(), ", "))
+		}
+
+		results, err := listPolicyResourcesByType(connector, sessionContext, resourceType, &query)
+		if err != nil {
+			return logAPIError(fmt.Sprintf("Failed to search for %s objects", resourceType), err)
+		}
+		objects, err := policyImportFilterResults(results, resourceType, tags)
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Found %d %s objects", len(objects), resourceType)
+
+		for _, obj := range objects {
+			importID := *obj.Path
+			if importResource.importByID {
+				importID = *obj.Id
+			}
+			name := *obj.Id
+			if obj.DisplayName != nil && *obj.DisplayName != "" {
+				name = *obj.DisplayName
+			}
+			label := policyImportLabel(name, importResource.resourceName, used)
+
+			fmt.Fprintf(w, "import {\n  to = %s.%s\n  id = %s\n}\n\n", importResource.resourceName, label, policyImportQuote(importID))
+			if !options.GenerateConfig {
+				continue
+			}
+
+			config, err := policyImportResourceConfig(provider.ResourcesMap[importResource.resourceName], importID, m)
+			if err != nil {
+				log.Printf("[WARN] Failed to generate configuration for %s: %v", *obj.Path, err)
+				fmt.Fprintf(w, "# Failed to generate configuration for %s: %v\n\n", *obj.Path, strings.ReplaceAll(err.Error(), "\n", " "))
+				continue
+			}
+			fmt.Fprintf(w, "resource %q %q {\n%s}\n\n", importResource.resourceName, label, config)
+		}
+	}
+
+	return nil
+}
+
+// policyImportResourceConfig imports and reads the object using provider resource,
+// and renders its configurable attributes as HCL
+func policyImportResourceConfig(r *schema.Resource, importID string, m interface{}) (string, error) {
+	d := r.Data(nil)
+	d.SetId(importID)
+
+	states := []*schema.ResourceData{d}
+	var err error
+	if r.Importer != nil {
+		if r.Importer.StateContext != nil {
+			states, err = r.Importer.StateContext(context.Background(), d, m)
+		} else if r.Importer.State != nil {
+			states, err = r.Importer.State(d, m)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(states) == 0 {
+		return "", fmt.Errorf("import of %s returned no state", importID)
+	}
+	d = states[0]
+
+	if r.Read != nil {
+		err = r.Read(d, m)
+	} else if r.ReadContext != nil {
+		diags := r.ReadContext(context.Background(), d, m)
+		if diags.HasError() {
+			err = fmt.Errorf("%s", diags[0].Summary)
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	if d.Id() == "" {
+		return "", fmt.Errorf("object %s not found", importID)
+	}
+
+	values := make(map[string]interface{})
+	for key := range r.Schema {
+		values[key] = d.Get(key)
+	}
+
+	var b strings.Builder
+	policyImportWriteAttributes(&b, r.Schema, values, "  ")
+	return b.String(), nil
+}
+
+func policyImportQuote(value string) string {
+	quoted := strconv.Quote(value)
+	// Escape HCL template sequences
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+func policyImportIsConfigurable(s *schema.Schema) bool {
+	return (s.Optional || s.Required) && s.Deprecated == ""
+}
+
+// policyImportIsDefault returns true if value does not need to be specified in configuration
+func policyImportIsDefault(s *schema.Schema, value interface{}) bool {
+	if s.Required {
+		return false
+	}
+	if s.Default != nil {
+		return reflect.DeepEqual(s.Default, value)
+	}
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func policyImportPrimitive(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return policyImportQuote(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return policyImportQuote(fmt.Sprintf("%v", value))
+}
+
+func policyImportList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+// policyImportWriteAttributes writes attributes aligned in terraform fmt style, followed by nested blocks
+func policyImportWriteAttributes(b *strings.Builder, s map[string]*schema.Schema, values map[string]interface{}, indent string) {
+	var keys []string
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var attributes, blocks []string
+	for _, key := range keys {
+		if !policyImportIsConfigurable(s[key]) || policyImportIsDefault(s[key], values[key]) {
+			continue
+		}
+		if _, isBlock := s[key].Elem.(*schema.Resource); isBlock {
+			blocks = append(blocks, key)
+		} else {
+			attributes = append(attributes, key)
+		}
+	}
+
+	width := 0
+	for _, key := range attributes {
+		if len(key) > width {
+			width = len(key)
+		}
+	}
+
+	for _, key := range attributes {
+		value := values[key]
+		prefix := fmt.Sprintf("%s%-*s = ", indent, width, key)
+		switch s[key].Type {
+		case schema.TypeList, schema.TypeSet:
+			var items []string
+			for _, item := range policyImportList(value) {
+				items = append(items, policyImportPrimitive(item))
+			}
+			if s[key].Type == schema.TypeSet {
+				// Keep generated configuration stable
+				sort.Strings(items)
+			}
+			fmt.Fprintf(b, "%s[%s]\n", prefix, strings.Join(items, ", "))
+		case schema.TypeMap:
+			mapValue := value.(map[string]interface{})
+			var mapKeys []string
+			for mapKey := range mapValue {
+				mapKeys = append(mapKeys, mapKey)
+			}
+			sort.Strings(mapKeys)
+			fmt.Fprintf(b, "%s{\n", prefix)
+			for _, mapKey := range mapKeys {
+				fmt.Fprintf(b, "%s  %s = %s\n", indent, policyImportQuote(mapKey), policyImportPrimitive(mapValue[mapKey]))
+			}
+			fmt.Fprintf(b, "%s}\n", indent)
+		default:
+			fmt.Fprintf(b, "%s%s\n", prefix, policyImportPrimitive(value))
+		}
+	}
+
+	separate := len(attributes) > 0
+	for _, key := range blocks {
+		elem := s[key].Elem.(*schema.Resource)
+		for _, item := range policyImportList(values[key]) {
+			itemValues, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if separate {
+				b.WriteString("\n")
+			}
+			separate = true
+			fmt.Fprintf(b, "%s%s {\n", indent, key)
+			policyImportWriteAttributes(b, elem.Schema, itemValues, indent+"  ")
+			fmt.Fprintf(b, "%s}\n", indent)
+		}
+	}
+}
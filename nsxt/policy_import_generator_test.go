@@ -0,0 +1,89 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPolicyImportLabel(t *testing.T) {
+	used := make(map[string]bool)
+	cases := []struct {
+		name     string
+		expected string
+	}{
+		{"Web Servers", "web_servers"},
+		{"Web-Servers", "web-servers"},
+		{"web servers", "web_servers_2"},
+		{"10.0.0.0/24", "_10_0_0_0_24"},
+		{"", "_"},
+	}
+
+	for _, c := range cases {
+		label := policyImportLabel(c.name, "nsxt_policy_group", used)
+		if label != c.expected {
+			t.Errorf("Expected label %s for %q, got %s", c.expected, c.name, label)
+		}
+	}
+
+	if label := policyImportLabel("Web Servers", "nsxt_policy_service", used); label != "web_servers" {
+		t.Errorf("Expected label to be unique per resource, got %s", label)
+	}
+}
+
+func TestPolicyImportResourceConfig(t *testing.T) {
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			d.Set("display_name", "test ${var}")
+			d.Set("enabled", false)
+			d.Set("members", []interface{}{"b", "a"})
+			d.Set("tag", []interface{}{map[string]interface{}{"scope": "env", "tag": "prod"}})
+			d.Set("path", "/infra/test")
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"display_name": {Type: schema.TypeString, Required: true},
+			"description":  {Type: schema.TypeString, Optional: true},
+			"enabled":      {Type: schema.TypeBool, Optional: true, Default: true},
+			"members": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tag": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope": {Type: schema.TypeString, Optional: true},
+						"tag":   {Type: schema.TypeString, Optional: true},
+					},
+				},
+			},
+			"path": {Type: schema.TypeString, Computed: true},
+		},
+	}
+
+	config, err := policyImportResourceConfig(r, "test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `  display_name = "test $${var}"
+  enabled      = false
+  members      = ["a", "b"]
+
+  tag {
+    scope = "env"
+    tag   = "prod"
+  }
+`
+	if config != expected {
+		t.Errorf("Expected configuration:\n%s\ngot:\n%s", expected, config)
+	}
+}
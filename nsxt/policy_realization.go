@@ -0,0 +1,194 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	realizedstate "github.com/vmware/terraform-provider-nsxt/api/infra/realized_state"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// Some policy objects are never realized on enforcement point. If no realized
+// entities appear within this period, the object is considered to be realized.
+const policyRealizationNoEntityTimeout = 10 * time.Second
+
+const policyRealizationStateNoEntity = "NO_ENTITY"
+
+// isPolicyRealizationResource returns true for resources that represent policy intent,
+// and thus can be waited upon for realization
+func isPolicyRealizationResource(name string, r *schema.Resource) bool {
+	if !strings.HasPrefix(name, "nsxt_policy_") && !strings.HasPrefix(name, "nsxt_vpc") {
+		return false
+	}
+	if r.Create == nil {
+		return false
+	}
+	_, hasPath := r.Schema["path"]
+	return hasPath
+}
+
+// policyRealizationWrapper adds realization wait to create and update of policy resource,
+// to be performed when enabled in provider configuration
+func policyRealizationWrapper(r *schema.Resource) {
+	originalCreate := r.Create
+	r.Create = func(d *schema.ResourceData, m interface{}) error {
+		if err := originalCreate(d, m); err != nil {
+			return err
+		}
+		return nsxtPolicyWaitForRealization(d, m)
+	}
+
+	if r.Update != nil {
+		originalUpdate := r.Update
+		r.Update = func(d *schema.ResourceData, m interface{}) error {
+			if err := originalUpdate(d, m); err != nil {
+				return err
+			}
+			return nsxtPolicyWaitForRealization(d, m)
+		}
+	}
+}
+
+func getPolicyRealizationContext(d *schema.ResourceData, m interface{}, path string) utl.SessionContext {
+	context := getParentContext(d, m, path)
+	if context.ClientType == utl.VPC {
+		// VPC objects are realized within project scope
+		context = utl.SessionContext{
+			ClientType: utl.Multitenancy,
+			ProjectID:  context.ProjectID,
+		}
+	}
+	return context
+}
+
+// nsxtPolicyWaitForRealization waits for realization of the object identified by path
+// attribute, and returns error with realization failure details if realization fails
+func nsxtPolicyWaitForRealization(d *schema.ResourceData, m interface{}) error {
+	config := m.(nsxtClients).CommonConfig
+	if !config.RealizationWait {
+		return nil
+	}
+
+	path := d.Get("path").(string)
+	if path == "" {
+		return nil
+	}
+	if isPolicyGlobalManager(m) {
+		// Realization on Global Manager is tracked per site
+		log.Printf("[DEBUG] Skipping realization wait for %s on Global Manager", path)
+		return nil
+	}
+
+	client := realizedstate.NewRealizedEntitiesClient(getPolicyRealizationContext(d, m, path), getPolicyConnector(m))
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+
+	log.Printf("[DEBUG] Waiting for realization of %s", path)
+	start := time.Now()
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"UNKNOWN", model.GenericPolicyRealizedResource_STATE_UNREALIZED},
+		Target: []string{
+			model.GenericPolicyRealizedResource_STATE_REALIZED,
+			model.GenericPolicyRealizedResource_STATE_ERROR,
+			policyRealizationStateNoEntity,
+		},
+		Refresh: func() (interface{}, string, error) {
+			result, err := client.List(path, nil)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(result.Results) == 0 {
+				if time.Since(start) > policyRealizationNoEntityTimeout {
+					return result.Results, policyRealizationStateNoEntity, nil
+				}
+				return result.Results, "UNKNOWN", nil
+			}
+			return result.Results, getPolicyRealizationState(result.Results), nil
+		},
+		Timeout:    time.Duration(config.RealizationTimeout) * time.Second,
+		MinTimeout: 1 * time.Second,
+		Delay:      1 * time.Second,
+	}
+
+	entities, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Failed to wait for realization of %s: %v", path, err)
+	}
+
+	return getPolicyRealizationError(path, entities.([]model.GenericPolicyRealizedResource))
+}
+
+// getPolicyRealizationState returns consolidated state of realized entities for an intent
+func getPolicyRealizationState(entities []model.GenericPolicyRealizedResource) string {
+	state := model.GenericPolicyRealizedResource_STATE_REALIZED
+	for _, entity := range entities {
+		if entity.State == nil {
+			state = "UNKNOWN"
+			continue
+		}
+		switch *entity.State {
+		case model.GenericPolicyRealizedResource_STATE_ERROR:
+			return model.GenericPolicyRealizedResource_STATE_ERROR
+		case model.GenericPolicyRealizedResource_STATE_UNREALIZED:
+			state = model.GenericPolicyRealizedResource_STATE_UNREALIZED
+		}
+	}
+	return state
+}
+
+// getPolicyRealizationError collects alarms and error messages from realized entities in ERROR state
+func getPolicyRealizationError(path string, entities []model.GenericPolicyRealizedResource) error {
+	var messages []string
+	for _, entity := range entities {
+		if entity.State == nil || *entity.State != model.GenericPolicyRealizedResource_STATE_ERROR {
+			continue
+		}
+		entityName := "realized entity"
+		if entity.EntityType != nil {
+			entityName = *entity.EntityType
+		}
+		if entity.Id != nil {
+			entityName = fmt.Sprintf("%s %s", entityName, *entity.Id)
+		}
+
+		var details []string
+		for _, alarm := range entity.Alarms {
+			if alarm.Message != nil {
+				details = append(details, *alarm.Message)
+			}
+			if alarm.ErrorDetails != nil && alarm.ErrorDetails.ErrorMessage != nil {
+				details = append(details, *alarm.ErrorDetails.ErrorMessage)
+			}
+		}
+		if entity.PublishStatusError != nil {
+			details = append(details, *entity.PublishStatusError)
+		}
+		for _, element := range entity.PublishStatusErrorDetails {
+			if element.FailureMessage != nil {
+				details = append(details, *element.FailureMessage)
+			}
+		}
+		if entity.RuntimeError != nil {
+			details = append(details, *entity.RuntimeError)
+		}
+		if len(details) == 0 {
+			details = append(details, "no error details available")
+		}
+		messages = append(messages, fmt.Sprintf("%s: %s", entityName, strings.Join(details, "; ")))
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Realization of %s failed:\n%s", path, strings.Join(messages, "\n"))
+}
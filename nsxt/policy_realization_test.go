@@ -0,0 +1,85 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestGetPolicyRealizationState(t *testing.T) {
+	realized := model.GenericPolicyRealizedResource_STATE_REALIZED
+	unrealized := model.GenericPolicyRealizedResource_STATE_UNREALIZED
+	failed := model.GenericPolicyRealizedResource_STATE_ERROR
+
+	cases := []struct {
+		states   []*string
+		expected string
+	}{
+		{[]*string{&realized, &realized}, realized},
+		{[]*string{&realized, &unrealized}, unrealized},
+		{[]*string{&unrealized, &failed}, failed},
+		{[]*string{nil, &realized}, "UNKNOWN"},
+	}
+
+	for _, c := range cases {
+		var entities []model.GenericPolicyRealizedResource
+		for _, state := range c.states {
+			entities = append(entities, model.GenericPolicyRealizedResource{State: state})
+		}
+		state := getPolicyRealizationState(entities)
+		if state != c.expected {
+			t.Errorf("Expected state %s, got %s", c.expected, state)
+		}
+	}
+}
+
+func TestGetPolicyRealizationError(t *testing.T) {
+	entities := []model.GenericPolicyRealizedResource{
+		{
+			State: strPtr(model.GenericPolicyRealizedResource_STATE_REALIZED),
+			Id:    strPtr("ok"),
+		},
+		{
+			State:      strPtr(model.GenericPolicyRealizedResource_STATE_ERROR),
+			Id:         strPtr("failed"),
+			EntityType: strPtr("RealizedLogicalSwitch"),
+			Alarms: []model.PolicyAlarmResource{
+				{Message: strPtr("Transport zone not found")},
+			},
+			RuntimeError: strPtr("Runtime failure"),
+		},
+	}
+
+	err := getPolicyRealizationError("/infra/segments/test", entities)
+	if err == nil {
+		t.Fatal("Expected realization error")
+	}
+	for _, expected := range []string{"/infra/segments/test", "RealizedLogicalSwitch failed", "Transport zone not found", "Runtime failure"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected %q in error: %v", expected, err)
+		}
+	}
+	if strings.Contains(err.Error(), " ok") {
+		t.Errorf("Unexpected realized entity in error: %v", err)
+	}
+
+	if err := getPolicyRealizationError("/infra/segments/test", entities[:1]); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestPolicyRealizationResources(t *testing.T) {
+	provider := Provider()
+	for _, name := range []string{"nsxt_policy_segment", "nsxt_policy_group", "nsxt_vpc_subnet"} {
+		if !isPolicyRealizationResource(name, provider.ResourcesMap[name]) {
+			t.Errorf("Expected realization wait for %s", name)
+		}
+	}
+	if isPolicyRealizationResource("nsxt_compute_manager", provider.ResourcesMap["nsxt_compute_manager"]) {
+		t.Errorf("Unexpected realization wait for nsxt_compute_manager")
+	}
+}
@@ -6,14 +6,19 @@ package nsxt
 import (
 	"errors"
 	"fmt"
+	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/search"
+	mp_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+	lm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	lm_search "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
 
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
@@ -24,13 +29,51 @@ type policySearchDataValue struct {
 	Resource    model.PolicyResource
 }
 
+const (
+	policyDataSourceMatchModeExact  = "exact"
+	policyDataSourceMatchModePrefix = "prefix"
+	policyDataSourceMatchModeRegex  = "regex"
+)
+
+var policyDataSourceMatchModeValues = []string{policyDataSourceMatchModeExact, policyDataSourceMatchModePrefix, policyDataSourceMatchModeRegex}
+
+func getDataSourceMatchModeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "How display name is matched against objects",
+		Optional:     true,
+		Default:      policyDataSourceMatchModePrefix,
+		ValidateFunc: validation.StringInSlice(policyDataSourceMatchModeValues, false),
+	}
+}
+
+func getPolicyDataSourceMatchMode(d *schema.ResourceData) string {
+	// Data sources that do not expose match mode preserve original prefix behavior
+	matchMode, _ := d.Get("match_mode").(string)
+	if matchMode == "" {
+		return policyDataSourceMatchModePrefix
+	}
+	return matchMode
+}
+
 func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues []*data.StructValue, resourceType string) (*data.StructValue, error) {
 	var perfectMatch, prefixMatch []policySearchDataValue
 	var obj policySearchDataValue
 	objName := d.Get("display_name").(string)
 	objID := d.Get("id").(string)
+	matchMode := getPolicyDataSourceMatchMode(d)
+	tags := getPolicySearchTagFiltersFromSchema(d, "tag")
 	converter := bindings.NewTypeConverter()
 
+	var nameRegex *regexp.Regexp
+	if objID == "" && matchMode == policyDataSourceMatchModeRegex {
+		var err error
+		nameRegex, err = regexp.Compile(objName)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid regular expression '%s' for %s display name: %v", objName, resourceType, err)
+		}
+	}
+
 	for _, result := range resultValues {
 		dataValue, errors := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
 		if len(errors) > 0 {
@@ -40,15 +83,23 @@ func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues [
 		if resourceType != *policyResource.ResourceType {
 			continue
 		}
+		if !policySearchTagsMatch(getPolicySearchTags(policyResource.Tags), tags) {
+			continue
+		}
 
 		if objID != "" {
 			perfectMatch = append(perfectMatch, policySearchDataValue{StructValue: result, Resource: policyResource})
 			break
+		} else if nameRegex != nil {
+			if nameRegex.MatchString(*policyResource.DisplayName) {
+				perfectMatch = append(perfectMatch, policySearchDataValue{StructValue: result, Resource: policyResource})
+			}
 		} else {
-			if *policyResource.DisplayName == objName {
+			// Without display name, any object matching the query and tags is a perfect match
+			if objName == "" || *policyResource.DisplayName == objName {
 				perfectMatch = append(perfectMatch, policySearchDataValue{StructValue: result, Resource: policyResource})
 			}
-			if strings.HasPrefix(*policyResource.DisplayName, objName) {
+			if objName != "" && matchMode == policyDataSourceMatchModePrefix && strings.HasPrefix(*policyResource.DisplayName, objName) {
 				prefixMatch = append(prefixMatch, policySearchDataValue{StructValue: result, Resource: policyResource})
 			}
 		}
@@ -59,6 +110,9 @@ func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues [
 			if objID != "" {
 				return nil, fmt.Errorf("Found multiple %s with ID '%s'", resourceType, objID)
 			}
+			if nameRegex != nil {
+				return nil, fmt.Errorf("Found multiple %s with name matching '%s'", resourceType, objName)
+			}
 			return nil, fmt.Errorf("Found multiple %s with name '%s'", resourceType, objName)
 		}
 		obj = perfectMatch[0]
@@ -67,10 +121,14 @@ func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues [
 			return nil, fmt.Errorf("Found multiple %s with name starting with '%s'", resourceType, objName)
 		}
 		obj = prefixMatch[0]
+		log.Printf("[WARN] %s with name '%s' was not found, using %s with name '%s' instead. Set match_mode to '%s' to avoid prefix match", resourceType, objName, resourceType, *obj.Resource.DisplayName, policyDataSourceMatchModeExact)
 	} else {
 		if objID != "" {
 			return nil, fmt.Errorf("%s with ID '%s' was not found", resourceType, objID)
 		}
+		if nameRegex != nil {
+			return nil, fmt.Errorf("%s with name matching '%s' was not found", resourceType, objName)
+		}
 		return nil, fmt.Errorf("%s with name '%s' was not found", resourceType, objName)
 	}
 
@@ -89,11 +147,18 @@ func policyDataSourceResourceRead(d *schema.ResourceData, connector client.Conne
 func policyDataSourceResourceReadWithValidation(d *schema.ResourceData, connector client.Connector, context utl.SessionContext, resourceType string, additionalQuery map[string]string, paramsValidation bool) (*data.StructValue, error) {
 	objName := d.Get("display_name").(string)
 	objID := d.Get("id").(string)
+	tags := getPolicySearchTagFiltersFromSchema(d, "tag")
 	var err error
 	var resultValues []*data.StructValue
 	additionalQueryString := buildQueryStringFromMap(additionalQuery)
-	if paramsValidation && objID == "" && objName == "" {
-		return nil, fmt.Errorf("No 'id' or 'display_name' specified for %s", resourceType)
+	if tagQuery := buildPolicyTagsQuery(tags); tagQuery != "" {
+		if additionalQueryString != "" {
+			additionalQueryString = additionalQueryString + " AND "
+		}
+		additionalQueryString = additionalQueryString + tagQuery
+	}
+	if paramsValidation && objID == "" && objName == "" && len(tags) == 0 {
+		return nil, fmt.Errorf("No 'id', 'display_name' or 'tag' specified for %s", resourceType)
 	}
 	if objID != "" {
 		if resourceType == "PolicyEdgeNode" {
@@ -103,6 +168,9 @@ func policyDataSourceResourceReadWithValidation(d *schema.ResourceData, connecto
 		} else {
 			resultValues, err = listPolicyResourcesByID(connector, context, &objID, &additionalQueryString)
 		}
+	} else if getPolicyDataSourceMatchMode(d) == policyDataSourceMatchModeRegex {
+		// Regular expression can not be expressed in search query, hence matching is done on client side
+		resultValues, err = listPolicyResourcesByType(connector, context, resourceType, &additionalQueryString)
 	} else {
 		resultValues, err = listPolicyResourcesByNameAndType(connector, context, objName, resourceType, &additionalQueryString)
 	}
@@ -127,6 +195,120 @@ func listPolicyResourcesByNameAndType(connector client.Connector, context utl.Se
 	return nil, errors.New("invalid ClientType")
 }
 
+func listPolicyResourcesByType(connector client.Connector, context utl.SessionContext, resourceType string, additionalQuery *string) ([]*data.StructValue, error) {
+	query := fmt.Sprintf("resource_type:%s AND marked_for_delete:false", resourceType)
+	switch context.ClientType {
+	case utl.Local:
+		return searchLMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
+	case utl.Global:
+		return searchGMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
+	case utl.Multitenancy, utl.VPC:
+		return searchMultitenancyResources(connector, context, *buildPolicyResourcesQuery(&query, additionalQuery))
+	}
+
+	return nil, errors.New("invalid ClientType")
+}
+
+// policySearchTag is a tag filter for policy search. Empty scope or tag matches any value.
+type policySearchTag struct {
+	scope string
+	tag   string
+}
+
+func getPolicySearchTagFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Filter objects by tag. Objects need to match all tag filters",
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"scope": {
+					Type:        schema.TypeString,
+					Description: "Tag scope. If not specified, any scope matches",
+					Optional:    true,
+				},
+				"tag": {
+					Type:        schema.TypeString,
+					Description: "Tag value. If not specified, any value matches",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func getPolicySearchTagFiltersFromSchema(d *schema.ResourceData, schemaName string) []policySearchTag {
+	var filters []policySearchTag
+	items, _ := d.Get(schemaName).([]interface{})
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		data := item.(map[string]interface{})
+		filters = append(filters, policySearchTag{scope: data["scope"].(string), tag: data["tag"].(string)})
+	}
+	return filters
+}
+
+// buildPolicyTagQuery builds search query for objects with given tag. Either scope or tag may be empty.
+func buildPolicyTagQuery(scope string, tag string) string {
+	var clauses []string
+	if scope != "" {
+		clauses = append(clauses, fmt.Sprintf("tags.scope:%s", escapeSpecialCharacters(scope)))
+	}
+	if tag != "" {
+		clauses = append(clauses, fmt.Sprintf("tags.tag:%s", escapeSpecialCharacters(tag)))
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+// buildPolicyTagsQuery builds search query narrowing results to objects with all given tags.
+// Since scope and tag may be matched in different tags, results need to be filtered
+// with policySearchTagsMatch.
+func buildPolicyTagsQuery(filters []policySearchTag) string {
+	var clauses []string
+	for _, filter := range filters {
+		if query := buildPolicyTagQuery(filter.scope, filter.tag); query != "" {
+			clauses = append(clauses, query)
+		}
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+// getPolicySearchTags converts tags of Global Manager, Local Manager or MP model into scope/tag pairs,
+// so that objects of all models can be filtered with policySearchTagsMatch
+func getPolicySearchTags[T model.Tag | lm_model.Tag | mp_model.Tag](objTags []T) []policySearchTag {
+	var pairs []policySearchTag
+	for _, objTag := range objTags {
+		tag := model.Tag(objTag)
+		pair := policySearchTag{}
+		if tag.Scope != nil {
+			pair.scope = *tag.Scope
+		}
+		if tag.Tag != nil {
+			pair.tag = *tag.Tag
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs
+}
+
+func policySearchTagsMatch(objTags []policySearchTag, filters []policySearchTag) bool {
+	for _, filter := range filters {
+		found := false
+		for _, objTag := range objTags {
+			if (filter.scope == "" || filter.scope == objTag.scope) && (filter.tag == "" || filter.tag == objTag.tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func listInventoryResourcesByNameAndType(connector client.Connector, context utl.SessionContext, displayName string, resourceType string, additionalQuery *string) ([]*data.StructValue, error) {
 	query := fmt.Sprintf("resource_type:%s AND display_name:%s*", resourceType, escapeSpecialCharacters(displayName))
 	return searchLM(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
@@ -0,0 +1,82 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func TestPolicyDataSourceResourceFilterAndSet(t *testing.T) {
+	prodTag := []model.Tag{{Scope: strPtr("env"), Tag: strPtr("prod")}}
+	results := []*data.StructValue{
+		testPolicyDataSourceListResult(t, "Service", "web-legacy", prodTag),
+		testPolicyDataSourceListResult(t, "Service", "web-1", nil),
+		testPolicyDataSourceListResult(t, "Service", "web-2", prodTag),
+	}
+
+	cases := []struct {
+		config   map[string]interface{}
+		expected string
+	}{
+		{map[string]interface{}{"display_name": "web-l"}, "web-legacy"},
+		{map[string]interface{}{"display_name": "web-l", "match_mode": "exact"}, ""},
+		{map[string]interface{}{"display_name": "web-1", "match_mode": "exact"}, "web-1"},
+		{map[string]interface{}{"display_name": "web", "match_mode": "prefix"}, ""},
+		{map[string]interface{}{"display_name": "^web-[0-9]$", "match_mode": "regex"}, ""},
+		{map[string]interface{}{"display_name": "^web-[2-9]$", "match_mode": "regex"}, "web-2"},
+		{map[string]interface{}{"display_name": "[", "match_mode": "regex"}, ""},
+		{map[string]interface{}{"display_name": "web-", "tag": []interface{}{map[string]interface{}{"scope": "env", "tag": "prod"}}}, ""},
+		{map[string]interface{}{"display_name": "web-2", "tag": []interface{}{map[string]interface{}{"scope": "env", "tag": "prod"}}}, "web-2"},
+		{map[string]interface{}{"display_name": "web-1", "tag": []interface{}{map[string]interface{}{"scope": "env"}}}, ""},
+		{map[string]interface{}{"tag": []interface{}{map[string]interface{}{"tag": "prod"}}, "match_mode": "regex", "display_name": "legacy"}, "web-legacy"},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, dataSourceNsxtPolicyService().Schema, c.config)
+		_, err := policyDataSourceResourceFilterAndSet(d, results, "Service")
+		if c.expected == "" {
+			if err == nil {
+				t.Errorf("Expected error for %v, found %s", c.config, d.Id())
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for %v: %v", c.config, err)
+			continue
+		}
+		if d.Id() != c.expected {
+			t.Errorf("Expected %s for %v, found %s", c.expected, c.config, d.Id())
+		}
+	}
+}
+
+func TestPolicySearchTagsMatch(t *testing.T) {
+	objTags := []model.Tag{
+		{Scope: strPtr("env"), Tag: strPtr("prod")},
+		{Scope: strPtr("app"), Tag: strPtr("web")},
+	}
+
+	cases := []struct {
+		filters  []string
+		expected bool
+	}{
+		{nil, true},
+		{[]string{"env:prod"}, true},
+		{[]string{"env:"}, true},
+		{[]string{"web"}, true},
+		{[]string{"env:prod", "app:web"}, true},
+		{[]string{"env:web"}, false},
+		{[]string{"env:prod", "app:db"}, false},
+	}
+
+	for _, c := range cases {
+		if policySearchTagsMatch(getPolicySearchTags(objTags), parsePolicyImportTags(c.filters)) != c.expected {
+			t.Errorf("Expected match %v for filters %v", c.expected, c.filters)
+		}
+	}
+}
@@ -695,3 +695,73 @@ func getPolicyLbMonitorPortSchema() *schema.Schema {
 func getVpcParentsFromContext(context utl.SessionContext) []string {
 	return []string{utl.DefaultOrgID, context.ProjectID, context.VPCID}
 }
+
+func getPolicyNestedObjectIDs(children []interface{}) []string {
+	var ids []string
+	for _, child := range children {
+		childMap := child.(map[string]interface{})
+		id := childMap["nsx_id"].(string)
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// getPolicyNestedObjectRemovedIDs returns IDs present in old configuration but missing in the new one
+func getPolicyNestedObjectRemovedIDs(oldIDs []string, newIDs []string) []string {
+	newIDMap := make(map[string]bool)
+	for _, id := range newIDs {
+		newIDMap[id] = true
+	}
+
+	var removedIDs []string
+	for _, id := range oldIDs {
+		if !newIDMap[id] {
+			removedIDs = append(removedIDs, id)
+		}
+	}
+
+	return removedIDs
+}
+
+// orderPolicyNestedObjectIDs sorts IDs retrieved from NSX so that IDs known in state
+// preserve their configured order, and any other IDs follow in NSX order
+func orderPolicyNestedObjectIDs(knownIDs []string, actualIDs []string) []string {
+	actualIDMap := make(map[string]bool)
+	for _, id := range actualIDs {
+		actualIDMap[id] = true
+	}
+
+	var orderedIDs []string
+	knownIDMap := make(map[string]bool)
+	for _, id := range knownIDs {
+		if actualIDMap[id] {
+			orderedIDs = append(orderedIDs, id)
+			knownIDMap[id] = true
+		}
+	}
+
+	for _, id := range actualIDs {
+		if !knownIDMap[id] {
+			orderedIDs = append(orderedIDs, id)
+		}
+	}
+
+	return orderedIDs
+}
+
+// assignPolicyNestedObjectIDs generates IDs for nested objects that were configured without one,
+// and stores them back in state so that subsequent reads can match NSX objects to configuration
+func assignPolicyNestedObjectIDs(d *schema.ResourceData, attrName string) ([]interface{}, error) {
+	children := d.Get(attrName).([]interface{})
+	for _, child := range children {
+		childMap := child.(map[string]interface{})
+		if childMap["nsx_id"].(string) == "" {
+			childMap["nsx_id"] = newUUID()
+		}
+	}
+
+	return children, d.Set(attrName, children)
+}
@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -100,3 +101,27 @@ func TestParseStandardPolicyPathVerifySize(t *testing.T) {
 	_, err = parseStandardPolicyPathVerifySize("/global-infra/things/1/sub-things/2/fine-tuned-thing/3", 1)
 	assert.NotNil(t, err)
 }
+
+func TestGetPolicyNestedObjectRemovedIDs(t *testing.T) {
+	removed := getPolicyNestedObjectRemovedIDs([]string{"if1", "if2", "if3"}, []string{"if3", "if1", "if4"})
+	if !reflect.DeepEqual(removed, []string{"if2"}) {
+		t.Errorf("Unexpected removed IDs %v", removed)
+	}
+
+	removed = getPolicyNestedObjectRemovedIDs(nil, []string{"if1"})
+	if len(removed) != 0 {
+		t.Errorf("Expected no removed IDs, got %v", removed)
+	}
+}
+
+func TestOrderPolicyNestedObjectIDs(t *testing.T) {
+	ordered := orderPolicyNestedObjectIDs([]string{"b", "gone", "a"}, []string{"a", "c", "b"})
+	if !reflect.DeepEqual(ordered, []string{"b", "a", "c"}) {
+		t.Errorf("Unexpected order %v", ordered)
+	}
+
+	ordered = orderPolicyNestedObjectIDs(nil, []string{"a", "c"})
+	if !reflect.DeepEqual(ordered, []string{"a", "c"}) {
+		t.Errorf("Unexpected order %v", ordered)
+	}
+}
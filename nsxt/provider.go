@@ -48,6 +48,8 @@ type commonProviderConfig struct {
 	Username               string
 	Password               string
 	LicenseKeys            []string
+	RealizationWait        bool
+	RealizationTimeout     int
 }
 
 type nsxtClients struct {
@@ -68,11 +70,13 @@ type nsxtClients struct {
 	Host                   string
 	PolicyEnforcementPoint string
 	PolicyGlobalManager    bool
+	// Collects hierarchical policy requests in bulk apply mode, nil otherwise
+	PolicyBulkApplier *policyBulkApplier
 }
 
 // Provider for VMWare NSX-T
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 
 		Schema: map[string]*schema.Schema{
 			"allow_unverified_ssl": {
@@ -241,6 +245,39 @@ func Provider() *schema.Provider {
 				Description: "Avoid initializing NSX connection on startup",
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_ON_DEMAND_CONNECTION", false),
 			},
+			"bulk_apply": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Batch creates and updates of supported policy resources into hierarchical API requests",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_BULK_APPLY", false),
+			},
+			"bulk_apply_window": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Time in milliseconds to collect policy objects for single hierarchical request in bulk apply mode",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_BULK_APPLY_WINDOW", 1000),
+				ValidateFunc: validation.IntBetween(10, 60000),
+			},
+			"bulk_apply_max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of policy objects in single hierarchical request in bulk apply mode",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_BULK_APPLY_MAX_SIZE", 500),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"realization_wait": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Wait for realization of policy resources after create and update",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_REALIZATION_WAIT", false),
+			},
+			"realization_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Realization timeout in seconds for policy resources",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_REALIZATION_TIMEOUT", 1200),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -300,9 +337,14 @@ func Provider() *schema.Provider {
 			"nsxt_policy_ipsec_vpn_local_endpoint":                   dataSourceNsxtPolicyIPSecVpnLocalEndpoint(),
 			"nsxt_policy_ipsec_vpn_service":                          dataSourceNsxtPolicyIPSecVpnService(),
 			"nsxt_policy_l2_vpn_service":                             dataSourceNsxtPolicyL2VpnService(),
+			"nsxt_policy_ipsec_vpn_session_status":                   dataSourceNsxtPolicyIPSecVpnSessionStatus(),
+			"nsxt_policy_l2_vpn_session_status":                      dataSourceNsxtPolicyL2VpnSessionStatus(),
+			"nsxt_policy_l2_vpn_session_peer_code":                   dataSourceNsxtPolicyL2VpnSessionPeerCode(),
 			"nsxt_policy_segment":                                    dataSourceNsxtPolicySegment(),
 			"nsxt_policy_project":                                    dataSourceNsxtPolicyProject(),
 			"nsxt_policy_gateway_dns_forwarder":                      dataSourceNsxtPolicyGatewayDNSForwarder(),
+			"nsxt_policy_dns_forwarder_statistics":                   dataSourceNsxtPolicyDNSForwarderStatistics(),
+			"nsxt_policy_nat_rule_statistics":                        dataSourceNsxtPolicyNATRuleStatistics(),
 			"nsxt_policy_gateway_prefix_list":                        dataSourceNsxtPolicyGatewayPrefixList(),
 			"nsxt_policy_gateway_route_map":                          dataSourceNsxtPolicyGatewayRouteMap(),
 			"nsxt_policy_uplink_host_switch_profile":                 dataSourceNsxtUplinkHostSwitchProfile(),
@@ -312,6 +354,10 @@ func Provider() *schema.Provider {
 			"nsxt_transport_node_realization":                        dataSourceNsxtTransportNodeRealization(),
 			"nsxt_failure_domain":                                    dataSourceNsxtFailureDomain(),
 			"nsxt_compute_collection":                                dataSourceNsxtComputeCollection(),
+			"nsxt_physical_servers":                                  dataSourceNsxtPhysicalServers(),
+			"nsxt_container_clusters":                                dataSourceNsxtContainerClusters(),
+			"nsxt_container_namespaces":                              dataSourceNsxtContainerNamespaces(),
+			"nsxt_container_ingress_policies":                        dataSourceNsxtContainerIngressPolicies(),
 			"nsxt_compute_manager_realization":                       dataSourceNsxtComputeManagerRealization(),
 			"nsxt_policy_host_transport_node":                        dataSourceNsxtPolicyHostTransportNode(),
 			"nsxt_manager_cluster_node":                              dataSourceNsxtManagerClusterNode(),
@@ -327,6 +373,8 @@ func Provider() *schema.Provider {
 			"nsxt_policy_distributed_flood_protection_profile":       dataSourceNsxtPolicyDistributedFloodProtectionProfile(),
 			"nsxt_policy_gateway_flood_protection_profile":           dataSourceNsxtPolicyGatewayFloodProtectionProfile(),
 			"nsxt_manager_info":                                      dataSourceNsxtManagerInfo(),
+			"nsxt_manager_cluster_status":                            dataSourceNsxtManagerClusterStatus(),
+			"nsxt_alarms":                                            dataSourceNsxtAlarms(),
 			"nsxt_vpc":                                               dataSourceNsxtVPC(),
 			"nsxt_vpc_group":                                         dataSourceNsxtVpcGroup(),
 			"nsxt_vpc_nat":                                           dataSourceNsxtVpcNat(),
@@ -340,6 +388,19 @@ func Provider() *schema.Provider {
 			"nsxt_vpc_ip_address_allocation":                         dataSourceNsxtVpcIpAddressAllocation(),
 			"nsxt_policy_gateway_connection":                         dataSourceNsxtPolicyGatewayConnection(),
 			"nsxt_policy_distributed_vlan_connection":                dataSourceNsxtPolicyDistributedVlanConnection(),
+			"nsxt_policy_generic_object":                             dataSourceNsxtPolicyGenericObject(),
+			"nsxt_policy_groups":                                     dataSourceNsxtPolicyGroups(),
+			"nsxt_policy_segments":                                   dataSourceNsxtPolicySegments(),
+			"nsxt_policy_services":                                   dataSourceNsxtPolicyServices(),
+			"nsxt_policy_gateways":                                   dataSourceNsxtPolicyGateways(),
+			"nsxt_policy_security_policies":                          dataSourceNsxtPolicySecurityPolicies(),
+			"nsxt_policy_group_effective_members":                    dataSourceNsxtPolicyGroupEffectiveMembers(),
+			"nsxt_policy_rule_statistics":                            dataSourceNsxtPolicyRuleStatistics(),
+			"nsxt_policy_gateway_routing_table":                      dataSourceNsxtPolicyGatewayRoutingTable(),
+			"nsxt_policy_bgp_neighbor_status":                        dataSourceNsxtPolicyBgpNeighborStatus(),
+			"nsxt_policy_segment_runtime":                            dataSourceNsxtPolicySegmentRuntime(),
+			"nsxt_policy_segment_port_runtime":                       dataSourceNsxtPolicySegmentPortRuntime(),
+			"nsxt_policy_context_profile_attributes":                 dataSourceNsxtPolicyContextProfileAttributes(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -416,10 +477,12 @@ func Provider() *schema.Provider {
 			"nsxt_policy_segment":                                      resourceNsxtPolicySegment(),
 			"nsxt_policy_vlan_segment":                                 resourceNsxtPolicyVlanSegment(),
 			"nsxt_policy_fixed_segment":                                resourceNsxtPolicyFixedSegment(),
+			"nsxt_policy_segment_static_arp":                           resourceNsxtPolicySegmentStaticArp(),
 			"nsxt_policy_static_route":                                 resourceNsxtPolicyStaticRoute(),
 			"nsxt_policy_gateway_prefix_list":                          resourceNsxtPolicyGatewayPrefixList(),
 			"nsxt_policy_vm_tags":                                      resourceNsxtPolicyVMTags(),
 			"nsxt_policy_nat_rule":                                     resourceNsxtPolicyNATRule(),
+			"nsxt_policy_nat_rules":                                    resourceNsxtPolicyNATRules(),
 			"nsxt_policy_ip_block":                                     resourceNsxtPolicyIPBlock(),
 			"nsxt_policy_lb_pool":                                      resourceNsxtPolicyLBPool(),
 			"nsxt_policy_ip_pool":                                      resourceNsxtPolicyIPPool(),
@@ -455,7 +518,9 @@ func Provider() *schema.Provider {
 			"nsxt_policy_ipsec_vpn_tunnel_profile":                     resourceNsxtPolicyIPSecVpnTunnelProfile(),
 			"nsxt_policy_ipsec_vpn_dpd_profile":                        resourceNsxtPolicyIPSecVpnDpdProfile(),
 			"nsxt_policy_ipsec_vpn_session":                            resourceNsxtPolicyIPSecVpnSession(),
+			"nsxt_policy_ipsec_vpn_session_bgp_neighbor":               resourceNsxtPolicyIPSecVpnSessionBgpNeighbor(),
 			"nsxt_policy_l2_vpn_session":                               resourceNsxtPolicyL2VPNSession(),
+			"nsxt_policy_l2_vpn_client_session":                        resourceNsxtPolicyL2VpnClientSession(),
 			"nsxt_policy_ipsec_vpn_service":                            resourceNsxtPolicyIPSecVpnService(),
 			"nsxt_policy_l2_vpn_service":                               resourceNsxtPolicyL2VpnService(),
 			"nsxt_policy_ipsec_vpn_local_endpoint":                     resourceNsxtPolicyIPSecVpnLocalEndpoint(),
@@ -464,6 +529,8 @@ func Provider() *schema.Provider {
 			"nsxt_policy_segment_security_profile":                     resourceNsxtPolicySegmentSecurityProfile(),
 			"nsxt_policy_spoof_guard_profile":                          resourceNsxtPolicySpoofGuardProfile(),
 			"nsxt_policy_gateway_qos_profile":                          resourceNsxtPolicyGatewayQosProfile(),
+			"nsxt_policy_pim_profile":                                  resourceNsxtPolicyPimProfile(),
+			"nsxt_policy_igmp_profile":                                 resourceNsxtPolicyIgmpProfile(),
 			"nsxt_policy_project":                                      resourceNsxtPolicyProject(),
 			"nsxt_policy_transport_zone":                               resourceNsxtPolicyTransportZone(),
 			"nsxt_policy_user_management_role":                         resourceNsxtPolicyUserManagementRole(),
@@ -508,6 +575,7 @@ func Provider() *schema.Provider {
 			"nsxt_policy_gateway_flood_protection_profile_binding":     resourceNsxtPolicyGatewayFloodProtectionProfileBinding(),
 			"nsxt_policy_compute_sub_cluster":                          resourceNsxtPolicyComputeSubCluster(),
 			"nsxt_policy_tier0_inter_vrf_routing":                      resourceNsxtPolicyTier0InterVRFRouting(),
+			"nsxt_policy_tier0_vrf":                                    resourceNsxtPolicyTier0Vrf(),
 			"nsxt_vpc_security_policy":                                 resourceNsxtVPCSecurityPolicy(),
 			"nsxt_vpc_group":                                           resourceNsxtVPCGroup(),
 			"nsxt_vpc_gateway_policy":                                  resourceNsxtVPCGatewayPolicy(),
@@ -529,10 +597,19 @@ func Provider() *schema.Provider {
 			"nsxt_vpc_static_route":                     resourceNsxtVpcStaticRoutes(),
 			"nsxt_policy_project_ip_address_allocation": resourceNsxtPolicyProjectIpAddressAllocation(),
 			"nsxt_vpc_dhcp_v4_static_binding":           resourceNsxtVpcSubnetDhcpV4StaticBindingConfig(),
+			"nsxt_policy_generic_object":                resourceNsxtPolicyGenericObject(),
 		},
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	for name, r := range provider.ResourcesMap {
+		if isPolicyRealizationResource(name, r) {
+			policyRealizationWrapper(r)
+		}
+	}
+
+	return provider
 }
 
 func isVMCCredentialSet(d *schema.ResourceData) bool {
@@ -1089,6 +1166,8 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 	}
 
 	licenses := interfaceListToStringList(d.Get("license_keys").([]interface{}))
+	realizationWait := d.Get("realization_wait").(bool)
+	realizationTimeout := d.Get("realization_timeout").(int)
 	return commonProviderConfig{
 		RemoteAuth:             remoteAuth,
 		ToleratePartialSuccess: toleratePartialSuccess,
@@ -1099,6 +1178,8 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 		Username:               username,
 		Password:               password,
 		LicenseKeys:            licenses,
+		RealizationWait:        realizationWait,
+		RealizationTimeout:     realizationTimeout,
 	}
 }
 
@@ -1108,6 +1189,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		CommonConfig: commonConfig,
 	}
 
+	if d.Get("bulk_apply").(bool) {
+		clients.PolicyBulkApplier = newPolicyBulkApplier(d.Get("bulk_apply_window").(int), d.Get("bulk_apply_max_size").(int))
+	}
+
 	err := configureNsxtClient(d, &clients)
 	if err != nil {
 		return nil, err
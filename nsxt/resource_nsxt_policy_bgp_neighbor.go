@@ -24,6 +24,10 @@ var bgpNeighborConfigGracefulRestartModeValues = []string{
 	model.BgpNeighborConfig_GRACEFUL_RESTART_MODE_GR_AND_HELPER,
 	model.BgpNeighborConfig_GRACEFUL_RESTART_MODE_DISABLE,
 }
+var bgpNeighborLocalAsPathModifierTypeValues = []string{
+	model.BgpNeighborLocalAsConfig_AS_PATH_MODIFIER_TYPE_PREPEND,
+	model.BgpNeighborLocalAsConfig_AS_PATH_MODIFIER_TYPE_PREPEND_REPLACE_AS,
+}
 var bgpNeighborConfigRouteFilteringAddressFamilyValues = []string{
 	model.BgpRouteFiltering_ADDRESS_FAMILY_IPV4,
 	model.BgpRouteFiltering_ADDRESS_FAMILY_IPV6,
@@ -84,6 +88,34 @@ func resourceNsxtPolicyBgpNeighbor() *schema.Resource {
 					},
 				},
 			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable BGP peering with this neighbor",
+				Optional:    true,
+				Default:     true,
+			},
+			"neighbor_local_as_config": {
+				Type:        schema.TypeList,
+				Description: "Local AS number override for peering with this neighbor",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_as_num": {
+							Type:         schema.TypeString,
+							Description:  "Local AS number in ASPLAIN or ASDOT format, used instead of gateway AS number",
+							Required:     true,
+							ValidateFunc: validateASPlainOrDot,
+						},
+						"as_path_modifier_type": {
+							Type:         schema.TypeString,
+							Description:  "AS path modifier for routes advertised to and received from this neighbor",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(bgpNeighborLocalAsPathModifierTypeValues, false),
+						},
+					},
+				},
+			},
 			"graceful_restart_mode": {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.StringInSlice(bgpNeighborConfigGracefulRestartModeValues, false),
@@ -302,6 +334,22 @@ func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, i
 		neighborStruct.Password = &password
 	}
 
+	if util.NsxVersionHigherOrEqual("3.2.0") {
+		enabled := d.Get("enabled").(bool)
+		neighborStruct.Enabled = &enabled
+	}
+
+	for _, localAs := range d.Get("neighbor_local_as_config").([]interface{}) {
+		data := localAs.(map[string]interface{})
+		localAsNum := data["local_as_num"].(string)
+		neighborStruct.NeighborLocalAsConfig = &model.BgpNeighborLocalAsConfig{
+			LocalAsNum: &localAsNum,
+		}
+		if modifier := data["as_path_modifier_type"].(string); modifier != "" {
+			neighborStruct.NeighborLocalAsConfig.AsPathModifierType = &modifier
+		}
+	}
+
 	return neighborStruct, nil
 }
 
@@ -317,8 +365,17 @@ func resourceNsxtPolicyBgpNeighborConvertAndPatch(id string, d *schema.ResourceD
 		return err
 	}
 
+	// PATCH ignores a missing local AS config, so removing it from an
+	// existing neighbor requires a full update (PUT) of the object
+	clearLocalAs := !d.IsNewResource() && d.HasChange("neighbor_local_as_config") && obj.NeighborLocalAsConfig == nil
+	if clearLocalAs {
+		revision := int64(d.Get("revision").(int))
+		obj.Revision = &revision
+		password := d.Get("password").(string)
+		obj.Password = &password
+	}
+
 	connector := getPolicyConnector(m)
-	// Create the resource using PATCH
 	log.Printf("[INFO] Creating BgpNeighbor with ID %s", id)
 	if isPolicyGlobalManager(m) {
 		gmObj, convErr := convertModelBindingType(obj, model.BgpNeighborConfigBindingType(), gm_model.BgpNeighborConfigBindingType())
@@ -326,11 +383,18 @@ func resourceNsxtPolicyBgpNeighborConvertAndPatch(id string, d *schema.ResourceD
 			return convErr
 		}
 		client := gm_bgp.NewNeighborsClient(connector)
-		err = client.Patch(t0ID, serviceID, id, gmObj.(gm_model.BgpNeighborConfig), nil)
-
+		if clearLocalAs {
+			_, err = client.Update(t0ID, serviceID, id, gmObj.(gm_model.BgpNeighborConfig), nil)
+		} else {
+			err = client.Patch(t0ID, serviceID, id, gmObj.(gm_model.BgpNeighborConfig), nil)
+		}
 	} else {
 		client := bgp.NewNeighborsClient(connector)
-		err = client.Patch(t0ID, serviceID, id, obj, nil)
+		if clearLocalAs {
+			_, err = client.Update(t0ID, serviceID, id, obj, nil)
+		} else {
+			err = client.Patch(t0ID, serviceID, id, obj, nil)
+		}
 	}
 	if err != nil {
 		return handleCreateError("BgpNeighbor", id, err)
@@ -424,6 +488,21 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 	d.Set("remote_as_num", obj.RemoteAsNum)
 	d.Set("source_addresses", obj.SourceAddresses)
 
+	if obj.Enabled != nil {
+		d.Set("enabled", obj.Enabled)
+	} else {
+		d.Set("enabled", true)
+	}
+
+	var localAsConfigs []interface{}
+	if obj.NeighborLocalAsConfig != nil {
+		localAs := make(map[string]interface{})
+		localAs["local_as_num"] = obj.NeighborLocalAsConfig.LocalAsNum
+		localAs["as_path_modifier_type"] = obj.NeighborLocalAsConfig.AsPathModifierType
+		localAsConfigs = append(localAsConfigs, localAs)
+	}
+	d.Set("neighbor_local_as_config", localAsConfigs)
+
 	var bfdConfigs []interface{}
 	if obj.Bfd != nil {
 		bfd := make(map[string]interface{})
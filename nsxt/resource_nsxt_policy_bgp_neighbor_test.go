@@ -237,6 +237,44 @@ func TestAccResourceNsxtPolicyBgpNeighbor_subConfig(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyBgpNeighbor_localAsWithRouteMap(t *testing.T) {
+	testResourceName := "nsxt_policy_bgp_neighbor.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.2.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyBgpNeighborCheckDestroy(state, "tfbgp")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyBgpNeighborLocalAsTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyBgpNeighborExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "allow_as_in", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "graceful_restart_mode", "GR_AND_HELPER"),
+					resource.TestCheckResourceAttr(testResourceName, "neighbor_local_as_config.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "neighbor_local_as_config.0.local_as_num", "65100"),
+					resource.TestCheckResourceAttr(testResourceName, "neighbor_local_as_config.0.as_path_modifier_type", "NO_PREPEND"),
+					resource.TestCheckResourceAttr(testResourceName, "route_filtering.0.maximum_routes", "100"),
+					resource.TestCheckResourceAttrPair(testResourceName, "route_filtering.0.out_route_filter", "nsxt_policy_gateway_route_map.test", "path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyBgpNeighborLocalAsTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyBgpNeighborExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "allow_as_in", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "graceful_restart_mode", "HELPER_ONLY"),
+					resource.TestCheckResourceAttr(testResourceName, "neighbor_local_as_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyBgpNeighbor_subConfigSingleRoute(t *testing.T) {
 	testResourceName := "nsxt_policy_bgp_neighbor.test"
 
@@ -599,6 +637,73 @@ resource "nsxt_policy_bgp_neighbor" "test" {
 }`, getEdgeClusterName())
 }
 
+func testAccNsxtPolicyBgpNeighborLocalAsTemplate(createFlow bool) string {
+	neighborConfig := `
+  enabled               = false
+  allow_as_in           = false
+  graceful_restart_mode = "HELPER_ONLY"`
+	if createFlow {
+		neighborConfig = `
+  allow_as_in           = true
+  graceful_restart_mode = "GR_AND_HELPER"
+
+  neighbor_local_as_config {
+    local_as_num          = "65100"
+    as_path_modifier_type = "NO_PREPEND"
+  }`
+	}
+	return fmt.Sprintf(`
+data "nsxt_policy_edge_cluster" "EC" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name      = "terraformt0gw"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.EC.path
+
+  bgp_config {
+    local_as_num = "60000"
+  }
+}
+
+resource "nsxt_policy_gateway_prefix_list" "test" {
+  display_name = "tfbgp"
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+
+  prefix {
+    network = "4.4.0.0/20"
+  }
+}
+
+resource "nsxt_policy_gateway_route_map" "test" {
+  display_name = "tfbgp"
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+
+  entry {
+    prefix_list_matches = [nsxt_policy_gateway_prefix_list.test.path]
+
+    set {
+      as_path_prepend = "60000 60000"
+      community       = "60000:100 NO_EXPORT"
+    }
+  }
+}
+
+resource "nsxt_policy_bgp_neighbor" "test" {
+  bgp_path         = nsxt_policy_tier0_gateway.test.bgp_config.0.path
+  display_name     = "tfbgp"
+  neighbor_address = "12.12.12.12"
+  remote_as_num    = "65001"
+%s
+
+  route_filtering {
+    address_family   = "IPV4"
+    maximum_routes   = 100
+    out_route_filter = nsxt_policy_gateway_route_map.test.path
+  }
+}`, getEdgeClusterName(), neighborConfig)
+}
+
 func testAccNsxtPolicyBgpNeighborSubConfigCreateSingleRouteFilter() string {
 	return fmt.Sprintf(`
 data "nsxt_policy_edge_cluster" "EC" {
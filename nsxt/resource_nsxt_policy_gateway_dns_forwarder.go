@@ -6,6 +6,7 @@ package nsxt
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	tier0s "github.com/vmware/terraform-provider-nsxt/api/infra/tier_0s"
 	tier1s "github.com/vmware/terraform-provider-nsxt/api/infra/tier_1s"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/transit_gateways"
 )
 
 var gatewayDNSForwarderLogLevelTypeValues = []string{
@@ -43,7 +45,7 @@ func resourceNsxtPolicyGatewayDNSForwarder() *schema.Resource {
 			"revision":     getRevisionSchema(),
 			"tag":          getTagsSchema(),
 			"context":      getContextSchema(false, false, false),
-			"gateway_path": getPolicyPathSchema(true, true, "Policy path for the Gateway"),
+			"gateway_path": getPolicyPathSchema(true, true, "Policy path for the Tier0, Tier1 or transit Gateway"),
 			"listener_ip": {
 				Type:         schema.TypeString,
 				Description:  "IP on which the DNS Forwarder listens",
@@ -80,6 +82,45 @@ func resourceNsxtPolicyGatewayDNSForwarder() *schema.Resource {
 	}
 }
 
+// policyDNSForwarderParent identifies the gateway hosting DNS forwarder: a Tier0 or Tier1 gateway,
+// or a transit gateway that serves VPCs of a project
+type policyDNSForwarderParent struct {
+	isT0             bool
+	isTransitGateway bool
+	orgID            string
+	projectID        string
+	gwID             string
+}
+
+func parsePolicyDNSForwarderGatewayPath(gwPath string) (policyDNSForwarderParent, error) {
+	// transit gateway path looks like "/orgs/default/projects/myproject/transit-gateways/mytgw"
+	segs := strings.Split(gwPath, "/")
+	if len(segs) == 7 && segs[1] == "orgs" && segs[3] == "projects" && segs[5] == "transit-gateways" && segs[6] != "" {
+		if util.NsxVersionLower("9.0.0") {
+			return policyDNSForwarderParent{}, fmt.Errorf("DNS forwarder on transit gateway requires NSX version 9.0.0 or higher")
+		}
+		return policyDNSForwarderParent{
+			isTransitGateway: true,
+			orgID:            segs[2],
+			projectID:        segs[4],
+			gwID:             segs[6],
+		}, nil
+	}
+
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if gwID == "" {
+		return policyDNSForwarderParent{}, fmt.Errorf("gateway_path is not valid")
+	}
+	return policyDNSForwarderParent{isT0: isT0, gwID: gwID}, nil
+}
+
+func (p policyDNSForwarderParent) validateContext(sessionContext utl.SessionContext) error {
+	if p.isT0 && sessionContext.ClientType == utl.Multitenancy {
+		return handleMultitenancyTier0Error()
+	}
+	return nil
+}
+
 func policyGatewayDNSForwarderGet(sessionContext utl.SessionContext, connector client.Connector, gwID string, isT0 bool) (model.PolicyDnsForwarder, error) {
 	var emptyFwdr model.PolicyDnsForwarder
 	if isT0 {
@@ -97,24 +138,68 @@ func policyGatewayDNSForwarderGet(sessionContext utl.SessionContext, connector c
 	return client.Get(gwID)
 }
 
+func (p policyDNSForwarderParent) get(sessionContext utl.SessionContext, connector client.Connector) (model.PolicyDnsForwarder, error) {
+	if p.isTransitGateway {
+		client := transit_gateways.NewDnsForwarderClient(connector)
+		return client.Get(p.orgID, p.projectID, p.gwID)
+	}
+	return policyGatewayDNSForwarderGet(sessionContext, connector, p.gwID, p.isT0)
+}
+
+func (p policyDNSForwarderParent) patch(sessionContext utl.SessionContext, connector client.Connector, obj model.PolicyDnsForwarder) error {
+	if p.isTransitGateway {
+		client := transit_gateways.NewDnsForwarderClient(connector)
+		return client.Patch(p.orgID, p.projectID, p.gwID, obj)
+	}
+	if p.isT0 {
+		client := tier0s.NewDnsForwarderClient(sessionContext, connector)
+		if client == nil {
+			return policyResourceNotSupportedError()
+		}
+		return client.Patch(p.gwID, obj)
+	}
+	client := tier1s.NewDnsForwarderClient(sessionContext, connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	return client.Patch(p.gwID, obj)
+}
+
+func (p policyDNSForwarderParent) delete(sessionContext utl.SessionContext, connector client.Connector) error {
+	if p.isTransitGateway {
+		client := transit_gateways.NewDnsForwarderClient(connector)
+		return client.Delete(p.orgID, p.projectID, p.gwID)
+	}
+	if p.isT0 {
+		client := tier0s.NewDnsForwarderClient(sessionContext, connector)
+		if client == nil {
+			return policyResourceNotSupportedError()
+		}
+		return client.Delete(p.gwID)
+	}
+	client := tier1s.NewDnsForwarderClient(sessionContext, connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	return client.Delete(p.gwID)
+}
+
 func resourceNsxtPolicyGatewayDNSForwarderRead(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
 
-	gwPolicyPath := d.Get("gateway_path").(string)
-	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
-	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+	parent, err := parsePolicyDNSForwarderGatewayPath(d.Get("gateway_path").(string))
+	if err != nil {
+		return err
 	}
 
 	context := getSessionContext(d, m)
-	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+	if err := parent.validateContext(context); err != nil {
+		return err
 	}
 
-	obj, err := policyGatewayDNSForwarderGet(context, connector, gwID, isT0)
-
+	obj, err := parent.get(context, connector)
 	if err != nil {
-		return handleReadError(d, "Gateway Dns Forwarder", gwID, err)
+		return handleReadError(d, "Gateway Dns Forwarder", parent.gwID, err)
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -132,7 +217,7 @@ func resourceNsxtPolicyGatewayDNSForwarderRead(d *schema.ResourceData, m interfa
 	return nil
 }
 
-func patchNsxtPolicyGatewayDNSForwarder(sessionContext utl.SessionContext, connector client.Connector, d *schema.ResourceData, gwID string, isT0 bool) error {
+func patchNsxtPolicyGatewayDNSForwarder(sessionContext utl.SessionContext, connector client.Connector, d *schema.ResourceData, parent policyDNSForwarderParent) error {
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
@@ -162,84 +247,58 @@ func patchNsxtPolicyGatewayDNSForwarder(sessionContext utl.SessionContext, conne
 		obj.CacheSize = &cacheSize
 	}
 
-	if isT0 {
-		client := tier0s.NewDnsForwarderClient(sessionContext, connector)
-		if client == nil {
-			return policyResourceNotSupportedError()
-		}
-		return client.Patch(gwID, obj)
-	}
-	client := tier1s.NewDnsForwarderClient(sessionContext, connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	return client.Patch(gwID, obj)
+	return parent.patch(sessionContext, connector, obj)
 }
 
 func resourceNsxtPolicyGatewayDNSForwarderCreate(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
 
-	gwPolicyPath := d.Get("gateway_path").(string)
-	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
-	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+	parent, err := parsePolicyDNSForwarderGatewayPath(d.Get("gateway_path").(string))
+	if err != nil {
+		return err
 	}
 
-	// Verify DNS forwarder is not yet defined for this Gateway
-	var err error
 	context := getSessionContext(d, m)
-
-	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+	if err := parent.validateContext(context); err != nil {
+		return err
 	}
 
-	if isT0 {
-		client := tier0s.NewDnsForwarderClient(context, connector)
-		if client == nil {
-			return policyResourceNotSupportedError()
-		}
-		_, err = client.Get(gwID)
-	} else {
-		client := tier1s.NewDnsForwarderClient(context, connector)
-		if client == nil {
-			return policyResourceNotSupportedError()
-		}
-		_, err = client.Get(gwID)
-	}
+	// Verify DNS forwarder is not yet defined for this Gateway
+	_, err = parent.get(context, connector)
 	if err == nil {
-		return fmt.Errorf("Gateway Dns Forwarder already exists for Gateway '%s'", gwID)
+		return fmt.Errorf("Gateway Dns Forwarder already exists for Gateway '%s'", parent.gwID)
 	} else if !isNotFoundError(err) {
 		return err
 	}
 
-	log.Printf("[INFO] Creating Dns Forwarder for Gateway %s", gwID)
+	log.Printf("[INFO] Creating Dns Forwarder for Gateway %s", parent.gwID)
 
-	err = patchNsxtPolicyGatewayDNSForwarder(context, connector, d, gwID, isT0)
+	err = patchNsxtPolicyGatewayDNSForwarder(context, connector, d, parent)
 	if err != nil {
-		return handleCreateError("Gateway Dns Forwarder", gwID, err)
+		return handleCreateError("Gateway Dns Forwarder", parent.gwID, err)
 	}
 
-	d.SetId(gwID)
+	d.SetId(parent.gwID)
 
 	return resourceNsxtPolicyGatewayDNSForwarderRead(d, m)
 }
 
 func resourceNsxtPolicyGatewayDNSForwarderUpdate(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
-	gwPolicyPath := d.Get("gateway_path").(string)
-	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
-	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+
+	parent, err := parsePolicyDNSForwarderGatewayPath(d.Get("gateway_path").(string))
+	if err != nil {
+		return err
 	}
 
 	context := getSessionContext(d, m)
-	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+	if err := parent.validateContext(context); err != nil {
+		return err
 	}
-	log.Printf("[INFO] Updating Gateway Dns Forwarder with ID %s", gwID)
-	err := patchNsxtPolicyGatewayDNSForwarder(context, connector, d, gwID, isT0)
+	log.Printf("[INFO] Updating Gateway Dns Forwarder with ID %s", parent.gwID)
+	err = patchNsxtPolicyGatewayDNSForwarder(context, connector, d, parent)
 	if err != nil {
-		return handleUpdateError("Gateway Dns Forwarder", gwID, err)
+		return handleUpdateError("Gateway Dns Forwarder", parent.gwID, err)
 	}
 
 	return resourceNsxtPolicyGatewayDNSForwarderRead(d, m)
@@ -248,33 +307,19 @@ func resourceNsxtPolicyGatewayDNSForwarderUpdate(d *schema.ResourceData, m inter
 func resourceNsxtPolicyGatewayDNSForwarderDelete(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
 
-	gwPolicyPath := d.Get("gateway_path").(string)
-	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
-	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+	parent, err := parsePolicyDNSForwarderGatewayPath(d.Get("gateway_path").(string))
+	if err != nil {
+		return err
 	}
 
-	var err error
 	context := getSessionContext(d, m)
-	if isT0 && context.ClientType == utl.Multitenancy {
-		return handleMultitenancyTier0Error()
+	if err := parent.validateContext(context); err != nil {
+		return err
 	}
 
-	if isT0 {
-		client := tier0s.NewDnsForwarderClient(context, connector)
-		if client == nil {
-			return policyResourceNotSupportedError()
-		}
-		err = client.Delete(gwID)
-	} else {
-		client := tier1s.NewDnsForwarderClient(context, connector)
-		if client == nil {
-			return policyResourceNotSupportedError()
-		}
-		err = client.Delete(gwID)
-	}
+	err = parent.delete(context, connector)
 	if err != nil {
-		return handleDeleteError("Gateway Dns Forwarder", gwID, err)
+		return handleDeleteError("Gateway Dns Forwarder", parent.gwID, err)
 	}
 
 	return nil
@@ -289,8 +334,11 @@ func resourceNsxtPolicyGatewayDNSForwarderImport(d *schema.ResourceData, m inter
 	}
 
 	d.Set("gateway_path", gwPath)
-	_, gwID := parseGatewayPolicyPath(gwPath)
-	d.SetId(gwID)
+	parent, err := parsePolicyDNSForwarderGatewayPath(gwPath)
+	if err != nil {
+		return rd, err
+	}
+	d.SetId(parent.gwID)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 )
 
 var testAccResourcePolicyGatewayDNSForwarderName = "nsxt_policy_gateway_dns_forwarder.test"
@@ -57,6 +59,74 @@ func TestAccResourceNsxtPolicyGatewayDNSForwarder_tier1_multitenancy(t *testing.
 	})
 }
 
+func TestAccResourceNsxtPolicyGatewayDNSForwarder_transitGateway(t *testing.T) {
+	resourceName := testAccResourcePolicyGatewayDNSForwarderName
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyMultitenancy(t)
+			testAccNSXVersion(t, "9.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGatewayDNSForwarderCheckDestroy(state, accTestPolicyGatewayDNSForwarderCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayDNSForwarderTransitGatewayTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayDNSForwarderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", accTestPolicyGatewayDNSForwarderCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(resourceName, "conditional_forwarder_zone_paths.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "default_forwarder_zone_path"),
+					resource.TestCheckResourceAttrSet(resourceName, "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestParsePolicyDNSForwarderGatewayPath(t *testing.T) {
+	savedVersion := util.NsxVersion
+	defer func() { util.NsxVersion = savedVersion }()
+	util.NsxVersion = "9.0.0"
+
+	tests := []struct {
+		path      string
+		expected  policyDNSForwarderParent
+		expectErr bool
+	}{
+		{"/infra/tier-0s/t0", policyDNSForwarderParent{isT0: true, gwID: "t0"}, false},
+		{"/infra/tier-1s/t1", policyDNSForwarderParent{gwID: "t1"}, false},
+		{"/orgs/default/projects/p1/infra/tier-1s/t1", policyDNSForwarderParent{gwID: "t1"}, false},
+		{"/orgs/default/projects/p1/transit-gateways/tgw", policyDNSForwarderParent{isTransitGateway: true, orgID: "default", projectID: "p1", gwID: "tgw"}, false},
+		{"t1", policyDNSForwarderParent{}, true},
+	}
+
+	for _, test := range tests {
+		parent, err := parsePolicyDNSForwarderGatewayPath(test.path)
+		if test.expectErr {
+			if err == nil {
+				t.Errorf("Expected error for path %s", test.path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for path %s: %v", test.path, err)
+		} else if parent != test.expected {
+			t.Errorf("Unexpected parse result for path %s: %+v", test.path, parent)
+		}
+	}
+
+	util.NsxVersion = "4.2.0"
+	if _, err := parsePolicyDNSForwarderGatewayPath("/orgs/default/projects/p1/transit-gateways/tgw"); err == nil {
+		t.Errorf("Expected error for transit gateway path with NSX version %s", util.NsxVersion)
+	}
+	if _, err := parsePolicyDNSForwarderGatewayPath("/infra/tier-1s/t1"); err != nil {
+		t.Errorf("Unexpected error for tier1 path with NSX version %s: %v", util.NsxVersion, err)
+	}
+}
+
 func testAccResourceNsxtPolicyGatewayDNSForwarder(t *testing.T, isT0 bool, withContext bool, preCheck func()) {
 	resourceName := testAccResourcePolicyGatewayDNSForwarderName
 	resource.Test(t, resource.TestCase{
@@ -178,9 +248,12 @@ func testAccNsxtPolicyGatewayDNSForwarderExists(resourceName string) resource.Te
 		}
 
 		gwPath := rs.Primary.Attributes["gateway_path"]
-		isT0, gwID := parseGatewayPolicyPath(gwPath)
+		parent, err := parsePolicyDNSForwarderGatewayPath(gwPath)
+		if err != nil {
+			return err
+		}
 
-		_, err := policyGatewayDNSForwarderGet(testAccGetSessionContext(), connector, gwID, isT0)
+		_, err = parent.get(testAccGetSessionContext(), connector)
 		if err != nil {
 			return fmt.Errorf("Policy Gateway DNS Forwarder resource does not exist on %s", gwPath)
 		}
@@ -198,9 +271,12 @@ func testAccNsxtPolicyGatewayDNSForwarderCheckDestroy(state *terraform.State, di
 		}
 
 		gwPath := rs.Primary.Attributes["gateway_path"]
-		isT0, gwID := parseGatewayPolicyPath(gwPath)
+		parent, err := parsePolicyDNSForwarderGatewayPath(gwPath)
+		if err != nil {
+			return err
+		}
 
-		_, err := policyGatewayDNSForwarderGet(testAccGetSessionContext(), connector, gwID, isT0)
+		_, err = parent.get(testAccGetSessionContext(), connector)
 		if err == nil {
 			return fmt.Errorf("Policy Gateway DNS Forwarder %s still exists", displayName)
 		}
@@ -289,3 +365,36 @@ resource "nsxt_policy_gateway_dns_forwarder" "test" {
 }
 `, context, accTestPolicyGatewayDNSForwarderCreateAttributes["display_name"], whyDoesGoNeedToBeSoComplicated[isT0])
 }
+
+func testAccNsxtPolicyGatewayDNSForwarderTransitGatewayTemplate() string {
+	context := testAccNsxtProjectContext()
+	return fmt.Sprintf(`
+resource "nsxt_policy_dns_forwarder_zone" "default" {
+%s
+  display_name     = "%s"
+  upstream_servers = ["1.1.1.1"]
+}
+
+resource "nsxt_policy_dns_forwarder_zone" "fqdn" {
+%s
+  display_name     = "%s"
+  upstream_servers = ["2.1.1.1"]
+  dns_domain_names = ["conditional.domain.org"]
+}
+
+data "nsxt_policy_transit_gateway" "test" {
+%s
+  id = "default"
+}
+
+resource "nsxt_policy_gateway_dns_forwarder" "test" {
+%s
+  display_name = "%s"
+  gateway_path = data.nsxt_policy_transit_gateway.test.path
+  listener_ip  = "78.2.1.12"
+
+  default_forwarder_zone_path      = nsxt_policy_dns_forwarder_zone.default.path
+  conditional_forwarder_zone_paths = [nsxt_policy_dns_forwarder_zone.fqdn.path]
+}
+`, context, testAccPolicyDNSForwarderHelperNames[0], context, testAccPolicyDNSForwarderHelperNames[1], context, context, accTestPolicyGatewayDNSForwarderCreateAttributes["display_name"])
+}
@@ -6,6 +6,7 @@ package nsxt
 import (
 	"fmt"
 	"log"
+	"net"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -105,13 +106,45 @@ func setPrefixesInSchema(d *schema.ResourceData, prefixes []model.PrefixEntry) {
 	}
 }
 
-func getPrefixesFromSchema(d *schema.ResourceData) []model.PrefixEntry {
+// validatePolicyPrefixEntryLength verifies ge/le values against network prefix length and address family
+func validatePolicyPrefixEntryLength(network string, ge int, le int) error {
+	prefixLen := 0
+	maxLen := 128
+	if network != "" {
+		_, ipNet, err := net.ParseCIDR(network)
+		if err != nil {
+			return fmt.Errorf("invalid network %s: %v", network, err)
+		}
+		prefixLen, maxLen = ipNet.Mask.Size()
+	}
+
+	if ge > maxLen || le > maxLen {
+		return fmt.Errorf("ge and le for network %s should not exceed %d", network, maxLen)
+	}
+	if ge > 0 && ge < prefixLen {
+		return fmt.Errorf("ge value %d for network %s should not be smaller than prefix length %d", ge, network, prefixLen)
+	}
+	if le > 0 && le < prefixLen {
+		return fmt.Errorf("le value %d for network %s should not be smaller than prefix length %d", le, network, prefixLen)
+	}
+	if ge > 0 && le > 0 && le < ge {
+		return fmt.Errorf("le value %d for network %s should not be smaller than ge value %d", le, network, ge)
+	}
+
+	return nil
+}
+
+func getPrefixesFromSchema(d *schema.ResourceData) ([]model.PrefixEntry, error) {
 	prefixes := d.Get("prefix").([]interface{})
 	var entriesList []model.PrefixEntry
 	for _, prefix := range prefixes {
 		data := prefix.(map[string]interface{})
 		action := data["action"].(string)
 		network := data["network"].(string)
+		err := validatePolicyPrefixEntryLength(network, data["ge"].(int), data["le"].(int))
+		if err != nil {
+			return nil, err
+		}
 
 		if network == "" {
 			network = "ANY"
@@ -134,7 +167,7 @@ func getPrefixesFromSchema(d *schema.ResourceData) []model.PrefixEntry {
 		entriesList = append(entriesList, elem)
 	}
 
-	return entriesList
+	return entriesList, nil
 }
 
 func resourceNsxtPolicyGatewayPrefixListDelete(d *schema.ResourceData, m interface{}) error {
@@ -262,7 +295,10 @@ func resourceNsxtPolicyGatewayPrefixListCreate(d *schema.ResourceData, m interfa
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	prefixes := getPrefixesFromSchema(d)
+	prefixes, err := getPrefixesFromSchema(d)
+	if err != nil {
+		return err
+	}
 	tags := getPolicyTagsFromSchema(d)
 
 	prefixListStruct := model.PrefixList{
@@ -275,7 +311,7 @@ func resourceNsxtPolicyGatewayPrefixListCreate(d *schema.ResourceData, m interfa
 
 	log.Printf("[INFO] Creating Gateway Prefix List with ID %s", id)
 
-	err := patchNsxtPolicyGatewayPrefixList(connector, gwID, prefixListStruct, isGlobalManager)
+	err = patchNsxtPolicyGatewayPrefixList(connector, gwID, prefixListStruct, isGlobalManager)
 	if err != nil {
 		return handleCreateError("Gateway Prefix List", id, err)
 	}
@@ -302,7 +338,10 @@ func resourceNsxtPolicyGatewayPrefixListUpdate(d *schema.ResourceData, m interfa
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	prefixes := getPrefixesFromSchema(d)
+	prefixes, err := getPrefixesFromSchema(d)
+	if err != nil {
+		return err
+	}
 	tags := getPolicyTagsFromSchema(d)
 
 	prefixListStruct := model.PrefixList{
@@ -314,7 +353,7 @@ func resourceNsxtPolicyGatewayPrefixListUpdate(d *schema.ResourceData, m interfa
 	}
 
 	log.Printf("[INFO] Updating Gateway Prefix List with ID %s", id)
-	err := patchNsxtPolicyGatewayPrefixList(connector, gwID, prefixListStruct, isPolicyGlobalManager(m))
+	err = patchNsxtPolicyGatewayPrefixList(connector, gwID, prefixListStruct, isPolicyGlobalManager(m))
 	if err != nil {
 		return handleUpdateError("Gateway Prefix List", id, err)
 	}
@@ -81,6 +81,64 @@ func TestAccResourceNsxtPolicyGatewayPrefixList_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyGatewayPrefixList_ipv6(t *testing.T) {
+	name := getAccTestResourceName()
+	action := model.PrefixEntry_ACTION_PERMIT
+	ge := "56"
+	le := "64"
+	network := "2001:db8:4::/48"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGWPrefixListCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGWPrefixListCreateTemplate(name, action, ge, le, network),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGWPrefixListExists(testAccResourcePolicyGWPrefixListName),
+					resource.TestCheckResourceAttr(testAccResourcePolicyGWPrefixListName, "prefix.#", "1"),
+					resource.TestCheckResourceAttr(testAccResourcePolicyGWPrefixListName, "prefix.0.le", le),
+					resource.TestCheckResourceAttr(testAccResourcePolicyGWPrefixListName, "prefix.0.ge", ge),
+					resource.TestCheckResourceAttr(testAccResourcePolicyGWPrefixListName, "prefix.0.network", network),
+				),
+			},
+		},
+	})
+}
+
+func TestValidatePolicyPrefixEntryLength(t *testing.T) {
+	cases := map[string]struct {
+		network string
+		ge      int
+		le      int
+		result  bool
+	}{
+		"any":             {network: "", ge: 0, le: 0, result: true},
+		"anyIPv6Lengths":  {network: "", ge: 64, le: 128, result: true},
+		"ipv4":            {network: "4.4.0.0/20", ge: 20, le: 23, result: true},
+		"ipv4TooLong":     {network: "4.4.0.0/20", ge: 24, le: 48, result: false},
+		"ipv4GeTooShort":  {network: "4.4.0.0/20", ge: 16, le: 0, result: false},
+		"ipv6":            {network: "2001:db8:4::/48", ge: 56, le: 64, result: true},
+		"ipv6Max":         {network: "2001:db8:4::/48", ge: 0, le: 128, result: true},
+		"ipv6LeTooShort":  {network: "2001:db8:4::/48", ge: 0, le: 32, result: false},
+		"leSmallerThanGe": {network: "2001:db8:4::/48", ge: 64, le: 56, result: false},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			err := validatePolicyPrefixEntryLength(tc.network, tc.ge, tc.le)
+			if err != nil && tc.result {
+				t.Errorf("unexpected error for %s ge %d le %d: %v", tc.network, tc.ge, tc.le, err)
+			} else if err == nil && !tc.result {
+				t.Errorf("expected error for %s ge %d le %d", tc.network, tc.ge, tc.le)
+			}
+		})
+	}
+}
+
 func TestAccResourceNsxtPolicyGatewayPrefixList_import(t *testing.T) {
 	name := getAccTestResourceName()
 	action := model.PrefixEntry_ACTION_DENY
@@ -73,6 +73,26 @@ func TestAccResourceNsxtPolicyGatewayRedistributionConfig_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyGatewayRedistributionConfig_ipv6RouteMap(t *testing.T) {
+	testResourceName := "nsxt_policy_gateway_redistribution_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.1.3") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayRedistributionIPv6RouteMapTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0CheckRedistributionExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.route_map_path"),
+					resource.TestCheckResourceAttr("nsxt_policy_gateway_prefix_list.v6", "prefix.0.network", "2001:db8:100::/48"),
+				),
+			},
+		},
+	})
+}
+
 // Platform returns concurrent change for this scenario
 // This test verifies retry is working properly
 func TestAccResourceNsxtPolicyGatewayRedistributionConfig_rename(t *testing.T) {
@@ -284,3 +304,39 @@ resource "nsxt_policy_gateway_redistribution_config" "prod" {
   }
 }`, getAccTestSitePathConfig())
 }
+
+func testAccNsxtPolicyGatewayRedistributionIPv6RouteMapTemplate() string {
+	return testAccNsxtPolicyGatewayRedistributionPrerequisites() + `
+resource "nsxt_policy_gateway_prefix_list" "v6" {
+  display_name = "v6-prefixes"
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+
+  prefix {
+    action  = "PERMIT"
+    network = "2001:db8:100::/48"
+    ge      = 56
+    le      = 64
+  }
+}
+
+resource "nsxt_policy_gateway_route_map" "v6" {
+  display_name = "v6-route-map"
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+
+  entry {
+    action              = "PERMIT"
+    prefix_list_matches = [nsxt_policy_gateway_prefix_list.v6.path]
+  }
+}
+
+resource "nsxt_policy_gateway_redistribution_config" "test" {
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+  bgp_enabled  = true
+
+  rule {
+    name           = "v6-rule"
+    types          = ["TIER0_SEGMENT", "TIER1_CONNECTED"]
+    route_map_path = nsxt_policy_gateway_route_map.v6.path
+  }
+}`
+}
@@ -110,7 +110,7 @@ func getPolicyRouteMapEntrySchema() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Description:  "Set BGP regular or large community for matching routes",
-							ValidateFunc: validatePolicyBGPCommunity,
+							ValidateFunc: validatePolicyBGPCommunities,
 						},
 						"local_preference": {
 							Type:        schema.TypeInt,
@@ -0,0 +1,247 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes populated by NSX that should not be part of the configured body
+var policyGenericObjectSystemKeys = []string{
+	"id",
+	"path",
+	"parent_path",
+	"relative_path",
+	"remote_path",
+	"unique_id",
+	"realization_id",
+	"marked_for_delete",
+	"overridden",
+	"owner_id",
+	"origin_site_id",
+	"children",
+}
+
+func resourceNsxtPolicyGenericObject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyGenericObjectCreate,
+		Read:   resourceNsxtPolicyGenericObjectRead,
+		Update: resourceNsxtPolicyGenericObjectUpdate,
+		Delete: resourceNsxtPolicyGenericObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyGenericObjectImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the object",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Description:      "JSON body of the object. Only attributes specified here are tracked for changes",
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: policyGenericObjectBodyDiffSuppress,
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Description: "Resource type of the object",
+				Computed:    true,
+			},
+			"revision": getRevisionSchema(),
+		},
+	}
+}
+
+// policyGenericObjectNormalizeBody returns canonical JSON representation of the body,
+// excluding attributes managed by NSX, such as _revision
+func policyGenericObjectNormalizeBody(body string) (map[string]interface{}, string, error) {
+	decoded, err := policyGenericDecodeJSON(body)
+	if err != nil {
+		return nil, "", err
+	}
+	obj, ok := decoded.(map[string]interface{})
+	if !ok {
+		return nil, "", fmt.Errorf("body is expected to be a JSON object")
+	}
+	for key := range obj {
+		if strings.HasPrefix(key, "_") {
+			delete(obj, key)
+		}
+	}
+	normalized, err := json.Marshal(obj)
+	if err != nil {
+		return nil, "", err
+	}
+	return obj, string(normalized), nil
+}
+
+func policyGenericObjectBodyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	_, oldNormalized, err := policyGenericObjectNormalizeBody(old)
+	if err != nil {
+		return false
+	}
+	_, newNormalized, err := policyGenericObjectNormalizeBody(new)
+	if err != nil {
+		return false
+	}
+	return oldNormalized == newNormalized
+}
+
+// policyGenericObjectFilter returns subset of actual object that matches keys present in
+// configured object. This ensures only attributes specified by the user are compared.
+func policyGenericObjectFilter(configured interface{}, actual interface{}) interface{} {
+	switch configuredValue := configured.(type) {
+	case map[string]interface{}:
+		actualMap, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual
+		}
+		result := make(map[string]interface{})
+		for key, value := range configuredValue {
+			if actualValue, ok := actualMap[key]; ok {
+				result[key] = policyGenericObjectFilter(value, actualValue)
+			}
+		}
+		return result
+	case []interface{}:
+		actualList, ok := actual.([]interface{})
+		if !ok || len(actualList) != len(configuredValue) {
+			return actual
+		}
+		result := make([]interface{}, len(actualList))
+		for i := range actualList {
+			result[i] = policyGenericObjectFilter(configuredValue[i], actualList[i])
+		}
+		return result
+	}
+	return actual
+}
+
+func policyGenericObjectStripSystemKeys(obj map[string]interface{}) {
+	for key := range obj {
+		if strings.HasPrefix(key, "_") {
+			delete(obj, key)
+		}
+	}
+	for _, key := range policyGenericObjectSystemKeys {
+		delete(obj, key)
+	}
+}
+
+func resourceNsxtPolicyGenericObjectPatch(d *schema.ResourceData, m interface{}, policyPath string) error {
+	body, _, err := policyGenericObjectNormalizeBody(d.Get("body").(string))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Patching generic policy object %s", policyPath)
+	return policyGenericPatch(getPolicyConnector(m), isPolicyGlobalManager(m), policyPath, body)
+}
+
+func resourceNsxtPolicyGenericObjectCreate(d *schema.ResourceData, m interface{}) error {
+	policyPath := d.Get("path").(string)
+
+	_, err := policyGenericGet(getPolicyConnector(m), isPolicyGlobalManager(m), policyPath)
+	if err == nil {
+		return fmt.Errorf("Object with path %s already exists", policyPath)
+	}
+	if !isNotFoundError(err) {
+		return logAPIError(fmt.Sprintf("Failed to verify existence of %s", policyPath), err)
+	}
+
+	if err := resourceNsxtPolicyGenericObjectPatch(d, m, policyPath); err != nil {
+		return handleCreateError("GenericObject", policyPath, err)
+	}
+
+	d.SetId(policyPath)
+
+	return resourceNsxtPolicyGenericObjectRead(d, m)
+}
+
+func resourceNsxtPolicyGenericObjectRead(d *schema.ResourceData, m interface{}) error {
+	policyPath := d.Id()
+	if policyPath == "" {
+		return fmt.Errorf("Error obtaining GenericObject path")
+	}
+
+	obj, err := policyGenericGet(getPolicyConnector(m), isPolicyGlobalManager(m), policyPath)
+	if err != nil {
+		return handleReadError(d, "GenericObject", policyPath, err)
+	}
+
+	if revision, ok := obj["_revision"].(json.Number); ok {
+		value, _ := revision.Int64()
+		d.Set("revision", value)
+	}
+	if resourceType, ok := obj["resource_type"].(string); ok {
+		d.Set("resource_type", resourceType)
+	}
+	d.Set("path", policyPath)
+
+	var body interface{}
+	configured, _, err := policyGenericObjectNormalizeBody(d.Get("body").(string))
+	if err == nil && len(configured) > 0 {
+		body = policyGenericObjectFilter(configured, obj)
+	} else {
+		// Import flow - no configuration is known yet
+		policyGenericObjectStripSystemKeys(obj)
+		body = obj
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	d.Set("body", string(encoded))
+
+	return nil
+}
+
+func resourceNsxtPolicyGenericObjectUpdate(d *schema.ResourceData, m interface{}) error {
+	policyPath := d.Id()
+	if policyPath == "" {
+		return fmt.Errorf("Error obtaining GenericObject path")
+	}
+
+	if err := resourceNsxtPolicyGenericObjectPatch(d, m, policyPath); err != nil {
+		return handleUpdateError("GenericObject", policyPath, err)
+	}
+
+	return resourceNsxtPolicyGenericObjectRead(d, m)
+}
+
+func resourceNsxtPolicyGenericObjectDelete(d *schema.ResourceData, m interface{}) error {
+	policyPath := d.Id()
+	if policyPath == "" {
+		return fmt.Errorf("Error obtaining GenericObject path")
+	}
+
+	err := policyGenericDelete(getPolicyConnector(m), isPolicyGlobalManager(m), policyPath)
+	if err != nil {
+		return handleDeleteError("GenericObject", policyPath, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyGenericObjectImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	if err := validateImportPolicyPath(importID); err != nil {
+		return nil, err
+	}
+
+	d.Set("path", importID)
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,162 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyGenericObject_basic(t *testing.T) {
+	id := getAccTestResourceName()
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_generic_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGenericObjectCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGenericObjectTemplate(id, name, "Terraform provisioned"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGenericObjectExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "resource_type", "IpAddressPool"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGenericObjectTemplate(id, updatedName, "Terraform updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGenericObjectExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "resource_type", "IpAddressPool"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyGenericObject_importBasic(t *testing.T) {
+	id := getAccTestResourceName()
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_generic_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGenericObjectCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGenericObjectTemplate(id, name, "Terraform provisioned"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported body holds all attributes of the object
+				ImportStateVerifyIgnore: []string{"body"},
+			},
+		},
+	})
+}
+
+func TestPolicyGenericObjectFilter(t *testing.T) {
+	configured := map[string]interface{}{
+		"display_name": "test",
+		"subnets": []interface{}{
+			map[string]interface{}{"cidr": "10.0.0.0/24"},
+		},
+	}
+	actual := map[string]interface{}{
+		"display_name":  "test",
+		"_revision":     2,
+		"resource_type": "Foo",
+		"subnets": []interface{}{
+			map[string]interface{}{"cidr": "10.0.0.0/24", "id": "s1"},
+		},
+	}
+
+	filtered, err := json.Marshal(policyGenericObjectFilter(configured, actual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"display_name":"test","subnets":[{"cidr":"10.0.0.0/24"}]}`
+	if string(filtered) != expected {
+		t.Errorf("Expected %s, got %s", expected, string(filtered))
+	}
+}
+
+func testAccNsxtPolicyGenericObjectExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy GenericObject resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy GenericObject resource ID not set in resources")
+		}
+
+		_, err := policyGenericGet(connector, testAccIsGlobalManager(), resourceID)
+		if err != nil {
+			return fmt.Errorf("Policy GenericObject %s does not exist: %v", resourceID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyGenericObjectCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_generic_object" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		_, err := policyGenericGet(connector, testAccIsGlobalManager(), resourceID)
+		if err == nil {
+			return fmt.Errorf("Policy GenericObject %s still exists", resourceID)
+		}
+		if !isNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyGenericObjectTemplate(id string, displayName string, description string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_generic_object" "test" {
+  path = "/infra/ip-pools/%s"
+  body = jsonencode({
+    display_name = "%s"
+    description  = "%s"
+    tags = [{
+      scope = "scope1"
+      tag   = "tag1"
+    }]
+  })
+}`, id, displayName, description)
+}
@@ -813,13 +813,57 @@ func validateGroupCriteriaAndConjunctions(criteriaSets []interface{}, conjunctio
 	return criteriaMeta, nil
 }
 
+func createChildDomainWithGroup(domain string, group model.Group) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	childGroup := model.ChildGroup{
+		ResourceType: "ChildGroup",
+		Group:        &group,
+	}
+
+	dataValue, errors := converter.ConvertToVapi(childGroup, model.ChildGroupBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	childDomain := model.ChildResourceReference{
+		Id:           &domain,
+		ResourceType: "ChildResourceReference",
+		TargetType:   strPtr("Domain"),
+		Children:     []*data.StructValue{dataValue.(*data.StructValue)},
+	}
+
+	dataValue, errors = converter.ConvertToVapi(childDomain, model.ChildResourceReferenceBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func policyGroupPatch(d *schema.ResourceData, m interface{}, domainName string, id string, obj model.Group) error {
+	context := getSessionContext(d, m)
+	if policyBulkApplyEnabled(m) && context.ClientType != utl.VPC {
+		obj.Id = &id
+		obj.ResourceType = strPtr("Group")
+		childDomain, err := createChildDomainWithGroup(domainName, obj)
+		if err != nil {
+			return fmt.Errorf("Failed to create H-API for Group: %s", err)
+		}
+		return policyBulkInfraPatch(context, childDomain, m)
+	}
+
+	client := domains.NewGroupsClient(context, getPolicyConnector(m))
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	return client.Patch(domainName, id, obj)
+}
+
 func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error {
 	return resourceNsxtPolicyGroupGeneralCreate(d, m, true)
 }
 
 func resourceNsxtPolicyGroupGeneralCreate(d *schema.ResourceData, m interface{}, withDomain bool) error {
-	connector := getPolicyConnector(m)
-
 	domainName := ""
 	if withDomain {
 		domainName = d.Get("domain").(string)
@@ -872,14 +916,9 @@ func resourceNsxtPolicyGroupGeneralCreate(d *schema.ResourceData, m interface{},
 		obj.GroupType = groupTypes
 	}
 
-	client := domains.NewGroupsClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	err = client.Patch(domainName, id, obj)
-
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating Group with ID %s", id)
+	err = policyGroupPatch(d, m, domainName, id, obj)
 	if err != nil {
 		return handleCreateError("Group", id, err)
 	}
@@ -954,8 +993,6 @@ func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceNsxtPolicyGroupGeneralUpdate(d *schema.ResourceData, m interface{}, withDomain bool) error {
-	connector := getPolicyConnector(m)
-
 	id := d.Id()
 	if id == "" {
 		return fmt.Errorf("Error obtaining Group ID")
@@ -1004,17 +1041,12 @@ func resourceNsxtPolicyGroupGeneralUpdate(d *schema.ResourceData, m interface{},
 		obj.GroupType = groupTypes
 	}
 
-	client := domains.NewGroupsClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
 	// Update the resource using PATCH
 	domainName := ""
 	if withDomain {
 		domainName = d.Get("domain").(string)
 	}
-	err = client.Patch(domainName, id, obj)
+	err = policyGroupPatch(d, m, domainName, id, obj)
 	if err != nil {
 		return handleUpdateError("Group", id, err)
 	}
@@ -16,9 +16,13 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	t0_ipsec_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/ipsec_vpn_services"
+	t0_ipsec_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/ipsec_vpn_services/sessions"
 	t0_ipsec_nested_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/ipsec_vpn_services"
+	t0_ipsec_nested_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/ipsec_vpn_services/sessions"
 	t1_ipsec_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/ipsec_vpn_services"
+	t1_ipsec_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/ipsec_vpn_services/sessions"
 	t1_ipsec_nested_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services/ipsec_vpn_services"
+	t1_ipsec_nested_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services/ipsec_vpn_services/sessions"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
@@ -420,6 +424,42 @@ func (c *ipsecSessionClient) Delete(connector client.Connector, id string) error
 	return client.Delete(c.gwID, c.serviceID, id)
 }
 
+func (c *ipsecSessionClient) DetailedStatus(connector client.Connector, id string, enforcementPointPath *string) (model.AggregateIPSecVpnSessionStatus, error) {
+	if c.isT0 {
+		if len(c.localeServiceID) > 0 {
+			client := t0_ipsec_nested_sessions.NewDetailedStatusClient(connector)
+			return client.Get(c.gwID, c.localeServiceID, c.serviceID, id, enforcementPointPath, nil)
+		}
+		client := t0_ipsec_sessions.NewDetailedStatusClient(connector)
+		return client.Get(c.gwID, c.serviceID, id, enforcementPointPath, nil)
+	}
+
+	if len(c.localeServiceID) > 0 {
+		client := t1_ipsec_nested_sessions.NewDetailedStatusClient(connector)
+		return client.Get(c.gwID, c.localeServiceID, c.serviceID, id, enforcementPointPath, nil)
+	}
+	client := t1_ipsec_sessions.NewDetailedStatusClient(connector)
+	return client.Get(c.gwID, c.serviceID, id, enforcementPointPath, nil)
+}
+
+func (c *ipsecSessionClient) Statistics(connector client.Connector, id string, enforcementPointPath *string) (model.AggregateIPSecVpnSessionStatistics, error) {
+	if c.isT0 {
+		if len(c.localeServiceID) > 0 {
+			client := t0_ipsec_nested_sessions.NewStatisticsClient(connector)
+			return client.Get(c.gwID, c.localeServiceID, c.serviceID, id, enforcementPointPath, nil)
+		}
+		client := t0_ipsec_sessions.NewStatisticsClient(connector)
+		return client.Get(c.gwID, c.serviceID, id, enforcementPointPath, nil)
+	}
+
+	if len(c.localeServiceID) > 0 {
+		client := t1_ipsec_nested_sessions.NewStatisticsClient(connector)
+		return client.Get(c.gwID, c.localeServiceID, c.serviceID, id, enforcementPointPath, nil)
+	}
+	client := t1_ipsec_sessions.NewStatisticsClient(connector)
+	return client.Get(c.gwID, c.serviceID, id, enforcementPointPath, nil)
+}
+
 func parseIPSecVPNServicePolicyPath(path string) (bool, string, string, string, error) {
 	segs := strings.Split(path, "/")
 	// Path should be like /infra/tier-1s/aaa/locale-services/default/ipsec-vpn-services/ccc
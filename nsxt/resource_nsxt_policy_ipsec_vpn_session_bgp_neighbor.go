@@ -0,0 +1,541 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/bgp"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIPSecVpnSessionBgpNeighbor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIPSecVpnSessionBgpNeighborCreate,
+		Read:   resourceNsxtPolicyIPSecVpnSessionBgpNeighborRead,
+		Update: resourceNsxtPolicyIPSecVpnSessionBgpNeighborUpdate,
+		Delete: resourceNsxtPolicyIPSecVpnSessionBgpNeighborDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyIPSecVpnSessionBgpNeighborImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"session_path": getPolicyPathSchema(true, true, "Policy path of route based IPSec VPN session on Tier0 gateway"),
+			"bgp_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path to the BGP config of the Tier0 gateway. Derived from the session when not specified",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"neighbor_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "IP address of the peer end of the tunnel interface",
+				ValidateFunc: validateSingleIP(),
+			},
+			"source_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Tunnel interface IP address to peer from. Derived from session tunnel interface when not specified",
+				ValidateFunc: validateSingleIP(),
+			},
+			"remote_as_num": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "ASN of the neighbor in ASPLAIN or ASDOT Format",
+				ValidateFunc: validateASPlainOrDot,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable BGP peering with this neighbor",
+				Optional:    true,
+				Default:     true,
+			},
+			"hold_down_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      180,
+				ValidateFunc: validation.IntBetween(1, 65535),
+				Description:  "Wait time in seconds before declaring peer dead",
+			},
+			"keep_alive_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(1, 65535),
+				Description:  "Interval between keep alive messages sent to peer",
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Password for BGP neighbor authentication",
+				ValidateFunc: validation.StringLenBetween(0, 20),
+				Sensitive:    true,
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Runtime status of the tunnel and BGP session over it",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tunnel_status": {
+							Type:        schema.TypeString,
+							Description: "Runtime status of the IPSec VPN session",
+							Computed:    true,
+						},
+						"ike_status": {
+							Type:        schema.TypeString,
+							Description: "State of the IKE session",
+							Computed:    true,
+						},
+						"ike_fail_reason": {
+							Type:        schema.TypeString,
+							Description: "Reason of IKE session failure",
+							Computed:    true,
+						},
+						"total_tunnels":      getComputedIntSchema("Total number of tunnels of the session"),
+						"negotiated_tunnels": getComputedIntSchema("Number of negotiated tunnels"),
+						"failed_tunnels":     getComputedIntSchema("Number of failed tunnels"),
+						"bgp_connection_state": {
+							Type:        schema.TypeString,
+							Description: "State of the BGP session, ESTABLISHED if established from any edge node",
+							Computed:    true,
+						},
+						"bgp_established_edge_paths": {
+							Type:        schema.TypeList,
+							Description: "Policy paths of edge nodes with established BGP session",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func parseIPSecVPNSessionPolicyPath(path string) (string, string, error) {
+	// Path should be like <service path>/sessions/<session id>
+	segs := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	segCount := len(segs)
+	if segCount < 3 || segs[segCount-2] != "sessions" {
+		return "", "", fmt.Errorf("Invalid IPSec VPN session path %s", path)
+	}
+	servicePath := strings.Join(segs[:segCount-2], "/")
+	if _, _, _, _, err := parseIPSecVPNServicePolicyPath(servicePath); err != nil {
+		return "", "", fmt.Errorf("Invalid IPSec VPN session path %s", path)
+	}
+
+	return servicePath, segs[segCount-1], nil
+}
+
+// getPolicyIPSecVpnSessionTunnelSubnets returns tunnel interface addresses of route based session with their networks
+func getPolicyIPSecVpnSessionTunnelSubnets(session model.RouteBasedIPSecVpnSession) ([]*net.IPNet, error) {
+	var subnets []*net.IPNet
+	for _, vti := range session.TunnelInterfaces {
+		for _, ipSubnet := range vti.IpSubnets {
+			if ipSubnet.PrefixLength == nil {
+				continue
+			}
+			for _, address := range ipSubnet.IpAddresses {
+				ip, network, err := net.ParseCIDR(fmt.Sprintf("%s/%d", address, *ipSubnet.PrefixLength))
+				if err != nil {
+					return nil, fmt.Errorf("Failed to parse tunnel interface address %s: %v", address, err)
+				}
+				network.IP = ip
+				subnets = append(subnets, network)
+			}
+		}
+	}
+	return subnets, nil
+}
+
+// resolvePolicyIPSecVtiBgpSourceAddress validates that neighbor address is reachable over one of the tunnel
+// interface subnets, and returns the tunnel interface address to be used as BGP source address
+func resolvePolicyIPSecVtiBgpSourceAddress(vtiSubnets []*net.IPNet, neighborAddress string, sourceAddress string) (string, error) {
+	neighbor := net.ParseIP(neighborAddress)
+	if neighbor == nil {
+		return "", fmt.Errorf("Invalid neighbor address %s", neighborAddress)
+	}
+	if len(vtiSubnets) == 0 {
+		return "", fmt.Errorf("IPSec VPN session has no tunnel interface addresses")
+	}
+
+	for _, subnet := range vtiSubnets {
+		if sourceAddress != "" && !subnet.IP.Equal(net.ParseIP(sourceAddress)) {
+			continue
+		}
+		if subnet.IP.Equal(neighbor) {
+			return "", fmt.Errorf("Neighbor address %s can not be the tunnel interface address", neighborAddress)
+		}
+		if subnet.Contains(neighbor) {
+			return subnet.IP.String(), nil
+		}
+		if sourceAddress != "" {
+			return "", fmt.Errorf("Neighbor address %s is not in tunnel interface subnet %s of source address %s", neighborAddress, subnet.String(), sourceAddress)
+		}
+	}
+
+	if sourceAddress != "" {
+		return "", fmt.Errorf("Source address %s is not a tunnel interface address of the IPSec VPN session", sourceAddress)
+	}
+	var subnets []string
+	for _, subnet := range vtiSubnets {
+		subnets = append(subnets, subnet.String())
+	}
+	return "", fmt.Errorf("Neighbor address %s is not in any of tunnel interface subnets %s", neighborAddress, strings.Join(subnets, ", "))
+}
+
+func getPolicyRouteBasedIPSecVpnSession(connector client.Connector, sessionPath string) (*model.RouteBasedIPSecVpnSession, error) {
+	servicePath, sessionID, err := parseIPSecVPNSessionPolicyPath(sessionPath)
+	if err != nil {
+		return nil, err
+	}
+	sessionClient, err := newIpsecSessionClient(servicePath)
+	if err != nil {
+		return nil, err
+	}
+	if !sessionClient.isT0 {
+		return nil, fmt.Errorf("BGP peering is only supported for IPSec VPN sessions on Tier0 gateway")
+	}
+
+	obj, err := sessionClient.Get(connector, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := bindings.NewTypeConverter()
+	baseObj, errs := converter.ConvertToGolang(obj, model.IPSecVpnSessionBindingType())
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	if baseObj.(model.IPSecVpnSession).ResourceType != model.IPSecVpnSession_RESOURCE_TYPE_ROUTEBASEDIPSECVPNSESSION {
+		return nil, fmt.Errorf("IPSec VPN session %s is not route based", sessionPath)
+	}
+	routeObj, errs := converter.ConvertToGolang(obj, model.RouteBasedIPSecVpnSessionBindingType())
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	session := routeObj.(model.RouteBasedIPSecVpnSession)
+	return &session, nil
+}
+
+// getPolicyIPSecVpnSessionBgpPath derives BGP config path of the gateway the session belongs to
+func getPolicyIPSecVpnSessionBgpPath(d *schema.ResourceData, m interface{}, sessionPath string) (string, error) {
+	servicePath, _, err := parseIPSecVPNSessionPolicyPath(sessionPath)
+	if err != nil {
+		return "", err
+	}
+	_, gwID, localeServiceID, _, err := parseIPSecVPNServicePolicyPath(servicePath)
+	if err != nil {
+		return "", err
+	}
+
+	if localeServiceID == "" {
+		localeService, err := getPolicyTier0GatewayLocaleServiceEntry(getSessionContext(d, m), gwID, getPolicyConnector(m))
+		if err != nil {
+			return "", err
+		}
+		if localeService == nil {
+			return "", fmt.Errorf("Tier0 gateway %s has no locale service for BGP config", gwID)
+		}
+		localeServiceID = *localeService.Id
+	}
+
+	return fmt.Sprintf("/infra/tier-0s/%s/locale-services/%s/bgp", gwID, localeServiceID), nil
+}
+
+func resourceNsxtPolicyIPSecVpnSessionBgpNeighborPatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	sessionPath := d.Get("session_path").(string)
+	bgpPath := d.Get("bgp_path").(string)
+	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
+	if t0ID == "" || serviceID == "" {
+		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
+	}
+	servicePath, _, err := parseIPSecVPNSessionPolicyPath(sessionPath)
+	if err != nil {
+		return err
+	}
+	if _, gwID, _, _, _ := parseIPSecVPNServicePolicyPath(servicePath); gwID != t0ID {
+		return fmt.Errorf("BGP config %s does not belong to gateway of IPSec VPN session %s", bgpPath, sessionPath)
+	}
+
+	session, err := getPolicyRouteBasedIPSecVpnSession(connector, sessionPath)
+	if err != nil {
+		return fmt.Errorf("Failed to retrieve IPSec VPN session %s: %v", sessionPath, err)
+	}
+	vtiSubnets, err := getPolicyIPSecVpnSessionTunnelSubnets(*session)
+	if err != nil {
+		return err
+	}
+	neighborAddress := d.Get("neighbor_address").(string)
+	sourceAddress, err := resolvePolicyIPSecVtiBgpSourceAddress(vtiSubnets, neighborAddress, d.Get("source_address").(string))
+	if err != nil {
+		return err
+	}
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	remoteAsNum := d.Get("remote_as_num").(string)
+	enabled := d.Get("enabled").(bool)
+	holdDownTime := int64(d.Get("hold_down_time").(int))
+	keepAliveTime := int64(d.Get("keep_alive_time").(int))
+	// Tunnel peer is directly connected
+	maximumHopLimit := int64(1)
+	obj := model.BgpNeighborConfig{
+		DisplayName:     &displayName,
+		Description:     &description,
+		Tags:            getPolicyTagsFromSchema(d),
+		NeighborAddress: &neighborAddress,
+		SourceAddresses: []string{sourceAddress},
+		RemoteAsNum:     &remoteAsNum,
+		Enabled:         &enabled,
+		HoldDownTime:    &holdDownTime,
+		KeepAliveTime:   &keepAliveTime,
+		MaximumHopLimit: &maximumHopLimit,
+		Id:              &id,
+	}
+	if d.HasChange("password") {
+		password := d.Get("password").(string)
+		obj.Password = &password
+	}
+
+	log.Printf("[INFO] Patching BgpNeighbor %s for IPSec VPN session %s", id, sessionPath)
+	client := bgp.NewNeighborsClient(connector)
+	return client.Patch(t0ID, serviceID, id, obj, nil)
+}
+
+func resourceNsxtPolicyIPSecVpnSessionBgpNeighborCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+	connector := getPolicyConnector(m)
+
+	if d.Get("bgp_path").(string) == "" {
+		bgpPath, err := getPolicyIPSecVpnSessionBgpPath(d, m, d.Get("session_path").(string))
+		if err != nil {
+			return err
+		}
+		d.Set("bgp_path", bgpPath)
+	}
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	}
+	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(d.Get("bgp_path").(string))
+	exists, err := resourceNsxtPolicyBgpNeighborExists(t0ID, serviceID, id, false, connector)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("BGP Neighbor with ID %s already exists for Tier-O %s and Locale Service %s", id, t0ID, serviceID)
+	}
+
+	err = resourceNsxtPolicyIPSecVpnSessionBgpNeighborPatch(id, d, m)
+	if err != nil {
+		return handleCreateError("IPSec VPN Session BGP Neighbor", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIPSecVpnSessionBgpNeighborRead(d, m)
+}
+
+func setPolicyIPSecVpnSessionBgpStatusInSchema(d *schema.ResourceData, connector client.Connector, neighborAddress string) error {
+	status := make(map[string]interface{})
+
+	sessionStatus, err := getPolicyIPSecVpnSessionStatus(connector, d.Get("session_path").(string), nil)
+	if err != nil {
+		log.Printf("[WARNING] Failed to retrieve status for IPSec VPN session %s: %v", d.Get("session_path").(string), err)
+	} else if sessionStatus != nil {
+		status["tunnel_status"] = sessionStatus.RuntimeStatus
+		status["total_tunnels"] = sessionStatus.TotalTunnels
+		status["negotiated_tunnels"] = sessionStatus.NegotiatedTunnels
+		status["failed_tunnels"] = sessionStatus.FailedTunnels
+		if sessionStatus.IkeStatus != nil {
+			status["ike_status"] = sessionStatus.IkeStatus.IkeSessionState
+			status["ike_fail_reason"] = sessionStatus.IkeStatus.FailReason
+		}
+	}
+
+	bgpStatus, err := listPolicyBgpNeighborStatus(connector, false, d.Get("bgp_path").(string), nil)
+	if err != nil {
+		log.Printf("[WARNING] Failed to retrieve BGP neighbor status for %s: %v", neighborAddress, err)
+	} else {
+		var establishedEdges []string
+		connectionState := ""
+		for _, neighbor := range bgpStatus.Results {
+			if neighbor.NeighborAddress == nil || *neighbor.NeighborAddress != neighborAddress || neighbor.ConnectionState == nil {
+				continue
+			}
+			if *neighbor.ConnectionState == model.PolicyBgpNeighborStatus_CONNECTION_STATE_ESTABLISHED {
+				if neighbor.EdgePath != nil {
+					establishedEdges = append(establishedEdges, *neighbor.EdgePath)
+				}
+				connectionState = *neighbor.ConnectionState
+			} else if connectionState == "" {
+				connectionState = *neighbor.ConnectionState
+			}
+		}
+		status["bgp_connection_state"] = connectionState
+		status["bgp_established_edge_paths"] = establishedEdges
+	}
+
+	return d.Set("status", []interface{}{status})
+}
+
+func resourceNsxtPolicyIPSecVpnSessionBgpNeighborRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSec VPN Session BGP Neighbor ID")
+	}
+
+	bgpPath := d.Get("bgp_path").(string)
+	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
+	if t0ID == "" || serviceID == "" {
+		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
+	}
+
+	client := bgp.NewNeighborsClient(connector)
+	obj, err := client.Get(t0ID, serviceID, id)
+	if err != nil {
+		return handleReadError(d, "IPSec VPN Session BGP Neighbor", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	// NOTE: password is not returned on API responses
+	d.Set("neighbor_address", obj.NeighborAddress)
+	d.Set("remote_as_num", obj.RemoteAsNum)
+	d.Set("hold_down_time", obj.HoldDownTime)
+	d.Set("keep_alive_time", obj.KeepAliveTime)
+	if len(obj.SourceAddresses) > 0 {
+		d.Set("source_address", obj.SourceAddresses[0])
+	}
+	if obj.Enabled != nil {
+		d.Set("enabled", obj.Enabled)
+	} else {
+		d.Set("enabled", true)
+	}
+
+	neighborAddress := ""
+	if obj.NeighborAddress != nil {
+		neighborAddress = *obj.NeighborAddress
+	}
+	return setPolicyIPSecVpnSessionBgpStatusInSchema(d, connector, neighborAddress)
+}
+
+func resourceNsxtPolicyIPSecVpnSessionBgpNeighborUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSec VPN Session BGP Neighbor ID")
+	}
+
+	err := resourceNsxtPolicyIPSecVpnSessionBgpNeighborPatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("IPSec VPN Session BGP Neighbor", id, err)
+	}
+
+	return resourceNsxtPolicyIPSecVpnSessionBgpNeighborRead(d, m)
+}
+
+func resourceNsxtPolicyIPSecVpnSessionBgpNeighborDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPSec VPN Session BGP Neighbor ID")
+	}
+
+	bgpPath := d.Get("bgp_path").(string)
+	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
+	if t0ID == "" || serviceID == "" {
+		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
+	}
+
+	client := bgp.NewNeighborsClient(getPolicyConnector(m))
+	err := client.Delete(t0ID, serviceID, id, nil)
+	if err != nil {
+		return handleDeleteError("IPSec VPN Session BGP Neighbor", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIPSecVpnSessionBgpNeighborImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	separator := strings.LastIndex(importID, "/")
+	if separator <= 0 {
+		return nil, fmt.Errorf("Please provide <session-path>/<neighbor-id> as an input")
+	}
+	sessionPath := importID[:separator]
+	if _, _, err := parseIPSecVPNSessionPolicyPath(sessionPath); err != nil {
+		return nil, fmt.Errorf("Please provide <session-path>/<neighbor-id> as an input")
+	}
+
+	bgpPath, err := getPolicyIPSecVpnSessionBgpPath(d, m, sessionPath)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(importID[separator+1:])
+	d.Set("session_path", sessionPath)
+	d.Set("bgp_path", bgpPath)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getPolicyIPSecVpnSessionStatus retrieves runtime status of IPSec VPN session by policy path.
+// nil is returned if the status is not yet available on the enforcement point.
+func getPolicyIPSecVpnSessionStatus(connector client.Connector, sessionPath string, enforcementPointPath *string) (*model.IPSecVpnSessionStatusNsxt, error) {
+	servicePath, sessionID, err := parseIPSecVPNSessionPolicyPath(sessionPath)
+	if err != nil {
+		return nil, err
+	}
+	sessionClient, err := newIpsecSessionClient(servicePath)
+	if err != nil {
+		return nil, err
+	}
+	result, err := sessionClient.DetailedStatus(connector, sessionID, enforcementPointPath)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := bindings.NewTypeConverter()
+	for _, statusValue := range result.Results {
+		status, errs := converter.ConvertToGolang(statusValue, model.IPSecVpnSessionStatusNsxtBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		sessionStatus := status.(model.IPSecVpnSessionStatusNsxt)
+		return &sessionStatus, nil
+	}
+	return nil, nil
+}
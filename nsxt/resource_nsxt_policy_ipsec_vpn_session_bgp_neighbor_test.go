@@ -0,0 +1,234 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestParseIPSecVPNSessionPolicyPath(t *testing.T) {
+	servicePath, sessionID, err := parseIPSecVPNSessionPolicyPath("/infra/tier-0s/t0/locale-services/default/ipsec-vpn-services/svc/sessions/s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if servicePath != "/infra/tier-0s/t0/locale-services/default/ipsec-vpn-services/svc" || sessionID != "s1" {
+		t.Errorf("Unexpected parse result %s, %s", servicePath, sessionID)
+	}
+
+	for _, path := range []string{"/infra/tier-0s/t0/ipsec-vpn-services/svc", "/infra/tier-0s/t0/sessions/s1", "s1"} {
+		if _, _, err := parseIPSecVPNSessionPolicyPath(path); err == nil {
+			t.Errorf("Expected error for path %s", path)
+		}
+	}
+}
+
+func TestResolvePolicyIPSecVtiBgpSourceAddress(t *testing.T) {
+	var subnets []*net.IPNet
+	for _, cidr := range []string{"169.254.10.1/30", "fd00::1/126"} {
+		ip, network, _ := net.ParseCIDR(cidr)
+		network.IP = ip
+		subnets = append(subnets, network)
+	}
+
+	tests := []struct {
+		neighbor string
+		source   string
+		expected string
+		isError  bool
+	}{
+		{"169.254.10.2", "", "169.254.10.1", false},
+		{"fd00::2", "", "fd00::1", false},
+		{"169.254.10.2", "169.254.10.1", "169.254.10.1", false},
+		{"169.254.10.1", "", "", true},
+		{"169.254.11.2", "", "", true},
+		{"fd00::2", "169.254.10.1", "", true},
+		{"169.254.10.2", "169.254.10.3", "", true},
+	}
+
+	for _, test := range tests {
+		source, err := resolvePolicyIPSecVtiBgpSourceAddress(subnets, test.neighbor, test.source)
+		if test.isError {
+			if err == nil {
+				t.Errorf("Expected error for neighbor %s with source %s", test.neighbor, test.source)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Unexpected error for neighbor %s: %v", test.neighbor, err)
+		} else if source != test.expected {
+			t.Errorf("Expected source %s for neighbor %s, got %s", test.expected, test.neighbor, source)
+		}
+	}
+
+	if _, err := resolvePolicyIPSecVtiBgpSourceAddress(nil, "169.254.10.2", ""); err == nil {
+		t.Errorf("Expected error for session without tunnel interface")
+	}
+}
+
+func TestGetPolicyIPSecVpnSessionStatus(t *testing.T) {
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/policy/api/v1/infra/tier-0s/t0/ipsec-vpn-services/svc/sessions/s1/detailed-status" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeTestJSONResponse(w, http.StatusOK, `{"intent_path": "/infra/tier-0s/t0/ipsec-vpn-services/svc/sessions/s1", "results": [
+		  {"resource_type": "IPSecVpnSessionStatusNsxT", "runtime_status": "DEGRADED", "total_tunnels": 2, "failed_tunnels": 1,
+		   "ike_status": {"ike_session_state": "UP"}}
+		]}`)
+	})
+
+	status, err := getPolicyIPSecVpnSessionStatus(connector, "/infra/tier-0s/t0/ipsec-vpn-services/svc/sessions/s1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status == nil || *status.RuntimeStatus != "DEGRADED" || *status.FailedTunnels != 1 || *status.IkeStatus.IkeSessionState != "UP" {
+		t.Errorf("Unexpected session status %v", status)
+	}
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnSessionBgpNeighbor_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipsec_vpn_session_bgp_neighbor.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_CLUSTER")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnSessionBgpNeighborCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnSessionBgpNeighborTemplate("65001", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "neighbor_address", "169.254.152.26"),
+					resource.TestCheckResourceAttr(testResourceName, "source_address", "169.254.152.25"),
+					resource.TestCheckResourceAttr(testResourceName, "remote_as_num", "65001"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "bgp_path"),
+					resource.TestCheckResourceAttr(testResourceName, "status.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIPSecVpnSessionBgpNeighborTemplate("65002", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "remote_as_num", "65002"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "source_address", "169.254.152.25"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIPSecVpnSessionBgpNeighbor_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipsec_vpn_session_bgp_neighbor.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_CLUSTER")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIPSecVpnSessionBgpNeighborCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIPSecVpnSessionBgpNeighborTemplate("65001", true),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status"},
+				ImportStateIdFunc:       testAccNsxtPolicyIPSecVpnSessionBgpNeighborImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIPSecVpnSessionBgpNeighborImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_ipsec_vpn_session_bgp_neighbor.test"]
+	if !ok {
+		return "", fmt.Errorf("NSX Policy IPSec VPN Session BGP Neighbor resource not found in resources")
+	}
+	sessionPath := rs.Primary.Attributes["session_path"]
+	if sessionPath == "" {
+		return "", fmt.Errorf("NSX Policy IPSec VPN Session BGP Neighbor session_path not set")
+	}
+	return fmt.Sprintf("%s/%s", sessionPath, rs.Primary.ID), nil
+}
+
+func testAccNsxtPolicyIPSecVpnSessionBgpNeighborCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_ipsec_vpn_session_bgp_neighbor" {
+			continue
+		}
+
+		t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(rs.Primary.Attributes["bgp_path"])
+		exists, err := resourceNsxtPolicyBgpNeighborExists(t0ID, serviceID, rs.Primary.ID, false, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Policy IPSec VPN Session BGP Neighbor %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIPSecVpnSessionBgpNeighborTemplate(remoteAs string, enabled bool) string {
+	attrMap := accTestPolicyIPSecVpnSessionRouteBasedCreateAttributes
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyIPSecVpnSessionPreConditionTemplate(true, false) + fmt.Sprintf(`
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name = "%s"
+  ha_mode      = "ACTIVE_STANDBY"
+
+  locale_service {
+    edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  }
+
+  bgp_config {
+    local_as_num = "60000"
+  }
+}
+
+resource "nsxt_policy_ipsec_vpn_session" "test" {
+  display_name        = "%s"
+  tunnel_profile_path = nsxt_policy_ipsec_vpn_tunnel_profile.test.path
+  local_endpoint_path = nsxt_policy_ipsec_vpn_local_endpoint.test.path
+  service_path        = nsxt_policy_ipsec_vpn_service.test_ipsec_svc.path
+  vpn_type            = "RouteBased"
+  peer_address        = "%s"
+  peer_id             = "%s"
+  ip_addresses        = ["%s"]
+  prefix_length       = %s
+  psk                 = "%s"
+}
+
+resource "nsxt_policy_ipsec_vpn_session_bgp_neighbor" "test" {
+  display_name     = "%s"
+  session_path     = nsxt_policy_ipsec_vpn_session.test.path
+  neighbor_address = "169.254.152.26"
+  remote_as_num    = "%s"
+  enabled          = %t
+}`, testAccNsxtPolicyVPNGatewayHelperName, attrMap["display_name"], attrMap["peer_address"], attrMap["peer_id"],
+		attrMap["ip_addresses"], attrMap["prefix_length"], attrMap["psk"], attrMap["display_name"], remoteAs, enabled)
+}
@@ -0,0 +1,356 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t0_l2vpn_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/l2vpn_services"
+	t0_l2vpn_nested_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/l2vpn_services"
+	t1_l2vpn_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/l2vpn_services"
+	t1_l2vpn_nested_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services/l2vpn_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyL2VpnClientSession() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyL2VpnClientSessionCreate,
+		Read:   resourceNsxtPolicyL2VpnClientSessionRead,
+		Update: resourceNsxtPolicyL2VpnClientSessionUpdate,
+		Delete: resourceNsxtPolicyL2VpnClientSessionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"service_path": getPolicyPathSchema(true, true, "Policy path of L2 VPN service in CLIENT mode"),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable/Disable L2 VPN session",
+				Optional:    true,
+				Default:     true,
+			},
+			"transport_tunnel": {
+				Type:        schema.TypeList,
+				Description: "Transport tunnels to create from peer codes of the server session",
+				Required:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_address": {
+							Type:         schema.TypeString,
+							Description:  "IPv4 address of local endpoint",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"peer_address": {
+							Type:         schema.TypeString,
+							Description:  "IPv4 address of peer endpoint on the server site",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"peer_code": {
+							Type:        schema.TypeString,
+							Description: "Peer code of the transport tunnel, generated on the server site",
+							Required:    true,
+							ForceNew:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"transport_tunnels": {
+				Type:        schema.TypeList,
+				Description: "Policy paths of IPSec VPN sessions created as transport tunnels",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+type l2vpnSessionClient struct {
+	isT0            bool
+	gwID            string
+	localeServiceID string
+	serviceID       string
+}
+
+func newL2vpnSessionClient(servicePath string) (*l2vpnSessionClient, error) {
+	isT0, gwID, localeServiceID, serviceID, err := parseL2VPNServicePolicyPath(servicePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &l2vpnSessionClient{
+		isT0:            isT0,
+		gwID:            gwID,
+		localeServiceID: localeServiceID,
+		serviceID:       serviceID,
+	}, nil
+}
+
+func (c *l2vpnSessionClient) GetService(connector client.Connector) (model.L2VPNService, error) {
+	return getNsxtPolicyL2VpnServiceByID(connector, c.gwID, c.isT0, c.localeServiceID, c.serviceID, false)
+}
+
+func (c *l2vpnSessionClient) Get(connector client.Connector, id string) (model.L2VPNSession, error) {
+	if c.isT0 {
+		if len(c.localeServiceID) > 0 {
+			client := t0_l2vpn_nested_services.NewSessionsClient(connector)
+			return client.Get(c.gwID, c.localeServiceID, c.serviceID, id)
+		}
+		client := t0_l2vpn_services.NewSessionsClient(connector)
+		return client.Get(c.gwID, c.serviceID, id)
+	}
+	if len(c.localeServiceID) > 0 {
+		client := t1_l2vpn_nested_services.NewSessionsClient(connector)
+		return client.Get(c.gwID, c.localeServiceID, c.serviceID, id)
+	}
+	client := t1_l2vpn_services.NewSessionsClient(connector)
+	return client.Get(c.gwID, c.serviceID, id)
+}
+
+func (c *l2vpnSessionClient) CreateWithPeerCode(connector client.Connector, id string, obj model.L2VPNSessionData) error {
+	if c.isT0 {
+		if len(c.localeServiceID) > 0 {
+			client := t0_l2vpn_nested_services.NewSessionsClient(connector)
+			return client.Createwithpeercode(c.gwID, c.localeServiceID, c.serviceID, id, obj)
+		}
+		client := t0_l2vpn_services.NewSessionsClient(connector)
+		return client.Createwithpeercode(c.gwID, c.serviceID, id, obj)
+	}
+	if len(c.localeServiceID) > 0 {
+		client := t1_l2vpn_nested_services.NewSessionsClient(connector)
+		return client.Createwithpeercode(c.gwID, c.localeServiceID, c.serviceID, id, obj)
+	}
+	client := t1_l2vpn_services.NewSessionsClient(connector)
+	return client.Createwithpeercode(c.gwID, c.serviceID, id, obj)
+}
+
+func (c *l2vpnSessionClient) Patch(connector client.Connector, id string, obj model.L2VPNSession) error {
+	if c.isT0 {
+		if len(c.localeServiceID) > 0 {
+			client := t0_l2vpn_nested_services.NewSessionsClient(connector)
+			return client.Patch(c.gwID, c.localeServiceID, c.serviceID, id, obj)
+		}
+		client := t0_l2vpn_services.NewSessionsClient(connector)
+		return client.Patch(c.gwID, c.serviceID, id, obj)
+	}
+	if len(c.localeServiceID) > 0 {
+		client := t1_l2vpn_nested_services.NewSessionsClient(connector)
+		return client.Patch(c.gwID, c.localeServiceID, c.serviceID, id, obj)
+	}
+	client := t1_l2vpn_services.NewSessionsClient(connector)
+	return client.Patch(c.gwID, c.serviceID, id, obj)
+}
+
+func (c *l2vpnSessionClient) Delete(connector client.Connector, id string) error {
+	if c.isT0 {
+		if len(c.localeServiceID) > 0 {
+			client := t0_l2vpn_nested_services.NewSessionsClient(connector)
+			return client.Delete(c.gwID, c.localeServiceID, c.serviceID, id)
+		}
+		client := t0_l2vpn_services.NewSessionsClient(connector)
+		return client.Delete(c.gwID, c.serviceID, id)
+	}
+	if len(c.localeServiceID) > 0 {
+		client := t1_l2vpn_nested_services.NewSessionsClient(connector)
+		return client.Delete(c.gwID, c.localeServiceID, c.serviceID, id)
+	}
+	client := t1_l2vpn_services.NewSessionsClient(connector)
+	return client.Delete(c.gwID, c.serviceID, id)
+}
+
+func getPolicyL2VpnClientSessionDataFromSchema(d *schema.ResourceData) model.L2VPNSessionData {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	enabled := d.Get("enabled").(bool)
+
+	var tunnels []model.L2VPNSessionTransportTunnelData
+	for _, tunnel := range d.Get("transport_tunnel").([]interface{}) {
+		data := tunnel.(map[string]interface{})
+		localAddress := data["local_address"].(string)
+		peerAddress := data["peer_address"].(string)
+		peerCode := data["peer_code"].(string)
+		tunnels = append(tunnels, model.L2VPNSessionTransportTunnelData{
+			LocalAddress: &localAddress,
+			PeerAddress:  &peerAddress,
+			PeerCode:     &peerCode,
+		})
+	}
+
+	return model.L2VPNSessionData{
+		DisplayName:      &displayName,
+		Description:      &description,
+		Enabled:          &enabled,
+		TransportTunnels: tunnels,
+	}
+}
+
+// patchPolicyL2VpnClientSession updates attributes that are not part of peer code based creation
+func patchPolicyL2VpnClientSession(d *schema.ResourceData, connector client.Connector, sessionClient *l2vpnSessionClient, id string) error {
+	obj, err := sessionClient.Get(connector, id)
+	if err != nil {
+		return err
+	}
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	enabled := d.Get("enabled").(bool)
+	obj.DisplayName = &displayName
+	obj.Description = &description
+	obj.Enabled = &enabled
+	obj.Tags = getPolicyTagsFromSchema(d)
+
+	return sessionClient.Patch(connector, id, obj)
+}
+
+func resourceNsxtPolicyL2VpnClientSessionCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+	connector := getPolicyConnector(m)
+
+	servicePath := d.Get("service_path").(string)
+	sessionClient, err := newL2vpnSessionClient(servicePath)
+	if err != nil {
+		return err
+	}
+
+	service, err := sessionClient.GetService(connector)
+	if err != nil {
+		return fmt.Errorf("Failed to retrieve L2 VPN service %s: %v", servicePath, err)
+	}
+	if service.Mode == nil || *service.Mode != model.L2VPNService_MODE_CLIENT {
+		return fmt.Errorf("L2 VPN service %s is expected to be in %s mode", servicePath, model.L2VPNService_MODE_CLIENT)
+	}
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	}
+	_, err = sessionClient.Get(connector, id)
+	if err == nil {
+		return fmt.Errorf("L2VpnSession with nsx_id '%s' already exists", id)
+	} else if !isNotFoundError(err) {
+		return err
+	}
+
+	log.Printf("[INFO] Creating L2 VPN client session with ID %s", id)
+	err = sessionClient.CreateWithPeerCode(connector, id, getPolicyL2VpnClientSessionDataFromSchema(d))
+	if err != nil {
+		return handleCreateError("L2VPNClientSession", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	// Tags can not be specified with peer code based creation
+	if len(d.Get("tag").(*schema.Set).List()) > 0 {
+		err = patchPolicyL2VpnClientSession(d, connector, sessionClient, id)
+		if err != nil {
+			return handleCreateError("L2VPNClientSession", id, err)
+		}
+	}
+
+	return resourceNsxtPolicyL2VpnClientSessionRead(d, m)
+}
+
+func resourceNsxtPolicyL2VpnClientSessionRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L2VPNClientSession ID")
+	}
+
+	sessionClient, err := newL2vpnSessionClient(d.Get("service_path").(string))
+	if err != nil {
+		return err
+	}
+	obj, err := sessionClient.Get(connector, id)
+	if err != nil {
+		return handleReadError(d, "L2VPNClientSession", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("enabled", obj.Enabled)
+	d.Set("transport_tunnels", obj.TransportTunnels)
+
+	return nil
+}
+
+func resourceNsxtPolicyL2VpnClientSessionUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L2VPNClientSession ID")
+	}
+
+	sessionClient, err := newL2vpnSessionClient(d.Get("service_path").(string))
+	if err != nil {
+		return err
+	}
+	err = patchPolicyL2VpnClientSession(d, connector, sessionClient, id)
+	if err != nil {
+		return handleUpdateError("L2VPNClientSession", id, err)
+	}
+
+	return resourceNsxtPolicyL2VpnClientSessionRead(d, m)
+}
+
+func resourceNsxtPolicyL2VpnClientSessionDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L2VPNClientSession ID")
+	}
+
+	sessionClient, err := newL2vpnSessionClient(d.Get("service_path").(string))
+	if err != nil {
+		return err
+	}
+	err = sessionClient.Delete(connector, id)
+	if err != nil {
+		return handleDeleteError("L2VPNClientSession", id, err)
+	}
+
+	// Transport tunnels were created implicitly from peer codes, and are owned by this resource
+	for _, tunnelPath := range interface2StringList(d.Get("transport_tunnels").([]interface{})) {
+		servicePath, tunnelID, err := parseIPSecVPNSessionPolicyPath(tunnelPath)
+		if err != nil {
+			log.Printf("[WARNING] Skipping deletion of transport tunnel %s: %v", tunnelPath, err)
+			continue
+		}
+		tunnelClient, err := newIpsecSessionClient(servicePath)
+		if err != nil {
+			return err
+		}
+		err = tunnelClient.Delete(connector, tunnelID)
+		if err != nil {
+			return handleDeleteError("IPSecVpnSession", tunnelID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyL2VpnClientSession_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_l2_vpn_client_session.test"
+	displayName := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_CLUSTER")
+			// Peer code generated by L2 VPN server session on another NSX
+			testAccEnvDefined(t, "NSXT_TEST_L2VPN_PEER_CODE")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyL2VpnClientSessionCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL2VpnClientSessionTemplate(displayName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_tunnel.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_tunnels.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyL2VpnClientSessionTemplate(updatedName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_tunnels.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyL2VpnClientSessionCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_l2_vpn_client_session" {
+			continue
+		}
+
+		sessionClient, err := newL2vpnSessionClient(rs.Primary.Attributes["service_path"])
+		if err != nil {
+			return err
+		}
+		_, err = sessionClient.Get(connector, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Policy L2 VPN client session %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyL2VpnClientSessionTemplate(displayName string, enabled bool) string {
+	return testAccNsxtPolicyTier0WithEdgeClusterForVPN() + fmt.Sprintf(`
+resource "nsxt_policy_ipsec_vpn_service" "test" {
+  display_name  = "%s"
+  gateway_path  = nsxt_policy_tier0_gateway.test.path
+}
+
+resource "nsxt_policy_l2_vpn_service" "test" {
+  display_name  = "%s"
+  gateway_path  = nsxt_policy_tier0_gateway.test.path
+  mode          = "CLIENT"
+  depends_on    = [nsxt_policy_ipsec_vpn_service.test]
+}
+
+resource "nsxt_policy_l2_vpn_client_session" "test" {
+  display_name = "%s"
+  service_path = nsxt_policy_l2_vpn_service.test.path
+  enabled      = %t
+
+  transport_tunnel {
+    local_address = "20.20.0.25"
+    peer_address  = "18.18.18.19"
+    peer_code     = "%s"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, displayName, displayName, displayName, enabled, os.Getenv("NSXT_TEST_L2VPN_PEER_CODE"))
+}
@@ -0,0 +1,461 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	t0nat "github.com/vmware/terraform-provider-nsxt/api/infra/tier_0s/nat"
+	t1nat "github.com/vmware/terraform-provider-nsxt/api/infra/tier_1s/nat"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
+)
+
+func resourceNsxtPolicyNATRules() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyNATRulesCreate,
+		Read:   resourceNsxtPolicyNATRulesRead,
+		Update: resourceNsxtPolicyNATRulesUpdate,
+		Delete: resourceNsxtPolicyNATRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyNATRulesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"context":      getContextSchema(false, false, false),
+			"gateway_path": getPolicyGatewayPathSchema(),
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "NAT section of the gateway to manage",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      model.PolicyNat_NAT_TYPE_USER,
+				ValidateFunc: validation.StringInSlice(policyNATRuleTypeValues, false),
+			},
+			"max_rules_per_request": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of rules in single hierarchical request. All rules are applied in single request by default",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "List of NAT rules in the section. Rules that are not listed are removed from the section",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: getPolicyNATRulesRuleSchema(),
+				},
+			},
+		},
+	}
+}
+
+// getPolicyNATRulesRuleSchema returns schema of a single rule within the section, based on
+// nsxt_policy_nat_rule schema
+func getPolicyNATRulesRuleSchema() map[string]*schema.Schema {
+	ruleSchema := resourceNsxtPolicyNATRule().Schema
+
+	// Gateway, context and NAT type are common for all rules in the section
+	delete(ruleSchema, "gateway_path")
+	delete(ruleSchema, "context")
+	delete(ruleSchema, "type")
+
+	ruleSchema["nsx_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "NSX ID of the rule, generated when not specified",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	return ruleSchema
+}
+
+func getPolicyNATRuleFromMap(ruleMap map[string]interface{}) model.PolicyNatRule {
+	id := ruleMap["nsx_id"].(string)
+	displayName := ruleMap["display_name"].(string)
+	description := ruleMap["description"].(string)
+	action := ruleMap["action"].(string)
+	enabled := ruleMap["enabled"].(bool)
+	fwMatch := ruleMap["firewall_match"].(string)
+	logging := ruleMap["logging"].(bool)
+	priority := int64(ruleMap["rule_priority"].(int))
+	service := ruleMap["service"].(string)
+	ports := ruleMap["translated_ports"].(string)
+	pbvmMatch := ruleMap["policy_based_vpn_mode"].(string)
+	dNets := stringListToCommaSeparatedString(interfaceListToStringList(ruleMap["destination_networks"].([]interface{})))
+	sNets := stringListToCommaSeparatedString(interfaceListToStringList(ruleMap["source_networks"].([]interface{})))
+	tNets := stringListToCommaSeparatedString(interfaceListToStringList(ruleMap["translated_networks"].([]interface{})))
+	scope := interface2StringList(ruleMap["scope"].(*schema.Set).List())
+	resourceType := "PolicyNatRule"
+
+	rule := model.PolicyNatRule{
+		Id:                 &id,
+		ResourceType:       &resourceType,
+		DisplayName:        &displayName,
+		Description:        &description,
+		Tags:               getPolicyTagsFromSet(ruleMap["tag"].(*schema.Set)),
+		Action:             &action,
+		DestinationNetwork: dNets,
+		SourceNetwork:      sNets,
+		Enabled:            &enabled,
+		Logging:            &logging,
+		SequenceNumber:     &priority,
+		Service:            &service,
+		TranslatedNetwork:  tNets,
+		Scope:              scope,
+	}
+
+	// handle values that can't be an empty string
+	if fwMatch != "" {
+		rule.FirewallMatch = &fwMatch
+	}
+	if ports != "" {
+		rule.TranslatedPorts = &ports
+	}
+	if pbvmMatch != "" && util.NsxVersionHigherOrEqual("4.0.0") {
+		rule.PolicyBasedVpnMode = &pbvmMatch
+	}
+
+	return rule
+}
+
+func getPolicyNATRuleMap(rule model.PolicyNatRule) map[string]interface{} {
+	elem := make(map[string]interface{})
+	elem["nsx_id"] = rule.Id
+	elem["path"] = rule.Path
+	elem["revision"] = rule.Revision
+	elem["display_name"] = rule.DisplayName
+	elem["description"] = rule.Description
+	elem["tag"] = initPolicyTagsSet(rule.Tags)
+	elem["action"] = rule.Action
+	if rule.DestinationNetwork != nil {
+		elem["destination_networks"] = commaSeparatedStringToStringList(*rule.DestinationNetwork)
+	}
+	elem["enabled"] = rule.Enabled
+	elem["firewall_match"] = rule.FirewallMatch
+	elem["logging"] = rule.Logging
+	elem["rule_priority"] = rule.SequenceNumber
+	elem["service"] = rule.Service
+	if rule.SourceNetwork != nil {
+		elem["source_networks"] = commaSeparatedStringToStringList(*rule.SourceNetwork)
+	}
+	if rule.TranslatedNetwork != nil {
+		elem["translated_networks"] = commaSeparatedStringToStringList(*rule.TranslatedNetwork)
+	}
+	elem["translated_ports"] = rule.TranslatedPorts
+	elem["scope"] = rule.Scope
+	if util.NsxVersionHigherOrEqual("4.0.0") {
+		elem["policy_based_vpn_mode"] = rule.PolicyBasedVpnMode
+	}
+
+	return elem
+}
+
+func validatePolicyNATRule(rule model.PolicyNatRule, natType string) error {
+	if err := validateNatTypeAction(*rule.Action, natType); err != nil {
+		return fmt.Errorf("NAT rule %s: %v", *rule.DisplayName, err)
+	}
+	if _, err := getTranslatedNetworks(rule); err != nil {
+		return fmt.Errorf("NAT rule %s: %v", *rule.DisplayName, err)
+	}
+	if _, err := getPolicyBasedVpnMode(rule); err != nil {
+		return fmt.Errorf("NAT rule %s: %v", *rule.DisplayName, err)
+	}
+	return nil
+}
+
+// listNsxtPolicyNATRules lists user rules in gateway NAT section. System owned rules are skipped.
+func listNsxtPolicyNATRules(sessionContext utl.SessionContext, connector client.Connector, gwID string, isT0 bool, natType string) ([]model.PolicyNatRule, error) {
+	var rules []model.PolicyNatRule
+	var cursor *string
+	for {
+		var result model.PolicyNatRuleListResult
+		var err error
+		if isT0 {
+			client := t0nat.NewNatRulesClient(sessionContext, connector)
+			if client == nil {
+				return nil, policyResourceNotSupportedError()
+			}
+			result, err = client.List(gwID, natType, cursor, nil, nil, nil, nil, nil)
+		} else {
+			client := t1nat.NewNatRulesClient(sessionContext, connector)
+			if client == nil {
+				return nil, policyResourceNotSupportedError()
+			}
+			result, err = client.List(gwID, natType, cursor, nil, nil, nil, nil, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range result.Results {
+			if rule.SystemOwned != nil && *rule.SystemOwned {
+				continue
+			}
+			rules = append(rules, rule)
+		}
+		cursor = result.Cursor
+		if cursor == nil || *cursor == "" || len(result.Results) == 0 {
+			break
+		}
+	}
+
+	return rules, nil
+}
+
+func initChildPolicyNATRule(rule model.PolicyNatRule, markForDelete bool) (*data.StructValue, error) {
+	childRule := model.ChildPolicyNatRule{
+		ResourceType:    "ChildPolicyNatRule",
+		Id:              rule.Id,
+		PolicyNatRule:   &rule,
+		MarkedForDelete: &markForDelete,
+	}
+
+	dataValue, errors := bindings.NewTypeConverter().ConvertToVapi(childRule, model.ChildPolicyNatRuleBindingType())
+	if len(errors) > 0 {
+		return nil, fmt.Errorf("Error converting child NAT rule: %v", errors[0])
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+// policyNATRulesInfraPatch applies all rule changes of the NAT section in hierarchical API calls,
+// with up to maxSize rules per call. maxSize of 0 means all rules are applied in a single call.
+func policyNATRulesInfraPatch(sessionContext utl.SessionContext, connector client.Connector, gwID string, isT0 bool, natType string, rules []model.PolicyNatRule, removedIDs []string, maxSize int) error {
+	var natChildren []*data.StructValue
+	for _, rule := range rules {
+		dataValue, err := initChildPolicyNATRule(rule, false)
+		if err != nil {
+			return err
+		}
+		natChildren = append(natChildren, dataValue)
+	}
+
+	resourceType := "PolicyNatRule"
+	for _, removedID := range removedIDs {
+		ruleID := removedID
+		dataValue, err := initChildPolicyNATRule(model.PolicyNatRule{Id: &ruleID, ResourceType: &resourceType}, true)
+		if err != nil {
+			return err
+		}
+		natChildren = append(natChildren, dataValue)
+	}
+
+	if maxSize <= 0 {
+		maxSize = len(natChildren)
+	}
+	for start := 0; start < len(natChildren); start += maxSize {
+		end := start + maxSize
+		if end > len(natChildren) {
+			end = len(natChildren)
+		}
+		infraObj, err := getPolicyNATRulesInfra(gwID, isT0, natType, natChildren[start:end])
+		if err != nil {
+			return err
+		}
+		if err := policyInfraPatch(sessionContext, infraObj, connector, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getPolicyNATRulesInfra(gwID string, isT0 bool, natType string, natChildren []*data.StructValue) (model.Infra, error) {
+	converter := bindings.NewTypeConverter()
+	natTargetType := "PolicyNat"
+	natRef := model.ChildResourceReference{
+		Id:           &natType,
+		ResourceType: "ChildResourceReference",
+		TargetType:   &natTargetType,
+		Children:     natChildren,
+	}
+	natValue, errors := converter.ConvertToVapi(natRef, model.ChildResourceReferenceBindingType())
+	if len(errors) > 0 {
+		return model.Infra{}, fmt.Errorf("Error converting NAT section reference: %v", errors[0])
+	}
+
+	gwTargetType := "Tier1"
+	if isT0 {
+		gwTargetType = "Tier0"
+	}
+	gwRef := model.ChildResourceReference{
+		Id:           &gwID,
+		ResourceType: "ChildResourceReference",
+		TargetType:   &gwTargetType,
+		Children:     []*data.StructValue{natValue.(*data.StructValue)},
+	}
+	gwValue, errors := converter.ConvertToVapi(gwRef, model.ChildResourceReferenceBindingType())
+	if len(errors) > 0 {
+		return model.Infra{}, fmt.Errorf("Error converting gateway reference: %v", errors[0])
+	}
+
+	infraType := "Infra"
+	return model.Infra{
+		Children:     []*data.StructValue{gwValue.(*data.StructValue)},
+		ResourceType: &infraType,
+	}, nil
+}
+
+func getPolicyNATRulesGateway(d *schema.ResourceData, m interface{}) (utl.SessionContext, string, bool, error) {
+	context := getSessionContext(d, m)
+	isT0, gwID := parseGatewayPolicyPath(d.Get("gateway_path").(string))
+	if gwID == "" {
+		return context, "", false, fmt.Errorf("gateway_path is not valid")
+	}
+	if isT0 && context.ClientType == utl.Multitenancy {
+		return context, "", false, handleMultitenancyTier0Error()
+	}
+	return context, gwID, isT0, nil
+}
+
+// getPolicyNATRulesID builds resource ID as policy path of the NAT section
+func getPolicyNATRulesID(gwPath string, natType string) string {
+	return fmt.Sprintf("%s/nat/%s", strings.TrimSuffix(gwPath, "/"), natType)
+}
+
+// applyPolicyNATRules patches configured rules, and removes any other rule present in the NAT section
+func applyPolicyNATRules(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	context, gwID, isT0, err := getPolicyNATRulesGateway(d, m)
+	if err != nil {
+		return err
+	}
+	natType := d.Get("type").(string)
+
+	ruleMaps, err := assignPolicyNestedObjectIDs(d, "rule")
+	if err != nil {
+		return err
+	}
+
+	var rules []model.PolicyNatRule
+	for _, ruleMap := range ruleMaps {
+		rule := getPolicyNATRuleFromMap(ruleMap.(map[string]interface{}))
+		if err := validatePolicyNATRule(rule, natType); err != nil {
+			return err
+		}
+		rules = append(rules, rule)
+	}
+
+	existingRules, err := listNsxtPolicyNATRules(context, connector, gwID, isT0, natType)
+	if err != nil {
+		return err
+	}
+	var existingIDs []string
+	for _, rule := range existingRules {
+		existingIDs = append(existingIDs, *rule.Id)
+	}
+	removedIDs := getPolicyNestedObjectRemovedIDs(existingIDs, getPolicyNestedObjectIDs(ruleMaps))
+
+	log.Printf("[INFO] Applying %d NAT rules and removing %d NAT rules on gateway %s section %s", len(rules), len(removedIDs), gwID, natType)
+	return policyNATRulesInfraPatch(context, connector, gwID, isT0, natType, rules, removedIDs, d.Get("max_rules_per_request").(int))
+}
+
+func resourceNsxtPolicyNATRulesCreate(d *schema.ResourceData, m interface{}) error {
+	id := getPolicyNATRulesID(d.Get("gateway_path").(string), d.Get("type").(string))
+	err := applyPolicyNATRules(d, m)
+	if err != nil {
+		return handleCreateError("NAT Rules", id, err)
+	}
+
+	d.SetId(id)
+	return resourceNsxtPolicyNATRulesRead(d, m)
+}
+
+func resourceNsxtPolicyNATRulesRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	context, gwID, isT0, err := getPolicyNATRulesGateway(d, m)
+	if err != nil {
+		return err
+	}
+	natType := d.Get("type").(string)
+
+	rules, err := listNsxtPolicyNATRules(context, connector, gwID, isT0, natType)
+	if err != nil {
+		return handleListError("NAT Rules", err)
+	}
+
+	ruleMap := make(map[string]model.PolicyNatRule)
+	var actualIDs []string
+	for _, rule := range rules {
+		ruleMap[*rule.Id] = rule
+		actualIDs = append(actualIDs, *rule.Id)
+	}
+
+	var ruleList []interface{}
+	knownIDs := getPolicyNestedObjectIDs(d.Get("rule").([]interface{}))
+	for _, id := range orderPolicyNestedObjectIDs(knownIDs, actualIDs) {
+		ruleList = append(ruleList, getPolicyNATRuleMap(ruleMap[id]))
+	}
+
+	return d.Set("rule", ruleList)
+}
+
+func resourceNsxtPolicyNATRulesUpdate(d *schema.ResourceData, m interface{}) error {
+	err := applyPolicyNATRules(d, m)
+	if err != nil {
+		return handleUpdateError("NAT Rules", d.Id(), err)
+	}
+
+	return resourceNsxtPolicyNATRulesRead(d, m)
+}
+
+func resourceNsxtPolicyNATRulesDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	context, gwID, isT0, err := getPolicyNATRulesGateway(d, m)
+	if err != nil {
+		return err
+	}
+	natType := d.Get("type").(string)
+
+	rules, err := listNsxtPolicyNATRules(context, connector, gwID, isT0, natType)
+	if err != nil {
+		return handleDeleteError("NAT Rules", d.Id(), err)
+	}
+	var ruleIDs []string
+	for _, rule := range rules {
+		ruleIDs = append(ruleIDs, *rule.Id)
+	}
+
+	err = policyNATRulesInfraPatch(context, connector, gwID, isT0, natType, nil, ruleIDs, d.Get("max_rules_per_request").(int))
+	if err != nil {
+		return handleDeleteError("NAT Rules", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyNATRulesImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	// Expected format is <gateway path>/nat/<nat type>
+	segs := strings.Split(importID, "/nat/")
+	if len(segs) != 2 || segs[1] == "" {
+		return nil, fmt.Errorf("Expected NAT section path <gateway path>/nat/<nat type>, got %s", importID)
+	}
+
+	gwPath := segs[0]
+	natType := segs[1]
+	d.SetId(gwPath)
+	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err != nil {
+		return rd, err
+	}
+
+	d.Set("gateway_path", gwPath)
+	d.Set("type", natType)
+	// Request size is not stored on NSX, hence the default is assumed
+	d.Set("max_rules_per_request", 0)
+	d.SetId(importID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,294 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var testAccResourcePolicyNATRulesName = "nsxt_policy_nat_rules.test"
+
+func TestAccResourceNsxtPolicyNATRules_basicT1(t *testing.T) {
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyNATRulesCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyNATRulesTier1Template(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyNATRulesCheckCount(testAccResourcePolicyNATRulesName, 2),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "type", model.PolicyNat_NAT_TYPE_USER),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "rule.0.display_name", name+"-snat"),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "rule.0.action", model.PolicyNatRule_ACTION_SNAT),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "rule.1.display_name", name+"-dnat"),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "rule.1.nsx_id", "dnat-rule"),
+					resource.TestCheckResourceAttrSet(testAccResourcePolicyNATRulesName, "rule.0.nsx_id"),
+					resource.TestCheckResourceAttrSet(testAccResourcePolicyNATRulesName, "rule.0.path"),
+					resource.TestCheckResourceAttrSet(testAccResourcePolicyNATRulesName, "rule.0.revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyNATRulesTier1Template(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyNATRulesCheckCount(testAccResourcePolicyNATRulesName, 1),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "rule.0.display_name", name+"-dnat"),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "rule.0.nsx_id", "dnat-rule"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyNATRules_nat64T1(t *testing.T) {
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccNSXVersion(t, "3.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyNATRulesCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyNATRulesNat64Template(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyNATRulesCheckCount(testAccResourcePolicyNATRulesName, 1),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "type", model.PolicyNat_NAT_TYPE_NAT64),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "rule.0.action", model.PolicyNatRule_ACTION_NAT64),
+					resource.TestCheckResourceAttr(testAccResourcePolicyNATRulesName, "rule.0.translated_networks.0", "44.11.11.2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyNATRules_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyNATRulesCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyNATRulesTier1Template(name, true),
+			},
+			{
+				ResourceName:      testAccResourcePolicyNATRulesName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestPolicyNATRuleFromMap(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNsxtPolicyNATRules().Schema, map[string]interface{}{
+		"gateway_path": "/infra/tier-1s/t1",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"nsx_id":               "r1",
+				"display_name":         "rule1",
+				"action":               model.PolicyNatRule_ACTION_NAT64,
+				"source_networks":      []interface{}{"2201::14", "2201::15"},
+				"destination_networks": []interface{}{"3301::14"},
+				"translated_networks":  []interface{}{"44.11.11.2"},
+				"rule_priority":        200,
+			},
+		},
+	})
+
+	rule := getPolicyNATRuleFromMap(d.Get("rule").([]interface{})[0].(map[string]interface{}))
+	if *rule.Id != "r1" || *rule.DisplayName != "rule1" || *rule.SequenceNumber != 200 {
+		t.Errorf("Unexpected rule attributes %s, %s, %d", *rule.Id, *rule.DisplayName, *rule.SequenceNumber)
+	}
+	if *rule.SourceNetwork != "2201::14,2201::15" || *rule.TranslatedNetwork != "44.11.11.2" {
+		t.Errorf("Unexpected rule networks %s, %s", *rule.SourceNetwork, *rule.TranslatedNetwork)
+	}
+	if rule.TranslatedPorts != nil {
+		t.Errorf("Empty translated ports are expected to be omitted")
+	}
+	if *rule.FirewallMatch != model.PolicyNatRule_FIREWALL_MATCH_BYPASS {
+		t.Errorf("Unexpected firewall match %s", *rule.FirewallMatch)
+	}
+	if err := validatePolicyNATRule(rule, model.PolicyNat_NAT_TYPE_NAT64); err != nil {
+		t.Errorf("Unexpected validation error: %v", err)
+	}
+	if err := validatePolicyNATRule(rule, model.PolicyNat_NAT_TYPE_USER); err == nil {
+		t.Errorf("Expected validation error for NAT64 rule in USER section")
+	}
+
+	elem := getPolicyNATRuleMap(rule)
+	if elem["source_networks"].([]string)[1] != "2201::15" || elem["nsx_id"].(*string) != rule.Id {
+		t.Errorf("Unexpected rule map %v", elem)
+	}
+}
+
+func TestPolicyNATRulesInfraPatch(t *testing.T) {
+	var requestSizes []int
+	connector := newTestServerConnector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/policy/api/v1/infra" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var infra struct {
+			Children []struct {
+				Children []struct {
+					ID       string `json:"id"`
+					Children []interface{}
+				}
+			}
+		}
+		if err := json.NewDecoder(r.Body).Decode(&infra); err != nil || len(infra.Children) != 1 || len(infra.Children[0].Children) != 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		natRef := infra.Children[0].Children[0]
+		if natRef.ID != model.PolicyNat_NAT_TYPE_USER {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requestSizes = append(requestSizes, len(natRef.Children))
+	})
+	context := utl.SessionContext{ClientType: utl.Local}
+
+	var rules []model.PolicyNatRule
+	for i := 0; i < 3; i++ {
+		rules = append(rules, model.PolicyNatRule{Id: strPtr(fmt.Sprintf("r%d", i)), Action: strPtr(model.PolicyNatRule_ACTION_DNAT)})
+	}
+	removedIDs := []string{"r3", "r4"}
+
+	err := policyNATRulesInfraPatch(context, connector, "t1", false, model.PolicyNat_NAT_TYPE_USER, rules, removedIDs, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(requestSizes) != "[2 2 1]" {
+		t.Errorf("Expected 3 requests with 2, 2 and 1 rules, got %v", requestSizes)
+	}
+
+	requestSizes = nil
+	err = policyNATRulesInfraPatch(context, connector, "t1", false, model.PolicyNat_NAT_TYPE_USER, rules, removedIDs, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(requestSizes) != "[5]" {
+		t.Errorf("Expected single request with 5 rules, got %v", requestSizes)
+	}
+}
+
+func testAccNsxtPolicyNATRulesCheckCount(resourceName string, count int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy NAT Rules resource %s not found in resources", resourceName)
+		}
+
+		isT0, gwID := parseGatewayPolicyPath(rs.Primary.Attributes["gateway_path"])
+		rules, err := listNsxtPolicyNATRules(testAccGetSessionContext(), connector, gwID, isT0, rs.Primary.Attributes["type"])
+		if err != nil {
+			return fmt.Errorf("Error while retrieving policy NAT Rules: %v", err)
+		}
+		if len(rules) != count {
+			return fmt.Errorf("Expected %d NAT rules on backend, found %d", count, len(rules))
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyNATRulesCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_nat_rules" {
+			continue
+		}
+
+		isT0, gwID := parseGatewayPolicyPath(rs.Primary.Attributes["gateway_path"])
+		rules, err := listNsxtPolicyNATRules(testAccGetSessionContext(), connector, gwID, isT0, rs.Primary.Attributes["type"])
+		if err == nil && len(rules) > 0 {
+			return fmt.Errorf("Policy NAT Rules still exist on %s", gwID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyNATRulesTier1Template(name string, withSnat bool) string {
+	snatRule := ""
+	if withSnat {
+		snatRule = fmt.Sprintf(`
+  rule {
+    display_name        = "%s-snat"
+    action              = "%s"
+    source_networks     = ["%s"]
+    translated_networks = ["%s"]
+    rule_priority       = 100
+  }
+`, name, model.PolicyNatRule_ACTION_SNAT, testAccResourcePolicyNATRuleSourceNet, testAccResourcePolicyNATRuleTransNet)
+	}
+
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false, false) + fmt.Sprintf(`
+resource "nsxt_policy_nat_rules" "test" {
+  gateway_path = nsxt_policy_tier1_gateway.test.path
+%s
+  rule {
+    nsx_id               = "dnat-rule"
+    display_name         = "%s-dnat"
+    action               = "%s"
+    destination_networks = ["%s"]
+    translated_networks  = ["%s"]
+    rule_priority        = 200
+
+    tag {
+      scope = "scope1"
+      tag   = "tag1"
+    }
+  }
+}
+`, snatRule, name, model.PolicyNatRule_ACTION_DNAT, testAccResourcePolicyNATRuleDestNet, testAccResourcePolicyNATRuleTransNet)
+}
+
+func testAccNsxtPolicyNATRulesNat64Template(name string) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false, false) + fmt.Sprintf(`
+resource "nsxt_policy_nat_rules" "test" {
+  gateway_path = nsxt_policy_tier1_gateway.test.path
+  type         = "%s"
+
+  rule {
+    display_name         = "%s"
+    action               = "%s"
+    source_networks      = ["2201::0014"]
+    destination_networks = ["3301:1122::1280:0014"]
+    translated_networks  = ["44.11.11.2"]
+  }
+}
+`, model.PolicyNat_NAT_TYPE_NAT64, name, model.PolicyNatRule_ACTION_NAT64)
+}
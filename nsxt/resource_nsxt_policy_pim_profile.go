@@ -0,0 +1,207 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyPimProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyPimProfileCreate,
+		Read:   resourceNsxtPolicyPimProfileRead,
+		Update: resourceNsxtPolicyPimProfileUpdate,
+		Delete: resourceNsxtPolicyPimProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"bsm_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Accept and forward bootstrap messages (BSM)",
+				Optional:    true,
+				Default:     true,
+			},
+			"rp_address_multicast_range": {
+				Type:        schema.TypeList,
+				Description: "Static RP addresses with multicast group ranges they serve",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rp_address": {
+							Type:         schema.TypeString,
+							Description:  "Static rendezvous point address",
+							Required:     true,
+							ValidateFunc: validateSingleIP(),
+						},
+						"multicast_ranges": {
+							Type:        schema.TypeList,
+							Description: "Multicast group ranges in CIDR format served by this RP",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateCidr(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyPimProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewPimProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyPimProfileFromSchema(d *schema.ResourceData) model.PolicyPimProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	bsmEnabled := d.Get("bsm_enabled").(bool)
+
+	var rpRanges []model.RpAddressMulticastRanges
+	for _, item := range d.Get("rp_address_multicast_range").([]interface{}) {
+		rpCfg := item.(map[string]interface{})
+		rpAddress := rpCfg["rp_address"].(string)
+		rpRanges = append(rpRanges, model.RpAddressMulticastRanges{
+			RpAddress:       &rpAddress,
+			MulticastRanges: interface2StringList(rpCfg["multicast_ranges"].([]interface{})),
+		})
+	}
+
+	return model.PolicyPimProfile{
+		DisplayName:              &displayName,
+		Description:              &description,
+		Tags:                     tags,
+		BsmEnabled:               &bsmEnabled,
+		RpAddressMulticastRanges: rpRanges,
+	}
+}
+
+func setPolicyPimProfileRpRangesInSchema(d *schema.ResourceData, rpRanges []model.RpAddressMulticastRanges) error {
+	var result []interface{}
+	for _, rpRange := range rpRanges {
+		elem := make(map[string]interface{})
+		elem["rp_address"] = rpRange.RpAddress
+		elem["multicast_ranges"] = rpRange.MulticastRanges
+		result = append(result, elem)
+	}
+
+	return d.Set("rp_address_multicast_range", result)
+}
+
+func resourceNsxtPolicyPimProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyPimProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := policyPimProfileFromSchema(d)
+
+	log.Printf("[INFO] Creating PimProfile with ID %s", id)
+	client := infra.NewPimProfilesClient(connector)
+	err = client.Patch(id, obj)
+	if err != nil {
+		return handleCreateError("PimProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyPimProfileRead(d, m)
+}
+
+func resourceNsxtPolicyPimProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PimProfile ID")
+	}
+
+	client := infra.NewPimProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "PimProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("bsm_enabled", obj.BsmEnabled)
+
+	return setPolicyPimProfileRpRangesInSchema(d, obj.RpAddressMulticastRanges)
+}
+
+func resourceNsxtPolicyPimProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PimProfile ID")
+	}
+
+	obj := policyPimProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	client := infra.NewPimProfilesClient(connector)
+	_, err := client.Update(id, obj)
+	if err != nil {
+		return handleUpdateError("PimProfile", id, err)
+	}
+
+	return resourceNsxtPolicyPimProfileRead(d, m)
+}
+
+func resourceNsxtPolicyPimProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PimProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewPimProfilesClient(connector)
+	err := client.Delete(id)
+
+	if err != nil {
+		return handleDeleteError("PimProfile", id, err)
+	}
+
+	return nil
+}
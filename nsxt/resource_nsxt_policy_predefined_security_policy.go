@@ -483,10 +483,6 @@ func securityPolicyInfraPatch(context utl.SessionContext, policy model.SecurityP
 	if err != nil {
 		return fmt.Errorf("Failed to create H-API for Predefined Security Policy: %s", err)
 	}
-	infraObj := model.Infra{
-		Children:     []*data.StructValue{childDomain},
-		ResourceType: strPtr("Infra"),
-	}
 
-	return policyInfraPatch(context, infraObj, connector, false)
+	return policyBulkInfraPatch(context, childDomain, m)
 }
@@ -0,0 +1,224 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t1_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/segments"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_t1_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/tier_1s/segments"
+)
+
+const policyStaticArpPathSuffix = "/static-arp"
+
+func resourceNsxtPolicySegmentStaticArp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicySegmentStaticArpCreate,
+		Read:   resourceNsxtPolicySegmentStaticArpRead,
+		Update: resourceNsxtPolicySegmentStaticArpUpdate,
+		Delete: resourceNsxtPolicySegmentStaticArpDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicySegmentStaticArpImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"segment_path": getPolicyPathSchema(true, true, "Policy path of fixed segment connected to Tier1 gateway"),
+			"display_name": getOptionalDisplayNameSchema(true),
+			"description":  getDescriptionSchema(),
+			"tag":          getTagsSchema(),
+			"path":         getPathSchema(),
+			"revision":     getRevisionSchema(),
+			"ip_address": {
+				Type:         schema.TypeString,
+				Description:  "IP address of static ARP entry",
+				Required:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"mac_address": {
+				Type:         schema.TypeString,
+				Description:  "MAC address of static ARP entry",
+				Required:     true,
+				ValidateFunc: validation.IsMACAddress,
+			},
+		},
+	}
+}
+
+// policySegmentStaticArpClient wraps static ARP API for both default and project scoped Tier1 segments
+type policySegmentStaticArpClient struct {
+	connector client.Connector
+	orgID     string
+	projectID string
+	tier1ID   string
+	segmentID string
+}
+
+func newPolicySegmentStaticArpClient(connector client.Connector, segmentPath string) (*policySegmentStaticArpClient, error) {
+	if !strings.Contains(segmentPath, "/tier-1s/") || !strings.Contains(segmentPath, "/segments/") {
+		return nil, fmt.Errorf("Static ARP is only supported on segments connected to Tier1 gateway, got segment path %s", segmentPath)
+	}
+	parents, err := parseStandardPolicyPath(segmentPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(parents) == 2 {
+		return &policySegmentStaticArpClient{connector: connector, tier1ID: parents[0], segmentID: parents[1]}, nil
+	}
+	if len(parents) == 4 {
+		return &policySegmentStaticArpClient{connector: connector, orgID: parents[0], projectID: parents[1], tier1ID: parents[2], segmentID: parents[3]}, nil
+	}
+	return nil, fmt.Errorf("Unexpected segment path %s", segmentPath)
+}
+
+func (c *policySegmentStaticArpClient) Get() (model.StaticARPConfig, error) {
+	if c.projectID != "" {
+		return project_t1_segments.NewStaticArpClient(c.connector).Get(c.orgID, c.projectID, c.tier1ID, c.segmentID)
+	}
+	return t1_segments.NewStaticArpClient(c.connector).Get(c.tier1ID, c.segmentID)
+}
+
+func (c *policySegmentStaticArpClient) Patch(obj model.StaticARPConfig) error {
+	if c.projectID != "" {
+		return project_t1_segments.NewStaticArpClient(c.connector).Patch(c.orgID, c.projectID, c.tier1ID, c.segmentID, obj)
+	}
+	return t1_segments.NewStaticArpClient(c.connector).Patch(c.tier1ID, c.segmentID, obj)
+}
+
+func (c *policySegmentStaticArpClient) Delete() error {
+	if c.projectID != "" {
+		return project_t1_segments.NewStaticArpClient(c.connector).Delete(c.orgID, c.projectID, c.tier1ID, c.segmentID)
+	}
+	return t1_segments.NewStaticArpClient(c.connector).Delete(c.tier1ID, c.segmentID)
+}
+
+func policySegmentStaticArpFromSchema(d *schema.ResourceData) model.StaticARPConfig {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	ipAddress := d.Get("ip_address").(string)
+	macAddress := d.Get("mac_address").(string)
+
+	obj := model.StaticARPConfig{
+		Description: &description,
+		Tags:        tags,
+		IpAddress:   &ipAddress,
+		MacAddress:  &macAddress,
+	}
+	if displayName != "" {
+		obj.DisplayName = &displayName
+	}
+
+	return obj
+}
+
+func resourceNsxtPolicySegmentStaticArpCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	segmentPath := d.Get("segment_path").(string)
+	client, err := newPolicySegmentStaticArpClient(getPolicyConnector(m), segmentPath)
+	if err != nil {
+		return err
+	}
+
+	id := segmentPath + policyStaticArpPathSuffix
+	_, err = client.Get()
+	if err == nil {
+		return fmt.Errorf("Static ARP config already exists on segment %s", segmentPath)
+	} else if !isNotFoundError(err) {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Static ARP config on segment %s", segmentPath)
+	err = client.Patch(policySegmentStaticArpFromSchema(d))
+	if err != nil {
+		return handleCreateError("Static ARP", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNsxtPolicySegmentStaticArpRead(d, m)
+}
+
+func resourceNsxtPolicySegmentStaticArpRead(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	segmentPath := d.Get("segment_path").(string)
+	if id == "" || segmentPath == "" {
+		return fmt.Errorf("Error obtaining Static ARP segment path")
+	}
+
+	client, err := newPolicySegmentStaticArpClient(getPolicyConnector(m), segmentPath)
+	if err != nil {
+		return err
+	}
+	obj, err := client.Get()
+	if err != nil {
+		return handleReadError(d, "Static ARP", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("ip_address", obj.IpAddress)
+	d.Set("mac_address", obj.MacAddress)
+
+	return nil
+}
+
+func resourceNsxtPolicySegmentStaticArpUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	segmentPath := d.Get("segment_path").(string)
+	client, err := newPolicySegmentStaticArpClient(getPolicyConnector(m), segmentPath)
+	if err != nil {
+		return err
+	}
+
+	obj := policySegmentStaticArpFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+	err = client.Patch(obj)
+	if err != nil {
+		return handleUpdateError("Static ARP", id, err)
+	}
+
+	return resourceNsxtPolicySegmentStaticArpRead(d, m)
+}
+
+func resourceNsxtPolicySegmentStaticArpDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	segmentPath := d.Get("segment_path").(string)
+	client, err := newPolicySegmentStaticArpClient(getPolicyConnector(m), segmentPath)
+	if err != nil {
+		return err
+	}
+
+	err = client.Delete()
+	if err != nil {
+		return handleDeleteError("Static ARP", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicySegmentStaticArpImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	if !isPolicyPath(importID) {
+		return nil, fmt.Errorf("Please provide policy path of the segment or its static ARP config as an input")
+	}
+
+	segmentPath := strings.TrimSuffix(importID, policyStaticArpPathSuffix)
+	d.Set("segment_path", segmentPath)
+	d.SetId(segmentPath + policyStaticArpPathSuffix)
+
+	return []*schema.ResourceData{d}, nil
+}
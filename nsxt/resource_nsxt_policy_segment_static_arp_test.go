@@ -0,0 +1,113 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestNewPolicySegmentStaticArpClient(t *testing.T) {
+	client, err := newPolicySegmentStaticArpClient(nil, "/infra/tier-1s/t1/segments/seg1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.tier1ID != "t1" || client.segmentID != "seg1" || client.projectID != "" {
+		t.Errorf("Unexpected client %v", client)
+	}
+
+	client, err = newPolicySegmentStaticArpClient(nil, "/orgs/default/projects/p1/infra/tier-1s/t1/segments/seg1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.orgID != "default" || client.projectID != "p1" || client.tier1ID != "t1" || client.segmentID != "seg1" {
+		t.Errorf("Unexpected client %v", client)
+	}
+
+	_, err = newPolicySegmentStaticArpClient(nil, "/infra/segments/seg1")
+	if err == nil {
+		t.Errorf("Expected error for segment not connected to Tier1")
+	}
+}
+
+func TestAccResourceNsxtPolicySegmentStaticArp_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_segment_static_arp.test"
+	tzName := getOverlayTransportZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySegmentStaticArpCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentStaticArpTemplate(tzName, name, "12.12.2.10", "00:50:56:aa:bb:01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "ip_address", "12.12.2.10"),
+					resource.TestCheckResourceAttr(testResourceName, "mac_address", "00:50:56:aa:bb:01"),
+					resource.TestCheckResourceAttrPair(testResourceName, "segment_path", "nsxt_policy_fixed_segment.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySegmentStaticArpTemplate(tzName, name, "12.12.2.11", "00:50:56:aa:bb:02"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "ip_address", "12.12.2.11"),
+					resource.TestCheckResourceAttr(testResourceName, "mac_address", "00:50:56:aa:bb:02"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentStaticArpCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_segment_static_arp" {
+			continue
+		}
+
+		client, err := newPolicySegmentStaticArpClient(connector, rs.Primary.Attributes["segment_path"])
+		if err != nil {
+			return err
+		}
+		_, err = client.Get()
+		if err == nil {
+			return fmt.Errorf("Static ARP %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySegmentStaticArpTemplate(tzName string, name string, ipAddress string, macAddress string) string {
+	return testAccNsxtPolicySegmentDeps(tzName, false) + fmt.Sprintf(`
+resource "nsxt_policy_fixed_segment" "test" {
+  display_name      = "%s"
+  connectivity_path = nsxt_policy_tier1_gateway.tier1ForSegments.path
+
+  subnet {
+     cidr = "12.12.2.1/24"
+  }
+}
+
+resource "nsxt_policy_segment_static_arp" "test" {
+  segment_path = nsxt_policy_fixed_segment.test.path
+  ip_address   = "%s"
+  mac_address  = "%s"
+}`, name, ipAddress, macAddress)
+}
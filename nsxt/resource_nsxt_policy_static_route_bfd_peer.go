@@ -6,6 +6,7 @@ package nsxt
 import (
 	"fmt"
 	"log"
+	"net"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -43,16 +44,16 @@ func resourceNsxtPolicyStaticRouteBfdPeer() *schema.Resource {
 			},
 			"peer_address": {
 				Type:         schema.TypeString,
-				Description:  "IPv4 Address of the peer",
+				Description:  "IPv4 or IPv6 Address of the peer",
 				Required:     true,
-				ValidateFunc: validation.IsIPv4Address,
+				ValidateFunc: validation.IsIPAddress,
 			},
 			"source_addresses": {
 				Type:        schema.TypeList,
 				Description: "Array of Tier0 external interface IP addresses",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.IsIPv4Address,
+					ValidateFunc: validation.IsIPAddress,
 				},
 				Optional: true,
 			},
@@ -80,6 +81,26 @@ func resourceNsxtPolicyStaticRouteBfdPeerExists(gwID string, id string, connecto
 	return false, logAPIError("Error retrieving resource", err)
 }
 
+// validatePolicyStaticRouteBfdPeerAddressFamily verifies source addresses belong to same address family as the peer
+func validatePolicyStaticRouteBfdPeerAddressFamily(peerAddress string, sourceAddresses []string) error {
+	peerIP := net.ParseIP(peerAddress)
+	if peerIP == nil {
+		return fmt.Errorf("invalid peer address %s", peerAddress)
+	}
+	isV4 := peerIP.To4() != nil
+	for _, address := range sourceAddresses {
+		sourceIP := net.ParseIP(address)
+		if sourceIP == nil {
+			return fmt.Errorf("invalid source address %s", address)
+		}
+		if (sourceIP.To4() != nil) != isV4 {
+			return fmt.Errorf("source address %s does not match address family of peer address %s", address, peerAddress)
+		}
+	}
+
+	return nil
+}
+
 func policyStaticRouteBfdPeerPatch(d *schema.ResourceData, m interface{}, gwID string, id string) error {
 	connector := getPolicyConnector(m)
 
@@ -90,6 +111,10 @@ func policyStaticRouteBfdPeerPatch(d *schema.ResourceData, m interface{}, gwID s
 	bfdProfilePath := d.Get("bfd_profile_path").(string)
 	peerAddress := d.Get("peer_address").(string)
 	sourceAddresses := getStringListFromSchemaList(d, "source_addresses")
+	err := validatePolicyStaticRouteBfdPeerAddressFamily(peerAddress, sourceAddresses)
+	if err != nil {
+		return err
+	}
 
 	obj := model.StaticRouteBfdPeer{
 		DisplayName:    &displayName,
@@ -122,7 +147,7 @@ func policyStaticRouteBfdPeerPatch(d *schema.ResourceData, m interface{}, gwID s
 func resourceNsxtPolicyStaticRouteBfdPeerExistsOnGateway(gwID string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
 
 	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
-		return resourceNsxtPolicyStaticRouteBfdPeerExists(id, gwID, connector, isGlobalManager)
+		return resourceNsxtPolicyStaticRouteBfdPeerExists(gwID, id, connector, isGlobalManager)
 	}
 }
 
@@ -106,6 +106,63 @@ func TestAccResourceNsxtPolicyStaticRouteBfdPeer_importBasic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyStaticRouteBfdPeer_ipv6(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_static_route_bfd_peer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccNSXVersion(t, "3.2.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyStaticRouteBfdPeerCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyStaticRouteBfdPeerIPv6Template(name, "2001:db8:12::4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyStaticRouteBfdPeerExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "peer_address", "2001:db8:12::4"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyStaticRouteBfdPeerIPv6Template(name, "2001:db8:12::5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyStaticRouteBfdPeerExists(name, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "peer_address", "2001:db8:12::5"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidatePolicyStaticRouteBfdPeerAddressFamily(t *testing.T) {
+	cases := map[string]struct {
+		peer    string
+		sources []string
+		result  bool
+	}{
+		"ipv4":           {peer: "10.12.2.4", sources: []string{"10.12.2.1"}, result: true},
+		"ipv6":           {peer: "2001:db8::4", sources: []string{"2001:db8::1", "2001:db8::2"}, result: true},
+		"noSources":      {peer: "2001:db8::4", sources: nil, result: true},
+		"ipv4PeerV6Src":  {peer: "10.12.2.4", sources: []string{"2001:db8::1"}, result: false},
+		"ipv6PeerV4Src":  {peer: "2001:db8::4", sources: []string{"2001:db8::1", "10.12.2.1"}, result: false},
+		"badPeerAddress": {peer: "10.12.2", sources: nil, result: false},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			err := validatePolicyStaticRouteBfdPeerAddressFamily(tc.peer, tc.sources)
+			if err != nil && tc.result {
+				t.Errorf("unexpected error for peer %s and sources %v: %v", tc.peer, tc.sources, err)
+			} else if err == nil && !tc.result {
+				t.Errorf("expected error for peer %s and sources %v", tc.peer, tc.sources)
+			}
+		})
+	}
+}
+
 func testAccNsxtPolicyStaticRouteBfdPeerExists(displayName string, resourceName string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 
@@ -203,3 +260,19 @@ resource "nsxt_policy_static_route_bfd_peer" "test" {
   peer_address = "%s"
 }`, accTestPolicyStaticRouteBfdPeerUpdateAttributes["display_name"], accTestPolicyStaticRouteBfdPeerUpdateAttributes["peer_address"])
 }
+
+func testAccNsxtPolicyStaticRouteBfdPeerIPv6Template(name string, peerAddress string) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier0WithEdgeClusterTemplate("test", false) + fmt.Sprintf(`
+data "nsxt_policy_bfd_profile" "test" {
+  display_name = "default"
+}
+
+resource "nsxt_policy_static_route_bfd_peer" "test" {
+  gateway_path     = nsxt_policy_tier0_gateway.test.path
+  bfd_profile_path = data.nsxt_policy_bfd_profile.test.path
+
+  display_name = "%s"
+  peer_address = "%s"
+}`, name, peerAddress)
+}
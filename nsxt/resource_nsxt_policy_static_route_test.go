@@ -70,6 +70,39 @@ func TestAccResourceNsxtPolicyStaticRoute_basicT0(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyStaticRoute_ipv6T0(t *testing.T) {
+	name := getAccTestResourceName()
+	network := "2001:db8:14::/64"
+	updateNetwork := "2001:db8:15::/64"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyStaticRouteCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyStaticRouteIPv6Tier0Template(name, network, "2001:db8:9::1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyStaticRouteExists(testAccResourcePolicyStaticRouteName),
+					resource.TestCheckResourceAttr(testAccResourcePolicyStaticRouteName, "network", network),
+					resource.TestCheckResourceAttr(testAccResourcePolicyStaticRouteName, "next_hop.#", "1"),
+					resource.TestCheckResourceAttr(testAccResourcePolicyStaticRouteName, "next_hop.0.ip_address", "2001:db8:9::1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyStaticRouteIPv6Tier0Template(name, updateNetwork, "2001:db8:9::2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyStaticRouteExists(testAccResourcePolicyStaticRouteName),
+					resource.TestCheckResourceAttr(testAccResourcePolicyStaticRouteName, "network", updateNetwork),
+					resource.TestCheckResourceAttr(testAccResourcePolicyStaticRouteName, "next_hop.0.ip_address", "2001:db8:9::2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyStaticRoute_basicT1(t *testing.T) {
 	testAccResourceNsxtPolicyStaticRouteBasicT1(t, false, func() {
 		testAccPreCheck(t)
@@ -302,6 +335,26 @@ resource "nsxt_policy_static_route" "test" {
 `, name, network)
 }
 
+func testAccNsxtPolicyStaticRouteIPv6Tier0Template(name string, network string, nextHop string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_tier0_gateway" "t0test" {
+  display_name = "terraform-t0-gw"
+  description  = "Acceptance Test"
+}
+
+resource "nsxt_policy_static_route" "test" {
+  display_name = "%s"
+  gateway_path = nsxt_policy_tier0_gateway.t0test.path
+  network      = "%s"
+
+  next_hop {
+    ip_address     = "%s"
+    admin_distance = 2
+  }
+}
+`, name, network, nextHop)
+}
+
 func testAccNsxtPolicyStaticRouteMultipleHopsTier0CreateTemplate(name string, network string) string {
 	return fmt.Sprintf(`
 resource "nsxt_policy_tier0_gateway" "t0test" {
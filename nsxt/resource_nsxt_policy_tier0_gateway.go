@@ -126,7 +126,7 @@ func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 			"locale_service":         getPolicyLocaleServiceSchema(false),
 			"bgp_config":             getPolicyTier0BGPConfigSchema(),
 			"vrf_config":             getPolicyVRFConfigSchema(),
-			"dhcp_config_path":       getPolicyPathSchema(false, false, "Policy path to DHCP server or relay configuration to use for this Tier0"),
+			"dhcp_config_path":       getPolicyGatewayDhcpConfigPathSchema("Tier0"),
 			"intersite_config":       getGatewayIntersiteConfigSchema(),
 			"redistribution_config":  getRedistributionConfigSchema(),
 			"rd_admin_address": {
@@ -734,7 +734,6 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 	vrfTransitSubnets := interfaceListToStringList(d.Get("vrf_transit_subnets").([]interface{}))
 	ipv6ProfilePaths := getIpv6ProfilePathsFromSchema(d)
 	vrfConfig := getPolicyVRFConfigFromSchema(d)
-	dhcpPath := d.Get("dhcp_config_path").(string)
 	rdAdminAddress := d.Get("rd_admin_address").(string)
 	rdAdminField := &rdAdminAddress
 	if rdAdminAddress == "" {
@@ -771,12 +770,7 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 		// This is update flow
 		t0Struct.Revision = &revision
 	}
-	if dhcpPath != "" {
-		dhcpPaths := []string{dhcpPath}
-		t0Struct.DhcpConfigPaths = dhcpPaths
-	} else {
-		t0Struct.DhcpConfigPaths = []string{}
-	}
+	t0Struct.DhcpConfigPaths = getPolicyGatewayDhcpConfigPathsFromSchema(d)
 
 	if isGlobalManager {
 		intersiteConfig := getPolicyGatewayIntersiteConfigFromSchema(d)
@@ -937,10 +931,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 		return vrfErr
 	}
 
-	dhcpPaths := obj.DhcpConfigPaths
-	if len(dhcpPaths) > 0 {
-		d.Set("dhcp_config_path", dhcpPaths[0])
-	}
+	setPolicyGatewayDhcpConfigPathsInSchema(d, obj.DhcpConfigPaths)
 	// Get the edge cluster Id or locale services
 	localeServices, err := listPolicyTier0GatewayLocaleServices(getSessionContext(d, m), connector, id)
 	if err != nil {
@@ -955,6 +946,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 	}
 	// map of nsx IDs that was provided in locale_services in intent
 	nsxIDMap := getAttrKeyMapFromSchemaSet(intentServices, "nsx_id")
+	multicastIntent := getLocaleServiceMulticastIntent(d)
 	if len(localeServices) > 0 {
 		for i, service := range localeServices {
 			if shouldSetLS {
@@ -972,6 +964,16 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 				if _, ok := nsxIDMap[*service.Id]; ok {
 					cfgMap["nsx_id"] = service.Id
 				}
+				if !isGlobalManager {
+					var intent map[string]interface{}
+					if service.EdgeClusterPath != nil {
+						intent = multicastIntent[*service.EdgeClusterPath]
+					}
+					err = setLocaleServiceMulticastInMap(connector, id, *service.Id, false, intent, cfgMap)
+					if err != nil {
+						return handleReadError(d, "Multicast Configuration for T0", id, err)
+					}
+				}
 				redistributionConfigs := getLocaleServiceRedistributionConfig(&localeServices[i])
 				if d.Get("redistribution_set").(bool) {
 					// redistribution_config is deprecated and should be
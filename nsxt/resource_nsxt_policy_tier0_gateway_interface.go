@@ -54,6 +54,7 @@ func resourceNsxtPolicyTier0GatewayInterface() *schema.Resource {
 			"subnets":                getGatewayInterfaceSubnetsSchema(),
 			"mtu":                    getMtuSchema(),
 			"ipv6_ndra_profile_path": getIPv6NDRAPathSchema(),
+			"ipv6_dad_profile_path":  getIPv6DadPathSchema(),
 			"type": {
 				Type:         schema.TypeString,
 				Description:  "Interface Type",
@@ -304,10 +305,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 	description := d.Get("description").(string)
 	tags := getPolicyTagsFromSchema(d)
 	interfaceSubnetList := getGatewayInterfaceSubnetList(d)
-	var ipv6ProfilePaths []string
-	if d.Get("ipv6_ndra_profile_path").(string) != "" {
-		ipv6ProfilePaths = append(ipv6ProfilePaths, d.Get("ipv6_ndra_profile_path").(string))
-	}
+	ipv6ProfilePaths := getIpv6ProfilePathsFromSchema(d)
 	mtu := int64(d.Get("mtu").(int))
 	edgePath := d.Get("edge_node_path").(string)
 	obj := model.Tier0Interface{
@@ -416,8 +414,9 @@ func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m inter
 	d.Set("type", obj.Type_)
 	d.Set("dhcp_relay_path", obj.DhcpRelayPath)
 
-	if obj.Ipv6ProfilePaths != nil {
-		d.Set("ipv6_ndra_profile_path", obj.Ipv6ProfilePaths[0]) // only one supported for now
+	err = setIpv6ProfilePathsInSchema(d, obj.Ipv6ProfilePaths)
+	if err != nil {
+		return fmt.Errorf("Failed to set ipv6 profiles for interface %s: %v", id, err)
 	}
 	if obj.Mtu != nil {
 		d.Set("mtu", *obj.Mtu)
@@ -479,10 +478,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m int
 	tags := getPolicyTagsFromSchema(d)
 	interfaceSubnetList := getGatewayInterfaceSubnetList(d)
 	segmentPath := d.Get("segment_path").(string)
-	var ipv6ProfilePaths []string
-	if d.Get("ipv6_ndra_profile_path").(string) != "" {
-		ipv6ProfilePaths = append(ipv6ProfilePaths, d.Get("ipv6_ndra_profile_path").(string))
-	}
+	ipv6ProfilePaths := getIpv6ProfilePathsFromSchema(d)
 	mtu := int64(d.Get("mtu").(int))
 	ifType := d.Get("type").(string)
 	edgePath := d.Get("edge_node_path").(string)
@@ -466,6 +466,41 @@ func TestAccResourceNsxtPolicyTier0GatewayInterface_withV6(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyTier0GatewayInterface_withDhcpv6Relay(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tier0_gateway_interface.test"
+	relayResourceName := "nsxt_policy_dhcp_relay.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0InterfaceCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0InterfaceTemplateWithDhcpv6Relay(name, "4003::100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0InterfaceExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "subnets.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "subnets.1", "4003::12/64"),
+					resource.TestCheckResourceAttrPair(testResourceName, "dhcp_relay_path", relayResourceName, "path"),
+					resource.TestCheckResourceAttr(relayResourceName, "server_addresses.#", "2"),
+					resource.TestCheckResourceAttr(relayResourceName, "server_addresses.1", "4003::100"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTier0InterfaceTemplateWithDhcpv6Relay(name, "4003::101"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0InterfaceExists(testResourceName),
+					resource.TestCheckResourceAttrPair(testResourceName, "dhcp_relay_path", relayResourceName, "path"),
+					resource.TestCheckResourceAttr(relayResourceName, "server_addresses.1", "4003::101"),
+				),
+			},
+		},
+	})
+}
+
 func testAccNSXPolicyTier0InterfaceImporterGetID(s *terraform.State) (string, error) {
 	testResourceName := "nsxt_policy_tier0_gateway_interface.test"
 	rs, ok := s.RootModule().Resources[testResourceName]
@@ -777,6 +812,30 @@ resource "nsxt_policy_tier0_gateway_interface" "test" {
 		testAccNsxtPolicyTier0InterfaceRealizationTemplate()
 }
 
+func testAccNsxtPolicyTier0InterfaceTemplateWithDhcpv6Relay(name string, serverAddress string) string {
+	return testAccNsxtPolicyGatewayInterfaceDeps("11", false) + fmt.Sprintf(`
+resource "nsxt_policy_dhcp_relay" "test" {
+  display_name     = "%s"
+  server_addresses = ["1.1.1.100", "%s"]
+}
+
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name      = "%s"
+  ha_mode           = "ACTIVE_STANDBY"
+  %s
+}
+
+resource "nsxt_policy_tier0_gateway_interface" "test" {
+  display_name    = "%s"
+  type            = "SERVICE"
+  gateway_path    = nsxt_policy_tier0_gateway.test.path
+  segment_path    = nsxt_policy_vlan_segment.test.path
+  subnets         = ["1.1.12.2/24", "4003::12/64"]
+  dhcp_relay_path = nsxt_policy_dhcp_relay.test.path
+}`, name, serverAddress, nsxtPolicyTier0GatewayName, testAccNsxtPolicyTier0EdgeClusterTemplate(), name) +
+		testAccNsxtPolicyTier0InterfaceRealizationTemplate()
+}
+
 func testAccNsxtPolicyTier0InterfaceOspfDeps() string {
 	return fmt.Sprintf(`
 resource "nsxt_policy_ospf_config" "test" {
@@ -117,6 +117,56 @@ func TestAccResourceNsxtPolicyTier0Gateway_localeService(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyTier0Gateway_multicast(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tier0_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.0.0")
+			testAccEnvDefined(t, "NSXT_TEST_ADVANCED_TOPOLOGY")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0CheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0MulticastTemplate(name, "profiles"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0Exists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "locale_service.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "locale_service.0.multicast.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "locale_service.0.multicast.0.replication_multicast_range", "233.1.0.0/16"),
+					resource.TestCheckResourceAttrPair(testResourceName, "locale_service.0.multicast.0.pim_profile_path", "nsxt_policy_pim_profile.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "locale_service.0.multicast.0.igmp_profile_path", "nsxt_policy_igmp_profile.test", "path"),
+				),
+			},
+			{
+				// NSX assigns default profiles when paths are not specified
+				Config: testAccNsxtPolicyTier0MulticastTemplate(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0Exists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "locale_service.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "locale_service.0.multicast.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "locale_service.0.multicast.0.igmp_profile_path", ""),
+					resource.TestCheckResourceAttr(testResourceName, "locale_service.0.multicast.0.pim_profile_path", ""),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTier0MulticastTemplate(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0Exists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "locale_service.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "locale_service.0.multicast.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyTier0Gateway_withId(t *testing.T) {
 	name := getAccTestResourceName()
 	id := "test-id"
@@ -767,6 +817,42 @@ resource "nsxt_policy_tier0_gateway" "test" {
 	return testAccAdjustPolicyInfraConfig(config)
 }
 
+// testAccNsxtPolicyTier0MulticastTemplate configures multicast with explicit profiles ("profiles"),
+// with profiles assigned by NSX ("default"), or no multicast ("")
+func testAccNsxtPolicyTier0MulticastTemplate(name string, multicastMode string) string {
+	multicast := ""
+	if multicastMode == "profiles" {
+		multicast = `
+    multicast {
+      igmp_profile_path           = nsxt_policy_igmp_profile.test.path
+      pim_profile_path            = nsxt_policy_pim_profile.test.path
+      replication_multicast_range = "233.1.0.0/16"
+    }`
+	} else if multicastMode == "default" {
+		multicast = `
+    multicast {}`
+	}
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) + fmt.Sprintf(`
+resource "nsxt_policy_pim_profile" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_igmp_profile" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name = "%s"
+  ha_mode      = "ACTIVE_STANDBY"
+
+  locale_service {
+    nsx_id            = "%s"
+    edge_cluster_path = data.nsxt_policy_edge_cluster.EC.path
+    %s
+  }
+}`, name, name, name, name, multicast)
+}
+
 func testAccNsxtPolicyTier0UpdateWithLocaleTemplate(name string) string {
 	config := testAccNsxtPolicyGatewayFabricDeps(true) + fmt.Sprintf(`
 data "nsxt_policy_edge_node" "node1" {
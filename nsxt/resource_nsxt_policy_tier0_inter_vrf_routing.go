@@ -37,45 +37,14 @@ func resourceNsxtPolicyTier0InterVRFRouting() *schema.Resource {
 			State: resourceNsxtPolicyTier0InterVRFRoutingImport,
 		},
 		Schema: map[string]*schema.Schema{
-			"nsx_id":       getNsxIDSchema(),
-			"path":         getPathSchema(),
-			"display_name": getDisplayNameSchema(),
-			"description":  getDescriptionSchema(),
-			"revision":     getRevisionSchema(),
-			"tag":          getTagsSchema(),
-			"gateway_path": getPolicyPathSchema(true, true, "Policy path for the Gateway"),
-			"bgp_route_leaking": {
-				Type:        schema.TypeList,
-				Description: "Import / export BGP routes",
-				Optional:    true,
-				MaxItems:    2,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"address_family": {
-							Type:         schema.TypeString,
-							Description:  "Address family type",
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice([]string{"IPV4", "IPV6"}, false),
-						},
-						"in_filter": {
-							Type:        schema.TypeList,
-							Description: "route map path for IN direction",
-							Optional:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-						},
-						"out_filter": {
-							Type:        schema.TypeList,
-							Description: "route map path for OUT direction",
-							Optional:    true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-						},
-					},
-				},
-			},
+			"nsx_id":            getNsxIDSchema(),
+			"path":              getPathSchema(),
+			"display_name":      getDisplayNameSchema(),
+			"description":       getDescriptionSchema(),
+			"revision":          getRevisionSchema(),
+			"tag":               getTagsSchema(),
+			"gateway_path":      getPolicyPathSchema(true, true, "Policy path for the Gateway"),
+			"bgp_route_leaking": getPolicyBgpRouteLeakingSchema(),
 			"static_route_advertisement": {
 				Type:        schema.TypeList,
 				Description: "Advertise subnet to target peers as static routes",
@@ -146,12 +115,43 @@ func resourceNsxtPolicyTier0InterVRFRouting() *schema.Resource {
 	}
 }
 
-func getPolicyInterVRFRoutingFromSchema(d *schema.ResourceData) model.PolicyInterVrfRoutingConfig {
-	displayName := d.Get("display_name").(string)
-	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+func getPolicyBgpRouteLeakingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Import / export BGP routes",
+		Optional:    true,
+		MaxItems:    2,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address_family": {
+					Type:         schema.TypeString,
+					Description:  "Address family type",
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"IPV4", "IPV6"}, false),
+				},
+				"in_filter": {
+					Type:        schema.TypeList,
+					Description: "route map path for IN direction",
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"out_filter": {
+					Type:        schema.TypeList,
+					Description: "route map path for OUT direction",
+					Optional:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyBgpRouteLeakingFromSchema(bpgRouteLeakingList interface{}) []model.BgpRouteLeaking {
 	var bgpRouteLeaking []model.BgpRouteLeaking
-	bpgRouteLeakingList := d.Get("bgp_route_leaking")
 	if bpgRouteLeakingList != nil {
 		for _, brl := range bpgRouteLeakingList.([]interface{}) {
 			brlMap := brl.(map[string]interface{})
@@ -172,6 +172,29 @@ func getPolicyInterVRFRoutingFromSchema(d *schema.ResourceData) model.PolicyInte
 		}
 	}
 
+	return bgpRouteLeaking
+}
+
+func getPolicyBgpRouteLeakingForSchema(bgpRouteLeaking []model.BgpRouteLeaking) []interface{} {
+	var brlList []interface{}
+	for _, brl := range bgpRouteLeaking {
+		brlMap := make(map[string]interface{})
+		brlMap["address_family"] = brl.AddressFamily
+		brlMap["in_filter"] = stringList2Interface(brl.InFilter)
+		brlMap["out_filter"] = stringList2Interface(brl.OutFilter)
+
+		brlList = append(brlList, brlMap)
+	}
+
+	return brlList
+}
+
+func getPolicyInterVRFRoutingFromSchema(d *schema.ResourceData) model.PolicyInterVrfRoutingConfig {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	bgpRouteLeaking := getPolicyBgpRouteLeakingFromSchema(d.Get("bgp_route_leaking"))
+
 	var staticRouteAdvertisement *model.PolicyStaticRouteAdvertisement
 	staticRouteAdvert := d.Get("static_route_advertisement")
 	if staticRouteAdvert != nil {
@@ -293,16 +316,7 @@ func resourceNsxtPolicyTier0InterVRFRoutingRead(d *schema.ResourceData, m interf
 	d.Set("revision", obj.Revision)
 	setPolicyTagsInSchema(d, obj.Tags)
 
-	var brlList []interface{}
-	for _, brl := range obj.BgpRouteLeaking {
-		brlMap := make(map[string]interface{})
-		brlMap["address_family"] = brl.AddressFamily
-		brlMap["in_filter"] = stringList2Interface(brl.InFilter)
-		brlMap["out_filter"] = stringList2Interface(brl.OutFilter)
-
-		brlList = append(brlList, brlMap)
-	}
-	d.Set("bgp_route_leaking", brlList)
+	d.Set("bgp_route_leaking", getPolicyBgpRouteLeakingForSchema(obj.BgpRouteLeaking))
 
 	var sraList []interface{}
 	if obj.StaticRouteAdvertisement != nil {
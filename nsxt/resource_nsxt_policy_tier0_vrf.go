@@ -0,0 +1,797 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyTier0VrfInterfaceTypeValues = []string{
+	model.Tier0Interface_TYPE_SERVICE,
+	model.Tier0Interface_TYPE_EXTERNAL,
+}
+
+func resourceNsxtPolicyTier0Vrf() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyTier0VrfCreate,
+		Read:   resourceNsxtPolicyTier0VrfRead,
+		Update: resourceNsxtPolicyTier0VrfUpdate,
+		Delete: resourceNsxtPolicyTier0VrfDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":              getNsxIDSchema(),
+			"path":                getPathSchema(),
+			"display_name":        getDisplayNameSchema(),
+			"description":         getDescriptionSchema(),
+			"revision":            getRevisionSchema(),
+			"tag":                 getTagsSchema(),
+			"parent_gateway_path": getPolicyPathSchema(true, true, "Policy path of the parent Tier0 gateway"),
+			"edge_cluster_path":   getPolicyPathSchema(false, false, "Policy path of the edge cluster for the VRF locale service"),
+			"dhcp_config_path":    getPolicyGatewayDhcpConfigPathSchema("VRF gateway"),
+			"evpn_transit_vni": {
+				Type:        schema.TypeInt,
+				Description: "L3 VNI associated with the VRF for overlay traffic. VNI must be unique and belong to configured VNI pool",
+				Optional:    true,
+			},
+			"route_distinguisher": getVRFRouteSchema(),
+			"route_target": {
+				Type:        schema.TypeList,
+				Description: "EVPN route targets",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_family": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      policyVRFRouteValues[0],
+							ValidateFunc: validation.StringInSlice(policyVRFRouteValues, false),
+						},
+						"import_targets": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     getVRFRouteElemSchema(),
+						},
+						"export_targets": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     getVRFRouteElemSchema(),
+						},
+					},
+				},
+			},
+			"bgp_config": {
+				Type:        schema.TypeList,
+				Description: "BGP routing configuration for the VRF",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Flag to enable BGP configuration",
+							Optional:    true,
+							Default:     true,
+						},
+						"ecmp": {
+							Type:        schema.TypeBool,
+							Description: "Flag to enable ECMP",
+							Optional:    true,
+							Default:     true,
+						},
+						"local_as_num": {
+							Type:         schema.TypeString,
+							Description:  "BGP AS number in ASPLAIN/ASDOT Format. When not set, the AS number of the parent gateway is used",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateASPlainOrDot,
+						},
+						"route_aggregation": {
+							Type:        schema.TypeList,
+							Description: "List of routes to be aggregated",
+							Optional:    true,
+							MaxItems:    1000,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix": {
+										Type:         schema.TypeString,
+										Description:  "CIDR of aggregate address",
+										Optional:     true,
+										ValidateFunc: validateCidr(),
+									},
+									"summary_only": {
+										Type:        schema.TypeBool,
+										Description: "Send only summarized route",
+										Optional:    true,
+										Default:     true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"interface": {
+				Type:        schema.TypeList,
+				Description: "VRF interfaces, typically on trunk segments with an access VLAN. This list is authoritative for the VRF",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nsx_id": {
+							Type:        schema.TypeString,
+							Description: "NSX ID of the interface, generated when not specified",
+							Optional:    true,
+							Computed:    true,
+						},
+						"path":           getPathSchema(),
+						"display_name":   getOptionalDisplayNameSchema(true),
+						"description":    getDescriptionSchema(),
+						"segment_path":   getPolicyPathSchema(true, false, "Policy path for connected segment"),
+						"edge_node_path": getPolicyPathSchema(false, false, "Policy path for edge node"),
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "Interface Type",
+							ValidateFunc: validation.StringInSlice(policyTier0VrfInterfaceTypeValues, false),
+							Optional:     true,
+							Default:      model.Tier0Interface_TYPE_EXTERNAL,
+						},
+						"subnets": {
+							Type:        schema.TypeList,
+							Description: "IP addresses and subnets assigned to the interface",
+							Required:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateIPCidr(),
+							},
+						},
+						"access_vlan_id": {
+							Type:         schema.TypeInt,
+							Description:  "Vlan ID on the trunk segment",
+							Optional:     true,
+							ValidateFunc: validateVLANId,
+						},
+						"mtu": getMtuSchema(),
+					},
+				},
+			},
+			"inter_vrf_routing": {
+				Type:        schema.TypeList,
+				Description: "Route leaking between this VRF and other VRFs or the parent gateway",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nsx_id": {
+							Type:        schema.TypeString,
+							Description: "NSX ID of the inter VRF routing configuration, generated when not specified",
+							Optional:    true,
+							Computed:    true,
+						},
+						"path":              getPathSchema(),
+						"target_path":       getPolicyPathSchema(true, false, "Policy path to tier0/vrf belongs to the same parent tier0"),
+						"bgp_route_leaking": getPolicyBgpRouteLeakingSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyTier0VrfConfigFromSchema(d *schema.ResourceData) *model.Tier0VrfConfig {
+	parentPath := d.Get("parent_gateway_path").(string)
+	vni := int64(d.Get("evpn_transit_vni").(int))
+	routeDist := d.Get("route_distinguisher").(string)
+
+	config := model.Tier0VrfConfig{
+		Tier0Path: &parentPath,
+	}
+
+	if len(routeDist) > 0 {
+		config.RouteDistinguisher = &routeDist
+	}
+
+	if vni > 0 {
+		config.EvpnTransitVni = &vni
+	}
+
+	routeTargets := d.Get("route_target").([]interface{})
+	for _, target := range routeTargets {
+		routeTarget := target.(map[string]interface{})
+		addressFamily := routeTarget["address_family"].(string)
+		exportTargets := interface2StringList(routeTarget["export_targets"].([]interface{}))
+		importTargets := interface2StringList(routeTarget["import_targets"].([]interface{}))
+		config.RouteTargets = append(config.RouteTargets, model.VrfRouteTargets{
+			AddressFamily:      &addressFamily,
+			ExportRouteTargets: exportTargets,
+			ImportRouteTargets: importTargets,
+		})
+	}
+
+	return &config
+}
+
+func getPolicyTier0VrfBgpConfigFromSchema(d *schema.ResourceData) *model.BgpRoutingConfig {
+	bgpConfigs := d.Get("bgp_config").([]interface{})
+	if len(bgpConfigs) == 0 || bgpConfigs[0] == nil {
+		return nil
+	}
+
+	cfgMap := bgpConfigs[0].(map[string]interface{})
+	enabled := cfgMap["enabled"].(bool)
+	ecmp := cfgMap["ecmp"].(bool)
+	localAsNum := cfgMap["local_as_num"].(string)
+
+	var aggregationStructs []model.RouteAggregationEntry
+	for _, agg := range cfgMap["route_aggregation"].([]interface{}) {
+		data := agg.(map[string]interface{})
+		prefix := data["prefix"].(string)
+		summary := data["summary_only"].(bool)
+		aggregationStructs = append(aggregationStructs, model.RouteAggregationEntry{
+			Prefix:      &prefix,
+			SummaryOnly: &summary,
+		})
+	}
+
+	id := "bgp"
+	bgpcType := "BgpRoutingConfig"
+	config := model.BgpRoutingConfig{
+		Id:                &id,
+		ResourceType:      &bgpcType,
+		Enabled:           &enabled,
+		Ecmp:              &ecmp,
+		RouteAggregations: aggregationStructs,
+	}
+
+	if len(localAsNum) > 0 {
+		config.LocalAsNum = &localAsNum
+	}
+
+	return &config
+}
+
+// getPolicyTier0VrfBgpConfigForPatch returns BGP config to be applied on the VRF. BGP config can not
+// be deleted from locale service, hence removal of bgp_config block disables BGP on the VRF.
+func getPolicyTier0VrfBgpConfigForPatch(d *schema.ResourceData, isUpdate bool) *model.BgpRoutingConfig {
+	bgpConfig := getPolicyTier0VrfBgpConfigFromSchema(d)
+	if bgpConfig != nil || !isUpdate {
+		return bgpConfig
+	}
+
+	oldBgpConfigs, _ := d.GetChange("bgp_config")
+	if len(oldBgpConfigs.([]interface{})) == 0 {
+		return nil
+	}
+
+	id := "bgp"
+	bgpcType := "BgpRoutingConfig"
+	enabled := false
+	return &model.BgpRoutingConfig{
+		Id:           &id,
+		ResourceType: &bgpcType,
+		Enabled:      &enabled,
+	}
+}
+
+func getPolicyTier0VrfInterfaceFromSchema(ifMap map[string]interface{}) model.Tier0Interface {
+	id := ifMap["nsx_id"].(string)
+	displayName := ifMap["display_name"].(string)
+	if displayName == "" {
+		displayName = id
+	}
+	description := ifMap["description"].(string)
+	segmentPath := ifMap["segment_path"].(string)
+	edgePath := ifMap["edge_node_path"].(string)
+	ifType := ifMap["type"].(string)
+	vlanID := int64(ifMap["access_vlan_id"].(int))
+	mtu := int64(ifMap["mtu"].(int))
+
+	var subnetList []model.InterfaceSubnet
+	for _, subnet := range interface2StringList(ifMap["subnets"].([]interface{})) {
+		result := strings.Split(subnet, "/")
+		prefix, _ := strconv.Atoi(result[1])
+		prefix64 := int64(prefix)
+		subnetList = append(subnetList, model.InterfaceSubnet{
+			IpAddresses: []string{result[0]},
+			PrefixLen:   &prefix64,
+		})
+	}
+
+	resourceType := "Tier0Interface"
+	obj := model.Tier0Interface{
+		Id:           &id,
+		ResourceType: &resourceType,
+		DisplayName:  &displayName,
+		Description:  &description,
+		SegmentPath:  &segmentPath,
+		Type_:        &ifType,
+		Subnets:      subnetList,
+	}
+
+	if edgePath != "" {
+		obj.EdgePath = &edgePath
+	}
+
+	if vlanID > 0 {
+		obj.AccessVlanId = &vlanID
+	}
+
+	if mtu > 0 {
+		obj.Mtu = &mtu
+	}
+
+	return obj
+}
+
+func getPolicyTier0VrfInterVrfRoutingFromSchema(routingMap map[string]interface{}) model.PolicyInterVrfRoutingConfig {
+	id := routingMap["nsx_id"].(string)
+	targetPath := routingMap["target_path"].(string)
+	resourceType := "PolicyInterVrfRoutingConfig"
+
+	return model.PolicyInterVrfRoutingConfig{
+		Id:              &id,
+		ResourceType:    &resourceType,
+		DisplayName:     &id,
+		TargetPath:      &targetPath,
+		BgpRouteLeaking: getPolicyBgpRouteLeakingFromSchema(routingMap["bgp_route_leaking"]),
+	}
+}
+
+func policyTier0VrfResourceToInfraStruct(d *schema.ResourceData, id string, isUpdate bool) (model.Infra, error) {
+	var gwChildren, lsChildren []*data.StructValue
+	converter := bindings.NewTypeConverter()
+	boolTrue := true
+
+	oldInterfaces, _ := d.GetChange("interface")
+	oldRoutings, _ := d.GetChange("inter_vrf_routing")
+
+	interfaces, err := assignPolicyNestedObjectIDs(d, "interface")
+	if err != nil {
+		return model.Infra{}, err
+	}
+	routings, err := assignPolicyNestedObjectIDs(d, "inter_vrf_routing")
+	if err != nil {
+		return model.Infra{}, err
+	}
+
+	bgpConfig := getPolicyTier0VrfBgpConfigForPatch(d, isUpdate)
+	if bgpConfig != nil {
+		dataValue, err := initPolicyTier0ChildBgpConfig(bgpConfig)
+		if err != nil {
+			return model.Infra{}, err
+		}
+		lsChildren = append(lsChildren, dataValue)
+	}
+
+	for _, intf := range interfaces {
+		obj := getPolicyTier0VrfInterfaceFromSchema(intf.(map[string]interface{}))
+		childInterface := model.ChildTier0Interface{
+			ResourceType:   "ChildTier0Interface",
+			Tier0Interface: &obj,
+		}
+		dataValue, errors := converter.ConvertToVapi(childInterface, model.ChildTier0InterfaceBindingType())
+		if errors != nil {
+			return model.Infra{}, fmt.Errorf("Error converting child Tier0 Interface: %v", errors[0])
+		}
+		lsChildren = append(lsChildren, dataValue.(*data.StructValue))
+	}
+
+	if isUpdate {
+		removedIDs := getPolicyNestedObjectRemovedIDs(getPolicyNestedObjectIDs(oldInterfaces.([]interface{})), getPolicyNestedObjectIDs(interfaces))
+		for _, removedID := range removedIDs {
+			ifID := removedID
+			resourceType := "Tier0Interface"
+			childInterface := model.ChildTier0Interface{
+				ResourceType:    "ChildTier0Interface",
+				MarkedForDelete: &boolTrue,
+				Tier0Interface: &model.Tier0Interface{
+					Id:           &ifID,
+					ResourceType: &resourceType,
+				},
+			}
+			dataValue, errors := converter.ConvertToVapi(childInterface, model.ChildTier0InterfaceBindingType())
+			if errors != nil {
+				return model.Infra{}, fmt.Errorf("Error converting child Tier0 Interface: %v", errors[0])
+			}
+			lsChildren = append(lsChildren, dataValue.(*data.StructValue))
+		}
+	}
+
+	lsType := "LocaleServices"
+	serviceStruct := model.LocaleServices{
+		Id:           &defaultPolicyLocaleServiceID,
+		ResourceType: &lsType,
+		Children:     lsChildren,
+	}
+	edgeClusterPath := d.Get("edge_cluster_path").(string)
+	if edgeClusterPath != "" {
+		serviceStruct.EdgeClusterPath = &edgeClusterPath
+	}
+	dataValue, err := initChildLocaleService(&serviceStruct, false)
+	if err != nil {
+		return model.Infra{}, err
+	}
+	gwChildren = append(gwChildren, dataValue)
+
+	for _, routing := range routings {
+		obj := getPolicyTier0VrfInterVrfRoutingFromSchema(routing.(map[string]interface{}))
+		childRouting := model.ChildPolicyInterVrfRoutingConfig{
+			ResourceType:                "ChildPolicyInterVrfRoutingConfig",
+			PolicyInterVrfRoutingConfig: &obj,
+		}
+		dataValue, errors := converter.ConvertToVapi(childRouting, model.ChildPolicyInterVrfRoutingConfigBindingType())
+		if errors != nil {
+			return model.Infra{}, fmt.Errorf("Error converting child Inter VRF Routing: %v", errors[0])
+		}
+		gwChildren = append(gwChildren, dataValue.(*data.StructValue))
+	}
+
+	if isUpdate {
+		removedIDs := getPolicyNestedObjectRemovedIDs(getPolicyNestedObjectIDs(oldRoutings.([]interface{})), getPolicyNestedObjectIDs(routings))
+		for _, removedID := range removedIDs {
+			routingID := removedID
+			resourceType := "PolicyInterVrfRoutingConfig"
+			childRouting := model.ChildPolicyInterVrfRoutingConfig{
+				ResourceType:    "ChildPolicyInterVrfRoutingConfig",
+				MarkedForDelete: &boolTrue,
+				PolicyInterVrfRoutingConfig: &model.PolicyInterVrfRoutingConfig{
+					Id:           &routingID,
+					ResourceType: &resourceType,
+				},
+			}
+			dataValue, errors := converter.ConvertToVapi(childRouting, model.ChildPolicyInterVrfRoutingConfigBindingType())
+			if errors != nil {
+				return model.Infra{}, fmt.Errorf("Error converting child Inter VRF Routing: %v", errors[0])
+			}
+			gwChildren = append(gwChildren, dataValue.(*data.StructValue))
+		}
+	}
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	t0Type := "Tier0"
+	t0Struct := model.Tier0{
+		Id:           &id,
+		ResourceType: &t0Type,
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		VrfConfig:    getPolicyTier0VrfConfigFromSchema(d),
+		Children:     gwChildren,
+	}
+	t0Struct.DhcpConfigPaths = getPolicyGatewayDhcpConfigPathsFromSchema(d)
+
+	if isUpdate {
+		revision := int64(d.Get("revision").(int))
+		t0Struct.Revision = &revision
+	}
+
+	childTier0 := model.ChildTier0{
+		Tier0:        &t0Struct,
+		ResourceType: "ChildTier0",
+	}
+	t0Value, errors := converter.ConvertToVapi(childTier0, model.ChildTier0BindingType())
+	if errors != nil {
+		return model.Infra{}, fmt.Errorf("Error converting Tier0 Child: %v", errors[0])
+	}
+
+	infraType := "Infra"
+	return model.Infra{
+		Children:     []*data.StructValue{t0Value.(*data.StructValue)},
+		ResourceType: &infraType,
+	}, nil
+}
+
+func validatePolicyTier0VrfConfig(d *schema.ResourceData) error {
+	parentPath := d.Get("parent_gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(parentPath)
+	if gwID == "" || !isT0 {
+		return fmt.Errorf("Tier0 gateway path expected for parent_gateway_path, got %s", parentPath)
+	}
+
+	if len(d.Get("inter_vrf_routing").([]interface{})) > 0 && util.NsxVersionLower("4.1.0") {
+		return fmt.Errorf("inter_vrf_routing requires NSX version 4.1.0 or higher")
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyTier0VrfCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	err := validatePolicyTier0VrfConfig(d)
+	if err != nil {
+		return err
+	}
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTier0GatewayExists)
+	if err != nil {
+		return err
+	}
+
+	// VRF gateway, its locale service with BGP and interfaces, and inter VRF routing
+	// are created in a single hierarchical call, which ensures correct ordering on NSX side
+	obj, err := policyTier0VrfResourceToInfraStruct(d, id, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Using H-API to create VRF Tier0 with ID %s", id)
+	err = policyInfraPatch(getSessionContext(d, m), obj, getPolicyConnector(m), false)
+	if err != nil {
+		return handleCreateError("VRF Tier0", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTier0VrfRead(d, m)
+}
+
+func setPolicyTier0VrfConfigInSchema(d *schema.ResourceData, config *model.Tier0VrfConfig) {
+	d.Set("parent_gateway_path", config.Tier0Path)
+	d.Set("route_distinguisher", config.RouteDistinguisher)
+	d.Set("evpn_transit_vni", config.EvpnTransitVni)
+
+	var routeTargets []interface{}
+	for _, target := range config.RouteTargets {
+		routeTarget := make(map[string]interface{})
+		routeTarget["address_family"] = target.AddressFamily
+		routeTarget["import_targets"] = stringList2Interface(target.ImportRouteTargets)
+		routeTarget["export_targets"] = stringList2Interface(target.ExportRouteTargets)
+		routeTargets = append(routeTargets, routeTarget)
+	}
+	d.Set("route_target", routeTargets)
+}
+
+func setPolicyTier0VrfInterfacesInSchema(d *schema.ResourceData, gwID string, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := locale_services.NewInterfacesClient(connector)
+
+	var interfaces []model.Tier0Interface
+	var cursor *string
+	for {
+		listResult, err := client.List(gwID, defaultPolicyLocaleServiceID, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			if isNotFoundError(err) {
+				break
+			}
+			return err
+		}
+		interfaces = append(interfaces, listResult.Results...)
+		cursor = listResult.Cursor
+		if cursor == nil || len(*cursor) == 0 {
+			break
+		}
+	}
+
+	interfaceMap := make(map[string]model.Tier0Interface)
+	var actualIDs []string
+	for _, obj := range interfaces {
+		interfaceMap[*obj.Id] = obj
+		actualIDs = append(actualIDs, *obj.Id)
+	}
+
+	var interfaceList []interface{}
+	knownIDs := getPolicyNestedObjectIDs(d.Get("interface").([]interface{}))
+	for _, id := range orderPolicyNestedObjectIDs(knownIDs, actualIDs) {
+		obj := interfaceMap[id]
+		elem := make(map[string]interface{})
+		elem["nsx_id"] = obj.Id
+		elem["path"] = obj.Path
+		elem["display_name"] = obj.DisplayName
+		elem["description"] = obj.Description
+		elem["segment_path"] = obj.SegmentPath
+		elem["edge_node_path"] = obj.EdgePath
+		elem["type"] = obj.Type_
+		elem["access_vlan_id"] = obj.AccessVlanId
+		elem["mtu"] = obj.Mtu
+
+		var subnetList []string
+		for _, subnet := range obj.Subnets {
+			subnetList = append(subnetList, fmt.Sprintf("%s/%d", subnet.IpAddresses[0], *subnet.PrefixLen))
+		}
+		elem["subnets"] = subnetList
+
+		interfaceList = append(interfaceList, elem)
+	}
+
+	return d.Set("interface", interfaceList)
+}
+
+func setPolicyTier0VrfInterVrfRoutingInSchema(d *schema.ResourceData, gwID string, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := tier_0s.NewInterVrfRoutingClient(connector)
+
+	var routings []model.PolicyInterVrfRoutingConfig
+	var cursor *string
+	for {
+		listResult, err := client.List(gwID, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			if isNotFoundError(err) {
+				break
+			}
+			return err
+		}
+		routings = append(routings, listResult.Results...)
+		cursor = listResult.Cursor
+		if cursor == nil || len(*cursor) == 0 {
+			break
+		}
+	}
+
+	routingMap := make(map[string]model.PolicyInterVrfRoutingConfig)
+	var actualIDs []string
+	for _, obj := range routings {
+		routingMap[*obj.Id] = obj
+		actualIDs = append(actualIDs, *obj.Id)
+	}
+
+	var routingList []interface{}
+	knownIDs := getPolicyNestedObjectIDs(d.Get("inter_vrf_routing").([]interface{}))
+	for _, id := range orderPolicyNestedObjectIDs(knownIDs, actualIDs) {
+		obj := routingMap[id]
+		elem := make(map[string]interface{})
+		elem["nsx_id"] = obj.Id
+		elem["path"] = obj.Path
+		elem["target_path"] = obj.TargetPath
+		elem["bgp_route_leaking"] = getPolicyBgpRouteLeakingForSchema(obj.BgpRouteLeaking)
+
+		routingList = append(routingList, elem)
+	}
+
+	return d.Set("inter_vrf_routing", routingList)
+}
+
+func resourceNsxtPolicyTier0VrfRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VRF Tier0 ID")
+	}
+
+	client := infra.NewTier0sClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "VRF Tier0", id, err)
+	}
+
+	if obj.VrfConfig == nil {
+		return fmt.Errorf("Tier0 %s is not a VRF gateway", id)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	setPolicyTier0VrfConfigInSchema(d, obj.VrfConfig)
+	setPolicyGatewayDhcpConfigPathsInSchema(d, obj.DhcpConfigPaths)
+
+	lsClient := tier_0s.NewLocaleServicesClient(connector)
+	localeService, err := lsClient.Get(id, defaultPolicyLocaleServiceID)
+	if err != nil && !isNotFoundError(err) {
+		return handleReadError(d, "VRF Tier0 Locale Service", id, err)
+	}
+	if err == nil {
+		d.Set("edge_cluster_path", localeService.EdgeClusterPath)
+	}
+
+	var bgpConfigs []interface{}
+	bgpClient := locale_services.NewBgpClient(connector)
+	bgpConfig, err := bgpClient.Get(id, defaultPolicyLocaleServiceID)
+	if err != nil && !isNotFoundError(err) {
+		return handleReadError(d, "VRF Tier0 BGP Config", id, err)
+	}
+	// BGP config always exists on locale service once created, hence disabled
+	// BGP is only reflected in state when configured on the resource
+	bgpEnabled := err == nil && bgpConfig.Enabled != nil && *bgpConfig.Enabled
+	if err == nil && (bgpEnabled || len(d.Get("bgp_config").([]interface{})) > 0) {
+		elem := make(map[string]interface{})
+		elem["enabled"] = bgpConfig.Enabled
+		elem["ecmp"] = bgpConfig.Ecmp
+		elem["local_as_num"] = bgpConfig.LocalAsNum
+		var aggregations []interface{}
+		for _, agg := range bgpConfig.RouteAggregations {
+			aggregation := make(map[string]interface{})
+			aggregation["prefix"] = agg.Prefix
+			aggregation["summary_only"] = agg.SummaryOnly
+			aggregations = append(aggregations, aggregation)
+		}
+		elem["route_aggregation"] = aggregations
+		bgpConfigs = append(bgpConfigs, elem)
+	}
+	d.Set("bgp_config", bgpConfigs)
+
+	err = setPolicyTier0VrfInterfacesInSchema(d, id, m)
+	if err != nil {
+		return handleReadError(d, "VRF Tier0 Interfaces", id, err)
+	}
+
+	// Inter VRF routing API is only available with NSX 4.1.0 onwards
+	if util.NsxVersionHigherOrEqual("4.1.0") {
+		err = setPolicyTier0VrfInterVrfRoutingInSchema(d, id, m)
+		if err != nil {
+			return fmt.Errorf("Error reading VRF Tier0 %s Inter VRF Routing: %v", id, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyTier0VrfUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VRF Tier0 ID")
+	}
+
+	err := validatePolicyTier0VrfConfig(d)
+	if err != nil {
+		return err
+	}
+
+	obj, err := policyTier0VrfResourceToInfraStruct(d, id, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Using H-API to update VRF Tier0 with ID %s", id)
+	err = policyInfraPatch(getSessionContext(d, m), obj, getPolicyConnector(m), true)
+	if err != nil {
+		return handleUpdateError("VRF Tier0", id, err)
+	}
+
+	return resourceNsxtPolicyTier0VrfRead(d, m)
+}
+
+func resourceNsxtPolicyTier0VrfDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VRF Tier0 ID")
+	}
+
+	connector := getPolicyConnector(m)
+
+	// Tear down in reverse dependency order: route leaking first, then interfaces,
+	// and finally the VRF gateway together with its locale service and BGP config
+	routingClient := tier_0s.NewInterVrfRoutingClient(connector)
+	for _, routingID := range getPolicyNestedObjectIDs(d.Get("inter_vrf_routing").([]interface{})) {
+		log.Printf("[DEBUG] Deleting inter VRF routing %s on VRF Tier0 %s", routingID, id)
+		err := routingClient.Delete(id, routingID)
+		if err != nil {
+			return handleDeleteError("Inter VRF Routing", routingID, err)
+		}
+	}
+
+	interfaceClient := locale_services.NewInterfacesClient(connector)
+	for _, ifID := range getPolicyNestedObjectIDs(d.Get("interface").([]interface{})) {
+		log.Printf("[DEBUG] Deleting interface %s on VRF Tier0 %s", ifID, id)
+		err := interfaceClient.Delete(id, defaultPolicyLocaleServiceID, ifID, nil)
+		if err != nil {
+			return handleDeleteError("VRF Tier0 Interface", ifID, err)
+		}
+	}
+
+	return resourceNsxtPolicyTier0GatewayDelete(d, m)
+}
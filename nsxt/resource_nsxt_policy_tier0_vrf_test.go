@@ -0,0 +1,238 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+)
+
+func TestAccResourceNsxtPolicyTier0Vrf_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tier0_vrf.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "4.1.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0VrfCheckDestroy(state, updateName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0VrfTemplate(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0Exists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrSet(testResourceName, "parent_gateway_path"),
+					resource.TestCheckResourceAttr(testResourceName, "route_target.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "route_target.0.import_targets.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "route_target.0.export_targets.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_config.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_config.0.route_aggregation.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "interface.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "interface.0.nsx_id", "vrf-uplink1"),
+					resource.TestCheckResourceAttr(testResourceName, "interface.0.access_vlan_id", "11"),
+					resource.TestCheckResourceAttrSet(testResourceName, "interface.1.nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "interface.1.path"),
+					resource.TestCheckResourceAttr(testResourceName, "inter_vrf_routing.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "inter_vrf_routing.0.target_path"),
+					resource.TestCheckResourceAttr(testResourceName, "inter_vrf_routing.0.bgp_route_leaking.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTier0VrfTemplate(updateName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0Exists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "route_target.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "interface.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "interface.0.nsx_id", "vrf-uplink1"),
+					resource.TestCheckResourceAttr(testResourceName, "inter_vrf_routing.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_config.#", "0"),
+					testAccNsxtPolicyTier0VrfBgpDisabled(testResourceName),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTier0Vrf_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tier0_vrf.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "4.1.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0VrfCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0VrfTemplate(name, false),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bgp_config"},
+			},
+		},
+	})
+}
+
+func TestGetPolicyTier0VrfBgpConfigForPatch(t *testing.T) {
+	r := resourceNsxtPolicyTier0Vrf()
+	state := &terraform.InstanceState{
+		ID: "vrf1",
+		Attributes: map[string]string{
+			"bgp_config.#":                     "1",
+			"bgp_config.0.enabled":             "true",
+			"bgp_config.0.ecmp":                "true",
+			"bgp_config.0.route_aggregation.#": "0",
+		},
+	}
+
+	d := r.Data(state)
+	if cfg := getPolicyTier0VrfBgpConfigForPatch(d, true); cfg == nil || !*cfg.Enabled {
+		t.Errorf("Expected configured BGP to be enabled, got %v", cfg)
+	}
+
+	// bgp_config block removed from configuration
+	if err := d.Set("bgp_config", nil); err != nil {
+		t.Fatal(err)
+	}
+	cfg := getPolicyTier0VrfBgpConfigForPatch(d, true)
+	if cfg == nil || *cfg.Enabled || *cfg.Id != "bgp" {
+		t.Errorf("Expected BGP to be disabled, got %v", cfg)
+	}
+	if cfg := getPolicyTier0VrfBgpConfigForPatch(d, false); cfg != nil {
+		t.Errorf("Expected no BGP config on create, got %v", cfg)
+	}
+
+	// bgp_config was never configured
+	d = r.Data(&terraform.InstanceState{ID: "vrf1"})
+	if cfg := getPolicyTier0VrfBgpConfigForPatch(d, true); cfg != nil {
+		t.Errorf("Expected no BGP config, got %v", cfg)
+	}
+}
+
+func testAccNsxtPolicyTier0VrfBgpDisabled(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy VRF Tier0 resource %s not found in resources", resourceName)
+		}
+
+		client := locale_services.NewBgpClient(connector)
+		bgpConfig, err := client.Get(rs.Primary.ID, defaultPolicyLocaleServiceID)
+		if err != nil {
+			return err
+		}
+		if bgpConfig.Enabled != nil && *bgpConfig.Enabled {
+			return fmt.Errorf("BGP is still enabled on VRF Tier0 %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTier0VrfCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_tier0_vrf" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyTier0GatewayExists(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Policy VRF Tier0 %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyTier0VrfTemplate(name string, createFlow bool) string {
+	var routeTargets, extraInterface, interVrfRouting, bgpConfig string
+	if createFlow {
+		bgpConfig = `
+  bgp_config {
+    ecmp = true
+    route_aggregation {
+      prefix = "4.4.0.0/16"
+    }
+  }`
+		routeTargets = `
+  route_target {
+    import_targets = ["2:12"]
+    export_targets = ["8999:123", "2:14"]
+  }`
+		extraInterface = `
+  interface {
+    type           = "EXTERNAL"
+    segment_path   = nsxt_policy_vlan_segment.test.path
+    edge_node_path = data.nsxt_policy_edge_node.EN.path
+    subnets        = ["4.4.5.1/24"]
+    access_vlan_id = 12
+  }`
+		interVrfRouting = `
+  inter_vrf_routing {
+    target_path = nsxt_policy_tier0_gateway.parent.path
+    bgp_route_leaking {
+      address_family = "IPV4"
+    }
+  }`
+	}
+
+	return testAccNsxtPolicyGatewayInterfaceDeps("11, 12", false) + fmt.Sprintf(`
+data "nsxt_policy_edge_node" "EN" {
+  edge_cluster_path = data.nsxt_policy_edge_cluster.EC.path
+  member_index      = 0
+}
+
+resource "nsxt_policy_tier0_gateway" "parent" {
+  nsx_id            = "vrf-parent"
+  display_name      = "parent"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.EC.path
+
+  bgp_config {
+    local_as_num = "60000"
+  }
+}
+
+resource "nsxt_policy_tier0_vrf" "test" {
+  display_name        = "%s"
+  parent_gateway_path = nsxt_policy_tier0_gateway.parent.path
+  edge_cluster_path   = data.nsxt_policy_edge_cluster.EC.path
+  %s
+  %s
+
+  interface {
+    nsx_id         = "vrf-uplink1"
+    type           = "EXTERNAL"
+    segment_path   = nsxt_policy_vlan_segment.test.path
+    edge_node_path = data.nsxt_policy_edge_node.EN.path
+    subnets        = ["4.4.4.1/24"]
+    access_vlan_id = 11
+  }
+  %s
+  %s
+}`, name, routeTargets, bgpConfig, extraInterface, interVrfRouting)
+}
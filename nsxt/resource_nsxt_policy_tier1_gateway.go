@@ -119,7 +119,7 @@ func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 			"route_advertisement_rule": getAdvRulesSchema(),
 			"ipv6_ndra_profile_path":   getIPv6NDRAPathSchema(),
 			"ipv6_dad_profile_path":    getIPv6DadPathSchema(),
-			"dhcp_config_path":         getPolicyPathSchema(false, false, "Policy path to DHCP server or relay configuration to use for this Tier1"),
+			"dhcp_config_path":         getPolicyGatewayDhcpConfigPathSchema("Tier1"),
 			"pool_allocation": {
 				Type:         schema.TypeString,
 				ForceNew:     true,
@@ -387,7 +387,6 @@ func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 	routeAdvertisementTypes := getStringListFromSchemaSet(d, "route_advertisement_types")
 	routeAdvertisementRules := getAdvRulesFromSchema(d)
 	ipv6ProfilePaths := getIpv6ProfilePathsFromSchema(d)
-	dhcpPath := d.Get("dhcp_config_path").(string)
 	haMode := d.Get("ha_mode").(string)
 	connectivityType := d.Get("type").(string)
 	revision := int64(d.Get("revision").(int))
@@ -426,12 +425,7 @@ func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 		obj.Type_ = &connectivityType
 	}
 
-	if dhcpPath != "" {
-		dhcpPaths := []string{dhcpPath}
-		obj.DhcpConfigPaths = dhcpPaths
-	} else {
-		obj.DhcpConfigPaths = []string{}
-	}
+	obj.DhcpConfigPaths = getPolicyGatewayDhcpConfigPathsFromSchema(d)
 	if len(d.Id()) > 0 {
 		// This is update flow
 		obj.Revision = &revision
@@ -581,11 +575,7 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 	} else {
 		d.Set("pool_allocation", obj.PoolAllocation)
 	}
-	dhcpPaths := obj.DhcpConfigPaths
-
-	if len(dhcpPaths) > 0 {
-		d.Set("dhcp_config_path", dhcpPaths[0])
-	}
+	setPolicyGatewayDhcpConfigPathsInSchema(d, obj.DhcpConfigPaths)
 
 	if obj.QosProfile != nil {
 		d.Set("ingress_qos_profile_path", obj.QosProfile.IngressQosProfilePath)
@@ -622,6 +612,12 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 				if _, ok := nsxIDMap[*service.Id]; ok {
 					cfgMap["nsx_id"] = service.Id
 				}
+				if context.ClientType == utl.Local {
+					err = setLocaleServiceMulticastInMap(connector, id, *service.Id, true, nil, cfgMap)
+					if err != nil {
+						return handleReadError(d, "Multicast Configuration for T1", id, err)
+					}
+				}
 				services = append(services, cfgMap)
 
 			} else {
@@ -44,7 +44,16 @@ func resourceNsxtPolicyTier1GatewayInterface() *schema.Resource {
 			"subnets":                getGatewayInterfaceSubnetsSchema(),
 			"mtu":                    getMtuSchema(),
 			"ipv6_ndra_profile_path": getIPv6NDRAPathSchema(),
+			"ipv6_dad_profile_path":  getIPv6DadPathSchema(),
 			"urpf_mode":              getGatewayInterfaceUrpfModeSchema(),
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "Ip addresses",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"locale_service_id": {
 				Type:        schema.TypeString,
 				Description: "Locale Service ID for this interface",
@@ -140,10 +149,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m int
 	dhcpRelayPath := d.Get("dhcp_relay_path").(string)
 	tags := getPolicyTagsFromSchema(d)
 	interfaceSubnetList := getGatewayInterfaceSubnetList(d)
-	var ipv6ProfilePaths []string
-	if d.Get("ipv6_ndra_profile_path").(string) != "" {
-		ipv6ProfilePaths = append(ipv6ProfilePaths, d.Get("ipv6_ndra_profile_path").(string))
-	}
+	ipv6ProfilePaths := getIpv6ProfilePathsFromSchema(d)
 	mtu := int64(d.Get("mtu").(int))
 	obj := model.Tier1Interface{
 		Id:               &id,
@@ -236,18 +242,22 @@ func resourceNsxtPolicyTier1GatewayInterfaceRead(d *schema.ResourceData, m inter
 	d.Set("revision", obj.Revision)
 	d.Set("segment_path", obj.SegmentPath)
 	d.Set("dhcp_relay_path", obj.DhcpRelayPath)
-	if obj.Ipv6ProfilePaths != nil {
-		d.Set("ipv6_ndra_profile_path", obj.Ipv6ProfilePaths[0]) // only one supported for now
+	err := setIpv6ProfilePathsInSchema(d, obj.Ipv6ProfilePaths)
+	if err != nil {
+		return fmt.Errorf("Failed to set ipv6 profiles for interface %s: %v", id, err)
 	}
 	d.Set("mtu", obj.Mtu)
 
 	if obj.Subnets != nil {
 		var subnetList []string
+		var ipList []string
 		for _, subnet := range obj.Subnets {
 			cidr := fmt.Sprintf("%s/%d", subnet.IpAddresses[0], *subnet.PrefixLen)
 			subnetList = append(subnetList, cidr)
+			ipList = append(ipList, subnet.IpAddresses[0])
 		}
 		d.Set("subnets", subnetList)
+		d.Set("ip_addresses", ipList)
 	}
 
 	if obj.UrpfMode != nil {
@@ -277,10 +287,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m int
 	interfaceSubnetList := getGatewayInterfaceSubnetList(d)
 	segmentPath := d.Get("segment_path").(string)
 	dhcpRelayPath := d.Get("dhcp_relay_path").(string)
-	var ipv6ProfilePaths []string
-	if d.Get("ipv6_ndra_profile_path").(string) != "" {
-		ipv6ProfilePaths = append(ipv6ProfilePaths, d.Get("ipv6_ndra_profile_path").(string))
-	}
+	ipv6ProfilePaths := getIpv6ProfilePathsFromSchema(d)
 	mtu := int64(d.Get("mtu").(int))
 	revision := int64(d.Get("revision").(int))
 	obj := model.Tier1Interface{
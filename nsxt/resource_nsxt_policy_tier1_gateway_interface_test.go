@@ -54,6 +54,7 @@ func testAccResourceNsxtPolicyTier1GatewayInterfaceBasic(t *testing.T, withConte
 					resource.TestCheckResourceAttr(testResourceName, "mtu", mtu),
 					resource.TestCheckResourceAttr(testResourceName, "subnets.#", "1"),
 					resource.TestCheckResourceAttr(testResourceName, "subnets.0", subnet),
+					resource.TestCheckResourceAttr(testResourceName, "ip_addresses.0", strings.Split(subnet, "/")[0]),
 					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
 					resource.TestCheckResourceAttrSet(testResourceName, "segment_path"),
 					resource.TestCheckResourceAttrSet(testResourceName, "gateway_path"),
@@ -394,6 +394,31 @@ func TestAccResourceNsxtPolicyTier1Gateway_withRules(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyTier1Gateway_withIPv6Rules(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tier1_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier1CheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier1TemplateWithIPv6Rules(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier1Exists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "route_advertisement_rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "route_advertisement_rule.0.subnets.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "route_advertisement_rule.1.subnets.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyTier1Gateway_withTier0(t *testing.T) {
 	name := getAccTestResourceName()
 	updateName := getAccTestResourceName()
@@ -806,6 +831,28 @@ resource "nsxt_policy_tier1_gateway" "test" {
 }`, name)
 }
 
+func testAccNsxtPolicyTier1TemplateWithIPv6Rules(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name = "%s"
+
+  route_advertisement_rule {
+    name                      = "v6-rule"
+    action                    = "PERMIT"
+    subnets                   = ["2001:db8:20::/64", "2001:db8:21::/64"]
+    route_advertisement_types = ["TIER1_CONNECTED"]
+    prefix_operator           = "GE"
+  }
+
+  route_advertisement_rule {
+    name            = "dual-stack-rule"
+    action          = "DENY"
+    subnets         = ["30.0.0.0/24", "2001:db8:30::/64"]
+    prefix_operator = "EQ"
+  }
+}`, name)
+}
+
 func testAccNsxtPolicyTier1TemplateWithQos(name string, profileName string) string {
 	return fmt.Sprintf(`
 data "nsxt_policy_gateway_qos_profile" "test" {
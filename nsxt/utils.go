@@ -90,6 +90,14 @@ func getStringListFromSchemaList(d *schema.ResourceData, schemaAttrName string)
 	return interface2StringList(d.Get(schemaAttrName).([]interface{}))
 }
 
+// getOptionalStringPtr returns pointer to string attribute value, or nil if the value is not set
+func getOptionalStringPtr(d *schema.ResourceData, schemaAttrName string) *string {
+	if value := d.Get(schemaAttrName).(string); value != "" {
+		return &value
+	}
+	return nil
+}
+
 // helper to construct a map based on curtain attribute in schema set
 // this helper is only relevant for Sets of nested objects (not scalars), and attrName
 // is the object attribute value of which would appear as key in the returned map object.
@@ -15,6 +15,7 @@ import (
 	"math/big"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -863,3 +864,18 @@ resource "nsxt_policy_shared_resource" "test" {
   }
 }`, context, name, projectPath, name, sharedResourcePath)
 }
+
+// newTestServerConnector starts local HTTP server with given handler, and returns connector
+// that sends API calls to this server. The server is stopped when the test completes.
+func newTestServerConnector(t *testing.T, handler http.HandlerFunc) client.Connector {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return client.NewConnector(server.URL, client.UsingRest(nil))
+}
+
+// writeTestJSONResponse replies to test server request with given status and JSON body
+func writeTestJSONResponse(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write([]byte(body))
+}
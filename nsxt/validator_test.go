@@ -160,3 +160,192 @@ func TestValidateSingleIP(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateASPath(t *testing.T) {
+
+	cases := map[string]struct {
+		value  interface{}
+		result bool
+	}{
+		"NotString": {
+			value:  777,
+			result: false,
+		},
+		"Empty": {
+			value:  "",
+			result: false,
+		},
+		"single": {
+			value:  "65001",
+			result: true,
+		},
+		"multiple": {
+			value:  "65001 65001  1.10",
+			result: true,
+		},
+		"badFirstToken": {
+			value:  "6500a 65001",
+			result: false,
+		},
+		"badDotToken": {
+			value:  "65001 70000.1",
+			result: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			_, errors := validateASPath(tc.value, tn)
+
+			if len(errors) > 0 && tc.result {
+				t.Errorf("validateASPath (%s) produced an unexpected error %s", tc.value, errors)
+			} else if len(errors) == 0 && !tc.result {
+				t.Errorf("validateASPath (%s) did not error", tc.value)
+			}
+		})
+	}
+}
+
+func TestValidatePolicyBGPCommunity(t *testing.T) {
+
+	cases := map[string]struct {
+		value  interface{}
+		result bool
+	}{
+		"NotString": {
+			value:  777,
+			result: false,
+		},
+		"Empty": {
+			value:  "",
+			result: false,
+		},
+		"wellKnown": {
+			value:  "NO_EXPORT",
+			result: true,
+		},
+		"regular": {
+			value:  "65000:100",
+			result: true,
+		},
+		"large": {
+			value:  "4200000000:1:100",
+			result: true,
+		},
+		"multiple": {
+			value:  "65000:100 65000:200",
+			result: false,
+		},
+		"regularOutOfRange": {
+			value:  "70000:100",
+			result: false,
+		},
+		"tooManyTokens": {
+			value:  "1:2:3:4",
+			result: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			_, errors := validatePolicyBGPCommunity(tc.value, tn)
+
+			if len(errors) > 0 && tc.result {
+				t.Errorf("validatePolicyBGPCommunity (%s) produced an unexpected error %s", tc.value, errors)
+			} else if len(errors) == 0 && !tc.result {
+				t.Errorf("validatePolicyBGPCommunity (%s) did not error", tc.value)
+			}
+		})
+	}
+}
+
+func TestValidatePolicyBGPCommunities(t *testing.T) {
+
+	cases := map[string]struct {
+		value  interface{}
+		result bool
+	}{
+		"NotString": {
+			value:  777,
+			result: false,
+		},
+		"Empty": {
+			value:  " ",
+			result: false,
+		},
+		"single": {
+			value:  "65000:100",
+			result: true,
+		},
+		"multiple": {
+			value:  "65000:100 4200000000:1:100 NO_ADVERTISE",
+			result: true,
+		},
+		"badSecond": {
+			value:  "65000:100 65000",
+			result: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			_, errors := validatePolicyBGPCommunities(tc.value, tn)
+
+			if len(errors) > 0 && tc.result {
+				t.Errorf("validatePolicyBGPCommunities (%s) produced an unexpected error %s", tc.value, errors)
+			} else if len(errors) == 0 && !tc.result {
+				t.Errorf("validatePolicyBGPCommunities (%s) did not error", tc.value)
+			}
+		})
+	}
+}
+
+func TestValidateCidr(t *testing.T) {
+
+	cases := map[string]struct {
+		value  interface{}
+		result bool
+	}{
+		"NotString": {
+			value:  777,
+			result: false,
+		},
+		"ipv4": {
+			value:  "20.0.0.0/24",
+			result: true,
+		},
+		"ipv4HostBits": {
+			value:  "20.0.0.1/24",
+			result: false,
+		},
+		"ipv6": {
+			value:  "2001:db8:20::/64",
+			result: true,
+		},
+		"ipv6HostBits": {
+			value:  "2001:db8:20::1/64",
+			result: false,
+		},
+		"ipv6NotCanonical": {
+			value:  "2001:DB8:20::/64",
+			result: false,
+		},
+		"singleIP": {
+			value:  "2001:db8:20::1",
+			result: false,
+		},
+	}
+
+	validator := validateCidr()
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			_, errors := validator(tc.value, tn)
+
+			if len(errors) > 0 && tc.result {
+				t.Errorf("validateCidr (%s) produced an unexpected error %s", tc.value, errors)
+			} else if len(errors) == 0 && !tc.result {
+				t.Errorf("validateCidr (%s) did not error", tc.value)
+			}
+		})
+	}
+}
@@ -575,9 +575,14 @@ func validateASPath(i interface{}, k string) (s []string, es []error) {
 		return
 	}
 
-	tokens := strings.Split(v, " ")
+	tokens := strings.Fields(v)
+	if len(tokens) == 0 {
+		es = append(es, fmt.Errorf("Non-empty AS path is expected for %s", k))
+		return
+	}
 	for _, token := range tokens {
-		s, es = validateASPlainOrDot(token, k)
+		_, tokenErrors := validateASPlainOrDot(token, k)
+		es = append(es, tokenErrors...)
 	}
 
 	return
@@ -590,26 +595,53 @@ func validatePolicyBGPCommunity(i interface{}, k string) (s []string, es []error
 		return
 	}
 
-	if (v == "NO_EXPORT") || (v == "NO_ADVERTISE") || (v == "NO_EXPORT_SUBCONFED") {
+	es = append(es, validatePolicySingleBGPCommunity(v)...)
+	return
+}
+
+// validatePolicyBGPCommunities validates list of BGP communities separated by space,
+// as accepted by route map set clause
+func validatePolicyBGPCommunities(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("String is expected, got %s", v))
 		return
 	}
 
+	for _, community := range strings.Fields(v) {
+		es = append(es, validatePolicySingleBGPCommunity(community)...)
+	}
+	if len(strings.Fields(v)) == 0 {
+		es = append(es, fmt.Errorf("Non-empty community is expected for %s", k))
+	}
+
+	return
+}
+
+func validatePolicySingleBGPCommunity(v string) []error {
+	if (v == "NO_EXPORT") || (v == "NO_ADVERTISE") || (v == "NO_EXPORT_SUBCONFED") {
+		return nil
+	}
+
 	formatErr := "aa:nn or aa:bb:nn format is expected, got %s"
 	tokens := strings.Split(v, ":")
 	if (len(tokens) > 3) || (len(tokens) < 2) {
-		es = append(es, fmt.Errorf(formatErr, v))
-		return
+		return []error{fmt.Errorf(formatErr, v)}
 	}
 
+	// regular community is composed of 16bit numbers, large community of 32bit numbers
+	bitSize := 16
+	if len(tokens) == 3 {
+		bitSize = 32
+	}
 	for _, token := range tokens {
-		_, err := strconv.Atoi(token)
+		_, err := strconv.ParseUint(token, 10, bitSize)
 		if err != nil {
-			es = append(es, fmt.Errorf(formatErr, v))
-			return
+			return []error{fmt.Errorf(formatErr, v)}
 		}
 	}
 
-	return
+	return nil
 }
 
 // validateLdapOrLdapsURL( is a SchemaValidateFunc which tests if the url is of type string and a valid LDAP or LDAPs
@@ -0,0 +1,80 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+// nsxtimport discovers existing NSX Policy objects and generates terraform import
+// blocks for them, along with resource configuration.
+//
+// Connection to NSX is configured via the same environment variables as the provider,
+// for example NSXT_MANAGER_HOST, NSXT_USERNAME, NSXT_PASSWORD and NSXT_ALLOW_UNVERIFIED_SSL.
+//
+// Usage, from repository root:
+//
+//	go run ./tools/nsxtimport -type Group,Service -tag env:prod -out imported.tf
+//	go run ./tools/nsxtimport -project dev -config=false
+//
+// The generated file can be consumed with `terraform plan`, which will show objects
+// to be imported. Generated configuration should be reviewed, since it includes
+// all configurable attributes that differ from their defaults.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt"
+)
+
+func splitList(value string) []string {
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func main() {
+	resourceTypes := flag.String("type", "", fmt.Sprintf("Comma separated NSX resource types to discover, out of %s. All if empty", strings.Join(nsxt.PolicyImportResourceTypes(), ", ")))
+	projectID := flag.String("project", "", "Project ID to discover objects in, default space if empty")
+	tags := flag.String("tag", "", "Comma separated tag filters in scope:tag format, either part can be empty")
+	generateConfig := flag.Bool("config", true, "Generate resource configuration in addition to import blocks")
+	out := flag.String("out", "", "Output file, standard output if empty")
+	flag.Parse()
+
+	provider := nsxt.Provider()
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{}))
+	if diags.HasError() {
+		for _, diag := range diags {
+			log.Printf("[ERROR] %s: %s", diag.Summary, diag.Detail)
+		}
+		os.Exit(1)
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("[ERROR] %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	options := nsxt.PolicyImportOptions{
+		ResourceTypes:  splitList(*resourceTypes),
+		ProjectID:      *projectID,
+		Tags:           splitList(*tags),
+		GenerateConfig: *generateConfig,
+	}
+	if err := nsxt.GeneratePolicyImport(provider, options, w); err != nil {
+		log.Fatalf("[ERROR] %v", err)
+	}
+}
@@ -0,0 +1,185 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	vapiBindings_ "github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Nested structures beyond this depth are left for manual implementation
+const maxNestingDepth = 3
+
+// attribute describes a single schema attribute derived from SDK model field
+type attribute struct {
+	SchemaName  string
+	SdkName     string
+	SchemaType  string
+	List        bool
+	Required    bool
+	Description string
+	Enums       []enumValue
+	// Name of SDK model struct for nested attributes
+	StructName string
+	Children   []*attribute
+}
+
+// Attributes that are common for all policy objects are handled by shared schemas
+func commonFieldNames() map[string]bool {
+	result := make(map[string]bool)
+	common := model.PolicyConfigResourceBindingType().(vapiBindings_.StructType)
+	for _, name := range common.FieldNames() {
+		result[name] = true
+	}
+	return result
+}
+
+func isRequired(fieldType vapiBindings_.BindingType) bool {
+	_, optional := fieldType.(vapiBindings_.OptionalType)
+	return !optional
+}
+
+func unwrapOptional(fieldType vapiBindings_.BindingType) vapiBindings_.BindingType {
+	if optional, ok := fieldType.(vapiBindings_.OptionalType); ok {
+		return optional.ElementType()
+	}
+	return fieldType
+}
+
+func resolveReference(fieldType vapiBindings_.BindingType) vapiBindings_.BindingType {
+	if ref, ok := fieldType.(vapiBindings_.ReferenceType); ok {
+		return ref.Resolve()
+	}
+	return fieldType
+}
+
+func primitiveSchemaType(fieldType vapiBindings_.BindingType) string {
+	switch fieldType.(type) {
+	case vapiBindings_.StringType, vapiBindings_.IdType, vapiBindings_.UriType, vapiBindings_.SecretType:
+		return "string"
+	case vapiBindings_.IntegerType:
+		return "int"
+	case vapiBindings_.BooleanType:
+		return "bool"
+	}
+	return ""
+}
+
+// loadAttributes builds attribute tree for given binding type via vAPI binding reflection
+func loadAttributes(src *sdkSource, bindingType vapiBindings_.BindingType, skip map[string]bool, depth int) ([]*attribute, error) {
+	structType, ok := bindingType.(vapiBindings_.StructType)
+	if !ok {
+		return nil, fmt.Errorf("binding type %T is not a structure", bindingType)
+	}
+	structName := structType.BindingStruct().Name()
+
+	fieldNames := structType.FieldNames()
+	sort.Strings(fieldNames)
+
+	var result []*attribute
+	for _, fieldName := range fieldNames {
+		if skip[fieldName] || strings.HasPrefix(fieldName, "_") {
+			continue
+		}
+		fieldType := structType.Field(fieldName)
+		sdkName := structType.CanonicalField(fieldName)
+		doc := src.fieldDoc(structName, sdkName)
+		if strings.Contains(strings.ToLower(doc.Description), "deprecated") {
+			log.Printf("[INFO] Skipping deprecated attribute %s.%s", structName, sdkName)
+			continue
+		}
+
+		attr := &attribute{
+			SchemaName:  fieldName,
+			SdkName:     sdkName,
+			Required:    isRequired(fieldType),
+			Description: doc.Description,
+			Enums:       doc.Enums,
+		}
+
+		elemType := resolveReference(unwrapOptional(fieldType))
+		if listType, ok := elemType.(vapiBindings_.ListType); ok {
+			attr.List = true
+			elemType = resolveReference(listType.ElementType())
+		}
+
+		if primitive := primitiveSchemaType(elemType); primitive != "" {
+			attr.SchemaType = primitive
+			result = append(result, attr)
+			continue
+		}
+
+		nested, ok := elemType.(vapiBindings_.StructType)
+		if !ok {
+			log.Printf("[WARNING] Skipping attribute %s.%s of unsupported type %T", structName, sdkName, elemType)
+			continue
+		}
+		if depth >= maxNestingDepth {
+			log.Printf("[WARNING] Skipping attribute %s.%s: nesting is too deep", structName, sdkName)
+			continue
+		}
+		children, err := loadAttributes(src, nested, nil, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(children) == 0 {
+			continue
+		}
+		attr.SchemaType = "struct"
+		attr.StructName = nested.BindingStruct().Name()
+		attr.Children = children
+		result = append(result, attr)
+	}
+
+	return result, nil
+}
+
+// enumVarName returns name of the variable that holds possible values for this attribute
+func (a *attribute) enumVarName(objName string) string {
+	return fmt.Sprintf("%s%sValues", lowercaseFirst(objName), a.SdkName)
+}
+
+func (a *attribute) testValue(create bool) string {
+	if len(a.Enums) > 0 {
+		if !create && len(a.Enums) > 1 {
+			return a.Enums[1].Value
+		}
+		return a.Enums[0].Value
+	}
+	switch a.SchemaType {
+	case "string":
+		if create {
+			return "test-create"
+		}
+		return "test-update"
+	case "int":
+		if create {
+			return "2"
+		}
+		return "5"
+	case "bool":
+		if create {
+			return "true"
+		}
+		return "false"
+	}
+	return ""
+}
+
+// docValue returns sample value for the attribute, formatted for HCL
+func (a *attribute) docValue() string {
+	value := a.testValue(true)
+	if a.SchemaType == "string" {
+		value = fmt.Sprintf("%q", value)
+	}
+	if a.List {
+		value = fmt.Sprintf("[%s]", value)
+	}
+	return value
+}
@@ -0,0 +1,91 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+// registrygen builds the model registry used by policygen. Go reflection can not
+// enumerate package level functions, so every <Model>BindingType function
+// exported by the SDK model package is collected here and written into a lookup
+// table, keyed by model name.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/vmware/terraform-provider-nsxt/tools/policygen/internal/sdkmodule"
+)
+
+const bindingTypeSuffix = "BindingType"
+
+func collectModels(dir string) ([]string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	var models []string
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv != nil || !fn.Name.IsExported() {
+					continue
+				}
+				name := fn.Name.Name
+				if !strings.HasSuffix(name, bindingTypeSuffix) || fn.Type.Params.NumFields() != 0 {
+					continue
+				}
+				models = append(models, strings.TrimSuffix(name, bindingTypeSuffix))
+			}
+		}
+	}
+	sort.Strings(models)
+	return models, nil
+}
+
+func main() {
+	out := flag.String("out", "registry.go", "Output file")
+	flag.Parse()
+
+	dir, err := sdkmodule.ModelDir()
+	if err != nil {
+		log.Fatal(err)
+	}
+	models, err := collectModels(dir)
+	if err != nil {
+		log.Fatalf("failed to parse SDK model package: %v", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by registrygen. DO NOT EDIT.\n\n")
+	buf.WriteString("package main\n\n")
+	buf.WriteString("import (\n")
+	buf.WriteString("\tvapiBindings_ \"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings\"\n")
+	buf.WriteString("\t\"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model\"\n")
+	buf.WriteString(")\n\n")
+	buf.WriteString("var modelRegistry = map[string]func() vapiBindings_.BindingType{\n")
+	for _, m := range models {
+		fmt.Fprintf(&buf, "\t%q: model.%s%s,\n", m, m, bindingTypeSuffix)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format registry: %v", err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[INFO] Registered %d models in %s", len(models), *out)
+}
@@ -0,0 +1,34 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+// Package sdkmodule locates the NSX SDK module used by policygen tools.
+package sdkmodule
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Path is the import path of the NSX SDK services module
+const Path = "github.com/vmware/vsphere-automation-sdk-go/services/nsxt"
+
+// Dir returns root directory of the SDK module source.
+// Module cache location is resolved by go tooling, so GOPATH layout is not required.
+func Dir() (string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", Path).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate %s module: %v", Path, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// ModelDir returns directory of the SDK model package source.
+func ModelDir() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "model"), nil
+}
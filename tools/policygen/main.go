@@ -0,0 +1,274 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+// policygen generates scaffolding for a new Policy resource or data source.
+//
+// Model structure is loaded from vAPI binding types of the NSX SDK, while
+// documentation and enum values are taken from the SDK model source, which
+// is located via go module tooling. The generated resource is metadata-based,
+// and comes with acceptance test, documentation and api_list.yaml entry, to be
+// consumed by api-wrapper-generator.py.
+//
+// Usage, from repository root:
+//
+//	go run ./tools/policygen -model MacDiscoveryProfile
+//	go run ./tools/policygen -model MacDiscoveryProfile -data
+//
+// After generation:
+//  1. Run `make api-wrapper` to generate API wrapper for the new entry in api_list.yaml
+//  2. Add the resource or data source to provider.go
+//  3. Replace FIXME subcategory in the documentation
+//  4. Review the generated schema, validations and test values
+//
+//go:generate go run ./internal/registrygen -out registry.go
+package main
+
+import (
+	"bytes"
+	"embed"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/vmware/terraform-provider-nsxt/tools/policygen/internal/sdkmodule"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+// generatorData is passed to the templates
+type generatorData struct {
+	// SDK model name, i.e. PolicyContextProfile
+	ModelName string
+	// Name used in function names and messages, i.e. ContextProfile
+	Name string
+	// Name used by API wrapper clients, i.e. IpDiscoveryProfile for IPDiscoveryProfile model
+	ObjName           string
+	ResourceLower     string
+	ResourceDashed    string
+	SchemaVar         string
+	Schema            string
+	Enums             string
+	HasEnums          bool
+	DocAttrs          string
+	DocReference      string
+	RequiredTestAttrs string
+	// SDK client methods that accept override parameter, i.e. Patch
+	Overrides map[string]bool
+}
+
+func lowercaseFirst(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// toSnakeCase converts SDK model name to terraform naming, keeping
+// abbreviations together, i.e. IPDiscoveryProfile -> ip_discovery_profile
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// toObjName converts terraform naming to SDK client naming, i.e. ip_discovery_profile -> IpDiscoveryProfile
+func toObjName(snake string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(snake, "_") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+func renderTemplate(name string, data *generatorData) ([]byte, error) {
+	tmpl, err := template.ParseFS(templates, "templates/"+name)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(name, ".go.tmpl") {
+		formatted, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to format output of %s: %v", name, err)
+		}
+		return formatted, nil
+	}
+	return buf.Bytes(), nil
+}
+
+func writeFile(path string, content []byte, force bool) error {
+	if _, err := os.Stat(path); err == nil && !force {
+		return fmt.Errorf("file %s already exists, use -force to overwrite", path)
+	}
+	log.Printf("[INFO] Generating %s", path)
+	return os.WriteFile(path, content, 0644)
+}
+
+func appendAPIList(path string, data *generatorData) error {
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if strings.Contains(string(existing), fmt.Sprintf("model_name: %s\n", data.ModelName)) {
+		log.Printf("[INFO] %s is already present in %s", data.ModelName, path)
+		return nil
+	}
+	entry, err := renderTemplate("api_list.yaml.tmpl", data)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if len(existing) > 0 && !bytes.HasSuffix(existing, []byte("\n")) {
+		entry = append([]byte("\n"), entry...)
+	}
+	log.Printf("[INFO] Adding %s to %s", data.ModelName, path)
+	_, err = f.Write(entry)
+	return err
+}
+
+func buildGeneratorData(modelName string) (*generatorData, error) {
+	bindingTypeFunc, ok := modelRegistry[modelName]
+	if !ok {
+		return nil, fmt.Errorf("model %s not found in SDK, consider running go generate to refresh model registry", modelName)
+	}
+
+	sdkDir, err := sdkmodule.Dir()
+	if err != nil {
+		return nil, err
+	}
+	src, err := loadSdkSource(filepath.Join(sdkDir, "model"))
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := loadAttributes(src, bindingTypeFunc(), commonFieldNames(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceLower := strings.TrimPrefix(toSnakeCase(modelName), "policy_")
+	objName := toObjName(resourceLower)
+	data := &generatorData{
+		ModelName:      modelName,
+		Name:           strings.TrimPrefix(modelName, "Policy"),
+		ObjName:        objName,
+		ResourceLower:  resourceLower,
+		ResourceDashed: strings.ReplaceAll(resourceLower, "_", "-"),
+		SchemaVar:      fmt.Sprintf("%sSchema", lowercaseFirst(objName)),
+		Schema:         buildExtendedSchema(objName, attrs, true),
+		Enums:          buildEnums(objName, attrs),
+		HasEnums:       hasEnums(attrs),
+		DocAttrs:       buildDocAttrs(attrs, "  "),
+		DocReference:   buildDocReference(attrs, ""),
+	}
+
+	var required []*attribute
+	for _, attr := range attrs {
+		if attr.Required {
+			required = append(required, attr)
+		}
+	}
+	data.RequiredTestAttrs = buildDocAttrs(required, "  ")
+
+	data.Overrides = make(map[string]bool)
+	clientDir := filepath.Join(sdkDir, "infra")
+	clientName := fmt.Sprintf("%ssClient", objName)
+	for _, method := range []string{"Patch", "Update", "Delete"} {
+		if data.Overrides[method], err = clientHasOverrideParam(clientDir, clientName, method); err != nil {
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+func main() {
+	modelName := flag.String("model", "", "SDK model name, for example MacDiscoveryProfile")
+	dataSource := flag.Bool("data", false, "Generate data source instead of resource")
+	outDir := flag.String("out", "nsxt", "Output directory for go files")
+	docsDir := flag.String("docs", "website/docs", "Documentation directory")
+	apiList := flag.String("api-list", "api/api_list.yaml", "API list for api-wrapper-generator.py, empty to skip")
+	force := flag.Bool("force", false, "Overwrite existing files")
+	flag.Parse()
+
+	if *modelName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	data, err := buildGeneratorData(*modelName)
+	if err != nil {
+		log.Fatalf("[ERROR] %v", err)
+	}
+
+	prefix := "resource_nsxt_policy_"
+	docSubdir := "r"
+	spec := map[string]string{
+		"resource.go.tmpl":      "",
+		"resource_test.go.tmpl": "_test",
+	}
+	docTemplate := "resource_doc.md.tmpl"
+	if *dataSource {
+		prefix = "data_source_nsxt_policy_"
+		docSubdir = "d"
+		spec = map[string]string{
+			"data_source.go.tmpl":      "",
+			"data_source_test.go.tmpl": "_test",
+		}
+		docTemplate = "data_source_doc.md.tmpl"
+	}
+
+	for tmpl, suffix := range spec {
+		content, err := renderTemplate(tmpl, data)
+		if err != nil {
+			log.Fatalf("[ERROR] %v", err)
+		}
+		target := filepath.Join(*outDir, fmt.Sprintf("%s%s%s.go", prefix, data.ResourceLower, suffix))
+		if err := writeFile(target, content, *force); err != nil {
+			log.Fatalf("[ERROR] %v", err)
+		}
+	}
+
+	content, err := renderTemplate(docTemplate, data)
+	if err != nil {
+		log.Fatalf("[ERROR] %v", err)
+	}
+	target := filepath.Join(*docsDir, docSubdir, fmt.Sprintf("policy_%s.html.markdown", data.ResourceLower))
+	if err := writeFile(target, content, *force); err != nil {
+		log.Fatalf("[ERROR] %v", err)
+	}
+
+	if *apiList != "" {
+		if err := appendAPIList(*apiList, data); err != nil {
+			log.Fatalf("[ERROR] %v", err)
+		}
+	}
+	log.Printf("[INFO] Done. Please run `make api-wrapper` and add nsxt_policy_%s to provider.go", data.ResourceLower)
+}
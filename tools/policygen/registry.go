@@ -0,0 +1,2327 @@
+// Code generated by registrygen. DO NOT EDIT.
+
+package main
+
+import (
+	vapiBindings_ "github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var modelRegistry = map[string]func() vapiBindings_.BindingType{
+	"ALBAuthToken":                                          model.ALBAuthTokenBindingType,
+	"ALBControllerAdminCredential":                          model.ALBControllerAdminCredentialBindingType,
+	"ALBControllerBackupConfiguration":                      model.ALBControllerBackupConfigurationBindingType,
+	"ALBControllerCertificate":                              model.ALBControllerCertificateBindingType,
+	"ALBControllerCertificateCsr":                           model.ALBControllerCertificateCsrBindingType,
+	"ALBControllerClusterInfo":                              model.ALBControllerClusterInfoBindingType,
+	"ALBControllerClusterTrigger":                           model.ALBControllerClusterTriggerBindingType,
+	"ALBControllerConfiguration":                            model.ALBControllerConfigurationBindingType,
+	"ALBControllerNodeFormFactor":                           model.ALBControllerNodeFormFactorBindingType,
+	"ALBControllerNodeFormFactors":                          model.ALBControllerNodeFormFactorsBindingType,
+	"ALBControllerNodeInfo":                                 model.ALBControllerNodeInfoBindingType,
+	"ALBControllerNodeUserSettings":                         model.ALBControllerNodeUserSettingsBindingType,
+	"ALBControllerNodeVMClusterConfig":                      model.ALBControllerNodeVMClusterConfigBindingType,
+	"ALBControllerNodeVMDeploymentConfig":                   model.ALBControllerNodeVMDeploymentConfigBindingType,
+	"ALBControllerNodeVMDeploymentProgressState":            model.ALBControllerNodeVMDeploymentProgressStateBindingType,
+	"ALBControllerNodeVMDeploymentRequest":                  model.ALBControllerNodeVMDeploymentRequestBindingType,
+	"ALBControllerNodeVMDeploymentRequestList":              model.ALBControllerNodeVMDeploymentRequestListBindingType,
+	"ALBControllerNodeVMDeploymentStatusReport":             model.ALBControllerNodeVMDeploymentStatusReportBindingType,
+	"ALBControllerSystemConfiguration":                      model.ALBControllerSystemConfigurationBindingType,
+	"ALBControllerSystemConfigurationResponse":              model.ALBControllerSystemConfigurationResponseBindingType,
+	"ALBControllerUserCredential":                           model.ALBControllerUserCredentialBindingType,
+	"ALBControllerUserCredentialResponse":                   model.ALBControllerUserCredentialResponseBindingType,
+	"ALGTypeServiceEntry":                                   model.ALGTypeServiceEntryBindingType,
+	"AbstractSpace":                                         model.AbstractSpaceBindingType,
+	"AcceptableComponentVersion":                            model.AcceptableComponentVersionBindingType,
+	"AcceptableComponentVersionList":                        model.AcceptableComponentVersionListBindingType,
+	"Action":                                                model.ActionBindingType,
+	"ActionableResource":                                    model.ActionableResourceBindingType,
+	"ActionableResourceListResult":                          model.ActionableResourceListResultBindingType,
+	"ActiveDirectoryIdentitySource":                         model.ActiveDirectoryIdentitySourceBindingType,
+	"AddALBControllerNodeVMInfo":                            model.AddALBControllerNodeVMInfoBindingType,
+	"AdditionalMetadata":                                    model.AdditionalMetadataBindingType,
+	"AddressBindingEntry":                                   model.AddressBindingEntryBindingType,
+	"AdvanceClusterRestoreInput":                            model.AdvanceClusterRestoreInputBindingType,
+	"AdvanceClusterRestoreRequest":                          model.AdvanceClusterRestoreRequestBindingType,
+	"AdvanceServiceConfig":                                  model.AdvanceServiceConfigBindingType,
+	"AdvancedConfig":                                        model.AdvancedConfigBindingType,
+	"AdvertisedNetworkCsvRecord":                            model.AdvertisedNetworkCsvRecordBindingType,
+	"AgentResource":                                         model.AgentResourceBindingType,
+	"AgentStatus":                                           model.AgentStatusBindingType,
+	"AgentStatusCount":                                      model.AgentStatusCountBindingType,
+	"AgentSubStatus":                                        model.AgentSubStatusBindingType,
+	"AggregateDNSForwarderStatistics":                       model.AggregateDNSForwarderStatisticsBindingType,
+	"AggregateDNSForwarderStatus":                           model.AggregateDNSForwarderStatusBindingType,
+	"AggregateIPSecVpnSessionStatistics":                    model.AggregateIPSecVpnSessionStatisticsBindingType,
+	"AggregateIPSecVpnSessionStatus":                        model.AggregateIPSecVpnSessionStatusBindingType,
+	"AggregateL2VPNSessionPeerConfig":                       model.AggregateL2VPNSessionPeerConfigBindingType,
+	"AggregateL2VPNSessionStatistics":                       model.AggregateL2VPNSessionStatisticsBindingType,
+	"AggregateL2VPNSessionStatus":                           model.AggregateL2VPNSessionStatusBindingType,
+	"AggregateL2VpnPeerConfig":                              model.AggregateL2VpnPeerConfigBindingType,
+	"AggregateL2VpnSessionRemoteMac":                        model.AggregateL2VpnSessionRemoteMacBindingType,
+	"AggregateL2VpnStatistics":                              model.AggregateL2VpnStatisticsBindingType,
+	"AggregateL3VpnStatistics":                              model.AggregateL3VpnStatisticsBindingType,
+	"AggregateLBNodeCapacitySummary":                        model.AggregateLBNodeCapacitySummaryBindingType,
+	"AggregateLBNodeUsageSummary":                           model.AggregateLBNodeUsageSummaryBindingType,
+	"AggregateLBPoolStatistics":                             model.AggregateLBPoolStatisticsBindingType,
+	"AggregateLBPoolStatus":                                 model.AggregateLBPoolStatusBindingType,
+	"AggregateLBServiceStatistics":                          model.AggregateLBServiceStatisticsBindingType,
+	"AggregateLBServiceStatus":                              model.AggregateLBServiceStatusBindingType,
+	"AggregateLBServiceUsage":                               model.AggregateLBServiceUsageBindingType,
+	"AggregateLBVirtualServerStatistics":                    model.AggregateLBVirtualServerStatisticsBindingType,
+	"AggregateLBVirtualServerStatus":                        model.AggregateLBVirtualServerStatusBindingType,
+	"AggregatePolicyDnsAnswer":                              model.AggregatePolicyDnsAnswerBindingType,
+	"AggregatePolicyRuntimeInfo":                            model.AggregatePolicyRuntimeInfoBindingType,
+	"AggregateTunnelTrafficStatistics":                      model.AggregateTunnelTrafficStatisticsBindingType,
+	"AggregatedDataCounter":                                 model.AggregatedDataCounterBindingType,
+	"AggregatedDataCounterEx":                               model.AggregatedDataCounterExBindingType,
+	"AggregatedFeatureDetailListResult":                     model.AggregatedFeatureDetailListResultBindingType,
+	"AggregatedFeatureListResult":                           model.AggregatedFeatureListResultBindingType,
+	"AggregatedFeatureState":                                model.AggregatedFeatureStateBindingType,
+	"AggregatedFeatureStateDetails":                         model.AggregatedFeatureStateDetailsBindingType,
+	"AggregatedLogicalRouterPortCounters":                   model.AggregatedLogicalRouterPortCountersBindingType,
+	"AlbControllerClusterRuntimeError":                      model.AlbControllerClusterRuntimeErrorBindingType,
+	"AlbControllerVsphereClusterNodeVmDeploymentConfig":     model.AlbControllerVsphereClusterNodeVmDeploymentConfigBindingType,
+	"AllocatedByVpc":                                        model.AllocatedByVpcBindingType,
+	"AllocationBasedOnFailureDomain":                        model.AllocationBasedOnFailureDomainBindingType,
+	"AllocationPool":                                        model.AllocationPoolBindingType,
+	"AllocationRule":                                        model.AllocationRuleBindingType,
+	"AllocationRuleAction":                                  model.AllocationRuleActionBindingType,
+	"AnalyticsMaintenanceMode":                              model.AnalyticsMaintenanceModeBindingType,
+	"AntreaAdapterStatus":                                   model.AntreaAdapterStatusBindingType,
+	"AntreaAgentsInfo":                                      model.AntreaAgentsInfoBindingType,
+	"AntreaClusterInfo":                                     model.AntreaClusterInfoBindingType,
+	"AntreaClusterListResult":                               model.AntreaClusterListResultBindingType,
+	"AntreaControllerStatus":                                model.AntreaControllerStatusBindingType,
+	"AntreaHeartbeatConfig":                                 model.AntreaHeartbeatConfigBindingType,
+	"AntreaHeartbeatConfigListResult":                       model.AntreaHeartbeatConfigListResultBindingType,
+	"AntreaTraceflowConfig":                                 model.AntreaTraceflowConfigBindingType,
+	"AntreaTraceflowConfigListResult":                       model.AntreaTraceflowConfigListResultBindingType,
+	"AntreaTraceflowIcmpEchoRequestHeader":                  model.AntreaTraceflowIcmpEchoRequestHeaderBindingType,
+	"AntreaTraceflowIpHeader":                               model.AntreaTraceflowIpHeaderBindingType,
+	"AntreaTraceflowIpv6Header":                             model.AntreaTraceflowIpv6HeaderBindingType,
+	"AntreaTraceflowObservation":                            model.AntreaTraceflowObservationBindingType,
+	"AntreaTraceflowObservationListResult":                  model.AntreaTraceflowObservationListResultBindingType,
+	"AntreaTraceflowPacketData":                             model.AntreaTraceflowPacketDataBindingType,
+	"AntreaTraceflowStatus":                                 model.AntreaTraceflowStatusBindingType,
+	"AntreaTraceflowTcpHeader":                              model.AntreaTraceflowTcpHeaderBindingType,
+	"AntreaTraceflowTransportHeader":                        model.AntreaTraceflowTransportHeaderBindingType,
+	"AntreaTraceflowUdpHeader":                              model.AntreaTraceflowUdpHeaderBindingType,
+	"ApiError":                                              model.ApiErrorBindingType,
+	"ApiRequestBody":                                        model.ApiRequestBodyBindingType,
+	"ApiServiceConfig":                                      model.ApiServiceConfigBindingType,
+	"ApplianceLatencyData":                                  model.ApplianceLatencyDataBindingType,
+	"ApplianceLatencyDataItem":                              model.ApplianceLatencyDataItemBindingType,
+	"ApplianceLatencyListResult":                            model.ApplianceLatencyListResultBindingType,
+	"ApplianceProcessData":                                  model.ApplianceProcessDataBindingType,
+	"ApplianceProcessListResult":                            model.ApplianceProcessListResultBindingType,
+	"ApplicationConnectivityStrategy":                       model.ApplicationConnectivityStrategyBindingType,
+	"ArpHeader":                                             model.ArpHeaderBindingType,
+	"ArpSnoopingConfig":                                     model.ArpSnoopingConfigBindingType,
+	"AssignedByAutoConf":                                    model.AssignedByAutoConfBindingType,
+	"AssignedByDhcp":                                        model.AssignedByDhcpBindingType,
+	"AssignedByDhcpv6":                                      model.AssignedByDhcpv6BindingType,
+	"AsyncCompatibilityCheckResult":                         model.AsyncCompatibilityCheckResultBindingType,
+	"AsyncOperationStatus":                                  model.AsyncOperationStatusBindingType,
+	"Attribute":                                             model.AttributeBindingType,
+	"AttributeVal":                                          model.AttributeValBindingType,
+	"AutoConf":                                              model.AutoConfBindingType,
+	"AutoEnableGatewayFirewallConfig":                       model.AutoEnableGatewayFirewallConfigBindingType,
+	"AutoRds":                                               model.AutoRdsBindingType,
+	"AutoUpdateErrorsOnAirgappedSites":                      model.AutoUpdateErrorsOnAirgappedSitesBindingType,
+	"AutoUpdateErrorsOnAirgappedSitesListResult":            model.AutoUpdateErrorsOnAirgappedSitesListResultBindingType,
+	"AutomaticHealthCheckToggle":                            model.AutomaticHealthCheckToggleBindingType,
+	"AviConnectionInfo":                                     model.AviConnectionInfoBindingType,
+	"Axes":                                                  model.AxesBindingType,
+	"AxisUnit":                                              model.AxisUnitBindingType,
+	"BFDDiagnosticCount":                                    model.BFDDiagnosticCountBindingType,
+	"BFDProperties":                                         model.BFDPropertiesBindingType,
+	"BFDStatusCount":                                        model.BFDStatusCountBindingType,
+	"BackupConfiguration":                                   model.BackupConfigurationBindingType,
+	"BackupOperationHistory":                                model.BackupOperationHistoryBindingType,
+	"BackupOperationStatus":                                 model.BackupOperationStatusBindingType,
+	"BackupOverview":                                        model.BackupOverviewBindingType,
+	"BackupSchedule":                                        model.BackupScheduleBindingType,
+	"BaseCompatibilityCheckResult":                          model.BaseCompatibilityCheckResultBindingType,
+	"BaseConsolidatedStatusPerEnforcementPoint":             model.BaseConsolidatedStatusPerEnforcementPointBindingType,
+	"BaseCountObservation":                                  model.BaseCountObservationBindingType,
+	"BaseEndpoint":                                          model.BaseEndpointBindingType,
+	"BaseInterfaceGroup":                                    model.BaseInterfaceGroupBindingType,
+	"BasePktCapResource":                                    model.BasePktCapResourceBindingType,
+	"BasePolicyServiceInstance":                             model.BasePolicyServiceInstanceBindingType,
+	"BaseRule":                                              model.BaseRuleBindingType,
+	"BaseRuleListResult":                                    model.BaseRuleListResultBindingType,
+	"BaseTier0Interface":                                    model.BaseTier0InterfaceBindingType,
+	"BatchRequest":                                          model.BatchRequestBindingType,
+	"BatchRequestItem":                                      model.BatchRequestItemBindingType,
+	"BatchResponse":                                         model.BatchResponseBindingType,
+	"BatchResponseItem":                                     model.BatchResponseItemBindingType,
+	"BfdHealthMonitoringConfig":                             model.BfdHealthMonitoringConfigBindingType,
+	"BfdProfile":                                            model.BfdProfileBindingType,
+	"BfdProfileListResult":                                  model.BfdProfileListResultBindingType,
+	"BgpAddressFamily":                                      model.BgpAddressFamilyBindingType,
+	"BgpBfdConfig":                                          model.BgpBfdConfigBindingType,
+	"BgpGracefulRestartConfig":                              model.BgpGracefulRestartConfigBindingType,
+	"BgpGracefulRestartTimer":                               model.BgpGracefulRestartTimerBindingType,
+	"BgpNeighborConfig":                                     model.BgpNeighborConfigBindingType,
+	"BgpNeighborConfigListResult":                           model.BgpNeighborConfigListResultBindingType,
+	"BgpNeighborLocalAsConfig":                              model.BgpNeighborLocalAsConfigBindingType,
+	"BgpNeighborRouteDetailsCsvRecord":                      model.BgpNeighborRouteDetailsCsvRecordBindingType,
+	"BgpNeighborRouteDetailsInCsvFormat":                    model.BgpNeighborRouteDetailsInCsvFormatBindingType,
+	"BgpNeighborRoutes":                                     model.BgpNeighborRoutesBindingType,
+	"BgpNeighborRoutesListResult":                           model.BgpNeighborRoutesListResultBindingType,
+	"BgpRouteFiltering":                                     model.BgpRouteFilteringBindingType,
+	"BgpRouteLeaking":                                       model.BgpRouteLeakingBindingType,
+	"BgpRoutingConfig":                                      model.BgpRoutingConfigBindingType,
+	"BgpTroubleshootConfig":                                 model.BgpTroubleshootConfigBindingType,
+	"BinaryPacketData":                                      model.BinaryPacketDataBindingType,
+	"BondPnicStatus":                                        model.BondPnicStatusBindingType,
+	"BridgeEndpointStatistics":                              model.BridgeEndpointStatisticsBindingType,
+	"BridgeEndpointStatus":                                  model.BridgeEndpointStatusBindingType,
+	"BridgeFirewallPolicy":                                  model.BridgeFirewallPolicyBindingType,
+	"BridgeFirewallPolicyListResult":                        model.BridgeFirewallPolicyListResultBindingType,
+	"BridgeProfileConfig":                                   model.BridgeProfileConfigBindingType,
+	"ByodPolicyServiceInstance":                             model.ByodPolicyServiceInstanceBindingType,
+	"ByodPolicyServiceInstanceListResult":                   model.ByodPolicyServiceInstanceListResultBindingType,
+	"CPUReservation":                                        model.CPUReservationBindingType,
+	"CaBundle":                                              model.CaBundleBindingType,
+	"CaBundleListResult":                                    model.CaBundleListResultBindingType,
+	"CapacityDashboardUsage":                                model.CapacityDashboardUsageBindingType,
+	"CapacityThreshold":                                     model.CapacityThresholdBindingType,
+	"CapacityThresholdList":                                 model.CapacityThresholdListBindingType,
+	"CapacityUsageMetaInfo":                                 model.CapacityUsageMetaInfoBindingType,
+	"CapacityUsageResponse":                                 model.CapacityUsageResponseBindingType,
+	"CheckpointCounter":                                     model.CheckpointCounterBindingType,
+	"CheckpointCounterResult":                               model.CheckpointCounterResultBindingType,
+	"ChildAntreaTraceflowConfig":                            model.ChildAntreaTraceflowConfigBindingType,
+	"ChildBfdProfile":                                       model.ChildBfdProfileBindingType,
+	"ChildBgpNeighborConfig":                                model.ChildBgpNeighborConfigBindingType,
+	"ChildBgpRoutingConfig":                                 model.ChildBgpRoutingConfigBindingType,
+	"ChildBridgeFirewallPolicy":                             model.ChildBridgeFirewallPolicyBindingType,
+	"ChildByodPolicyServiceInstance":                        model.ChildByodPolicyServiceInstanceBindingType,
+	"ChildCaBundle":                                         model.ChildCaBundleBindingType,
+	"ChildClusterSecurityConfiguration":                     model.ChildClusterSecurityConfigurationBindingType,
+	"ChildCommunicationEntry":                               model.ChildCommunicationEntryBindingType,
+	"ChildCommunicationMap":                                 model.ChildCommunicationMapBindingType,
+	"ChildCommunityList":                                    model.ChildCommunityListBindingType,
+	"ChildComputeClusterIdfwConfiguration":                  model.ChildComputeClusterIdfwConfigurationBindingType,
+	"ChildConstraint":                                       model.ChildConstraintBindingType,
+	"ChildConstraintGlobalConfig":                           model.ChildConstraintGlobalConfigBindingType,
+	"ChildDeploymentZone":                                   model.ChildDeploymentZoneBindingType,
+	"ChildDfwFirewallConfiguration":                         model.ChildDfwFirewallConfigurationBindingType,
+	"ChildDhcpRelayConfig":                                  model.ChildDhcpRelayConfigBindingType,
+	"ChildDhcpServerConfig":                                 model.ChildDhcpServerConfigBindingType,
+	"ChildDhcpStaticBindingConfig":                          model.ChildDhcpStaticBindingConfigBindingType,
+	"ChildDistributedVlanConnection":                        model.ChildDistributedVlanConnectionBindingType,
+	"ChildDnsSecurityProfile":                               model.ChildDnsSecurityProfileBindingType,
+	"ChildDnsSecurityProfileBindingMap":                     model.ChildDnsSecurityProfileBindingMapBindingType,
+	"ChildDomain":                                           model.ChildDomainBindingType,
+	"ChildDomainDeploymentMap":                              model.ChildDomainDeploymentMapBindingType,
+	"ChildEndpointPolicy":                                   model.ChildEndpointPolicyBindingType,
+	"ChildEndpointRule":                                     model.ChildEndpointRuleBindingType,
+	"ChildEnforcementPoint":                                 model.ChildEnforcementPointBindingType,
+	"ChildEvpnConfig":                                       model.ChildEvpnConfigBindingType,
+	"ChildEvpnTunnelEndpointConfig":                         model.ChildEvpnTunnelEndpointConfigBindingType,
+	"ChildFloodProtectionProfile":                           model.ChildFloodProtectionProfileBindingType,
+	"ChildFloodProtectionProfileBindingMap":                 model.ChildFloodProtectionProfileBindingMapBindingType,
+	"ChildFqdnAnalysisConfig":                               model.ChildFqdnAnalysisConfigBindingType,
+	"ChildGatewayConnection":                                model.ChildGatewayConnectionBindingType,
+	"ChildGatewayPolicy":                                    model.ChildGatewayPolicyBindingType,
+	"ChildGatewayQosProfile":                                model.ChildGatewayQosProfileBindingType,
+	"ChildGeneralSecurityProfile":                           model.ChildGeneralSecurityProfileBindingType,
+	"ChildGeneralSecurityProfileBindingMap":                 model.ChildGeneralSecurityProfileBindingMapBindingType,
+	"ChildGeoIpSetting":                                     model.ChildGeoIpSettingBindingType,
+	"ChildGlobalConfig":                                     model.ChildGlobalConfigBindingType,
+	"ChildGlobalDfwConfiguration":                           model.ChildGlobalDfwConfigurationBindingType,
+	"ChildGlobalIdsSettings":                                model.ChildGlobalIdsSettingsBindingType,
+	"ChildGlobalIdsSignature":                               model.ChildGlobalIdsSignatureBindingType,
+	"ChildGlobalManager":                                    model.ChildGlobalManagerBindingType,
+	"ChildGroup":                                            model.ChildGroupBindingType,
+	"ChildGroupDiscoveryProfileBindingMap":                  model.ChildGroupDiscoveryProfileBindingMapBindingType,
+	"ChildGroupMonitoringProfileBindingMap":                 model.ChildGroupMonitoringProfileBindingMapBindingType,
+	"ChildHostTransportNode":                                model.ChildHostTransportNodeBindingType,
+	"ChildHostTransportNodeCollection":                      model.ChildHostTransportNodeCollectionBindingType,
+	"ChildIPDiscoveryProfile":                               model.ChildIPDiscoveryProfileBindingType,
+	"ChildIPFIXDFWCollectorProfile":                         model.ChildIPFIXDFWCollectorProfileBindingType,
+	"ChildIPFIXDFWProfile":                                  model.ChildIPFIXDFWProfileBindingType,
+	"ChildIPFIXL2CollectorProfile":                          model.ChildIPFIXL2CollectorProfileBindingType,
+	"ChildIPFIXL2Profile":                                   model.ChildIPFIXL2ProfileBindingType,
+	"ChildIPReputationSiteMapping":                          model.ChildIPReputationSiteMappingBindingType,
+	"ChildIPSecVpnDpdProfile":                               model.ChildIPSecVpnDpdProfileBindingType,
+	"ChildIPSecVpnIkeProfile":                               model.ChildIPSecVpnIkeProfileBindingType,
+	"ChildIPSecVpnLocalEndpoint":                            model.ChildIPSecVpnLocalEndpointBindingType,
+	"ChildIPSecVpnService":                                  model.ChildIPSecVpnServiceBindingType,
+	"ChildIPSecVpnSession":                                  model.ChildIPSecVpnSessionBindingType,
+	"ChildIPSecVpnTunnelProfile":                            model.ChildIPSecVpnTunnelProfileBindingType,
+	"ChildIdentityFirewallStore":                            model.ChildIdentityFirewallStoreBindingType,
+	"ChildIdsClusterConfig":                                 model.ChildIdsClusterConfigBindingType,
+	"ChildIdsCustomSignatureSettings":                       model.ChildIdsCustomSignatureSettingsBindingType,
+	"ChildIdsGatewayPolicy":                                 model.ChildIdsGatewayPolicyBindingType,
+	"ChildIdsGlobalEventConfig":                             model.ChildIdsGlobalEventConfigBindingType,
+	"ChildIdsPcapFileMetadata":                              model.ChildIdsPcapFileMetadataBindingType,
+	"ChildIdsProfile":                                       model.ChildIdsProfileBindingType,
+	"ChildIdsRule":                                          model.ChildIdsRuleBindingType,
+	"ChildIdsSecurityPolicy":                                model.ChildIdsSecurityPolicyBindingType,
+	"ChildIdsSettings":                                      model.ChildIdsSettingsBindingType,
+	"ChildIdsSignature":                                     model.ChildIdsSignatureBindingType,
+	"ChildIdsSignatureStatus":                               model.ChildIdsSignatureStatusBindingType,
+	"ChildIdsSignatureVersion":                              model.ChildIdsSignatureVersionBindingType,
+	"ChildIdsSiteVersionMapping":                            model.ChildIdsSiteVersionMappingBindingType,
+	"ChildIdsStandaloneHostConfig":                          model.ChildIdsStandaloneHostConfigBindingType,
+	"ChildIdsThresholdConfig":                               model.ChildIdsThresholdConfigBindingType,
+	"ChildInfra":                                            model.ChildInfraBindingType,
+	"ChildIpAddressAllocation":                              model.ChildIpAddressAllocationBindingType,
+	"ChildIpAddressBlock":                                   model.ChildIpAddressBlockBindingType,
+	"ChildIpAddressPool":                                    model.ChildIpAddressPoolBindingType,
+	"ChildIpAddressPoolSubnet":                              model.ChildIpAddressPoolSubnetBindingType,
+	"ChildIpv6DadProfile":                                   model.ChildIpv6DadProfileBindingType,
+	"ChildIpv6NdraProfile":                                  model.ChildIpv6NdraProfileBindingType,
+	"ChildL2BridgeEndpointProfile":                          model.ChildL2BridgeEndpointProfileBindingType,
+	"ChildL2VPNService":                                     model.ChildL2VPNServiceBindingType,
+	"ChildL2VPNSession":                                     model.ChildL2VPNSessionBindingType,
+	"ChildL2Vpn":                                            model.ChildL2VpnBindingType,
+	"ChildL2VpnContext":                                     model.ChildL2VpnContextBindingType,
+	"ChildL3Vpn":                                            model.ChildL3VpnBindingType,
+	"ChildL3VpnContext":                                     model.ChildL3VpnContextBindingType,
+	"ChildL7AccessEntry":                                    model.ChildL7AccessEntryBindingType,
+	"ChildL7AccessProfile":                                  model.ChildL7AccessProfileBindingType,
+	"ChildLBAppProfile":                                     model.ChildLBAppProfileBindingType,
+	"ChildLBClientSslProfile":                               model.ChildLBClientSslProfileBindingType,
+	"ChildLBMonitorProfile":                                 model.ChildLBMonitorProfileBindingType,
+	"ChildLBPersistenceProfile":                             model.ChildLBPersistenceProfileBindingType,
+	"ChildLBPool":                                           model.ChildLBPoolBindingType,
+	"ChildLBServerSslProfile":                               model.ChildLBServerSslProfileBindingType,
+	"ChildLBService":                                        model.ChildLBServiceBindingType,
+	"ChildLBVirtualServer":                                  model.ChildLBVirtualServerBindingType,
+	"ChildLimit":                                            model.ChildLimitBindingType,
+	"ChildLiveTraceConfig":                                  model.ChildLiveTraceConfigBindingType,
+	"ChildLocaleServices":                                   model.ChildLocaleServicesBindingType,
+	"ChildMacDiscoveryProfile":                              model.ChildMacDiscoveryProfileBindingType,
+	"ChildMalwarePreventionProfile":                         model.ChildMalwarePreventionProfileBindingType,
+	"ChildMalwarePreventionSignature":                       model.ChildMalwarePreventionSignatureBindingType,
+	"ChildMetadataProxyConfig":                              model.ChildMetadataProxyConfigBindingType,
+	"ChildOdsDynamicRunbookInstance":                        model.ChildOdsDynamicRunbookInstanceBindingType,
+	"ChildOdsRunbookInvocation":                             model.ChildOdsRunbookInvocationBindingType,
+	"ChildOdsRunbookInvocationArtifactBatchRequest":         model.ChildOdsRunbookInvocationArtifactBatchRequestBindingType,
+	"ChildOpsGlobalConfig":                                  model.ChildOpsGlobalConfigBindingType,
+	"ChildOrg":                                              model.ChildOrgBindingType,
+	"ChildOrgRoot":                                          model.ChildOrgRootBindingType,
+	"ChildOspfAreaConfig":                                   model.ChildOspfAreaConfigBindingType,
+	"ChildOspfRoutingConfig":                                model.ChildOspfRoutingConfigBindingType,
+	"ChildPolicyConfigResource":                             model.ChildPolicyConfigResourceBindingType,
+	"ChildPolicyContextProfile":                             model.ChildPolicyContextProfileBindingType,
+	"ChildPolicyCustomAttributes":                           model.ChildPolicyCustomAttributesBindingType,
+	"ChildPolicyDnsForwarder":                               model.ChildPolicyDnsForwarderBindingType,
+	"ChildPolicyDnsForwarderZone":                           model.ChildPolicyDnsForwarderZoneBindingType,
+	"ChildPolicyEdgeCluster":                                model.ChildPolicyEdgeClusterBindingType,
+	"ChildPolicyEdgeHighAvailabilityProfile":                model.ChildPolicyEdgeHighAvailabilityProfileBindingType,
+	"ChildPolicyEdgeNode":                                   model.ChildPolicyEdgeNodeBindingType,
+	"ChildPolicyEdgeTransportNode":                          model.ChildPolicyEdgeTransportNodeBindingType,
+	"ChildPolicyExcludeList":                                model.ChildPolicyExcludeListBindingType,
+	"ChildPolicyFailureDomain":                              model.ChildPolicyFailureDomainBindingType,
+	"ChildPolicyFirewallCPUMemThresholdsProfileBindingMap":  model.ChildPolicyFirewallCPUMemThresholdsProfileBindingMapBindingType,
+	"ChildPolicyFirewallCpuMemThresholdsProfile":            model.ChildPolicyFirewallCpuMemThresholdsProfileBindingType,
+	"ChildPolicyFirewallFloodProtectionProfileBindingMap":   model.ChildPolicyFirewallFloodProtectionProfileBindingMapBindingType,
+	"ChildPolicyFirewallIpReputationConfig":                 model.ChildPolicyFirewallIpReputationConfigBindingType,
+	"ChildPolicyFirewallScheduler":                          model.ChildPolicyFirewallSchedulerBindingType,
+	"ChildPolicyFirewallSessionTimerProfile":                model.ChildPolicyFirewallSessionTimerProfileBindingType,
+	"ChildPolicyFirewallSessionTimerProfileBindingMap":      model.ChildPolicyFirewallSessionTimerProfileBindingMapBindingType,
+	"ChildPolicyIgmpProfile":                                model.ChildPolicyIgmpProfileBindingType,
+	"ChildPolicyInterVrfRoutingConfig":                      model.ChildPolicyInterVrfRoutingConfigBindingType,
+	"ChildPolicyLabel":                                      model.ChildPolicyLabelBindingType,
+	"ChildPolicyLatencyStatProfile":                         model.ChildPolicyLatencyStatProfileBindingType,
+	"ChildPolicyMulticastConfig":                            model.ChildPolicyMulticastConfigBindingType,
+	"ChildPolicyNat":                                        model.ChildPolicyNatBindingType,
+	"ChildPolicyNatRule":                                    model.ChildPolicyNatRuleBindingType,
+	"ChildPolicyPimProfile":                                 model.ChildPolicyPimProfileBindingType,
+	"ChildPolicySIExcludeList":                              model.ChildPolicySIExcludeListBindingType,
+	"ChildPolicyServiceChain":                               model.ChildPolicyServiceChainBindingType,
+	"ChildPolicyServiceInstance":                            model.ChildPolicyServiceInstanceBindingType,
+	"ChildPolicyServiceProfile":                             model.ChildPolicyServiceProfileBindingType,
+	"ChildPolicyTier1MulticastConfig":                       model.ChildPolicyTier1MulticastConfigBindingType,
+	"ChildPolicyTransportZone":                              model.ChildPolicyTransportZoneBindingType,
+	"ChildPolicyTransportZoneProfile":                       model.ChildPolicyTransportZoneProfileBindingType,
+	"ChildPolicyUrlCategorizationConfig":                    model.ChildPolicyUrlCategorizationConfigBindingType,
+	"ChildPolicyVpcNatRule":                                 model.ChildPolicyVpcNatRuleBindingType,
+	"ChildPortDiscoveryProfileBindingMap":                   model.ChildPortDiscoveryProfileBindingMapBindingType,
+	"ChildPortMirroringProfile":                             model.ChildPortMirroringProfileBindingType,
+	"ChildPortMonitoringProfileBindingMap":                  model.ChildPortMonitoringProfileBindingMapBindingType,
+	"ChildPortQosProfileBindingMap":                         model.ChildPortQosProfileBindingMapBindingType,
+	"ChildPortRealTimeEthProfileBindingMap":                 model.ChildPortRealTimeEthProfileBindingMapBindingType,
+	"ChildPortSecurityProfileBindingMap":                    model.ChildPortSecurityProfileBindingMapBindingType,
+	"ChildPrefixList":                                       model.ChildPrefixListBindingType,
+	"ChildProject":                                          model.ChildProjectBindingType,
+	"ChildProjectIpAddressAllocation":                       model.ChildProjectIpAddressAllocationBindingType,
+	"ChildProjectRouteFilter":                               model.ChildProjectRouteFilterBindingType,
+	"ChildQosProfile":                                       model.ChildQosProfileBindingType,
+	"ChildReaction":                                         model.ChildReactionBindingType,
+	"ChildRealTimeEthProfile":                               model.ChildRealTimeEthProfileBindingType,
+	"ChildRedirectionPolicy":                                model.ChildRedirectionPolicyBindingType,
+	"ChildRedirectionRule":                                  model.ChildRedirectionRuleBindingType,
+	"ChildResourceReference":                                model.ChildResourceReferenceBindingType,
+	"ChildRule":                                             model.ChildRuleBindingType,
+	"ChildSIStatusConfiguration":                            model.ChildSIStatusConfigurationBindingType,
+	"ChildSecurityFeatures":                                 model.ChildSecurityFeaturesBindingType,
+	"ChildSecurityPolicy":                                   model.ChildSecurityPolicyBindingType,
+	"ChildSegment":                                          model.ChildSegmentBindingType,
+	"ChildSegmentConnectionBindingMap":                      model.ChildSegmentConnectionBindingMapBindingType,
+	"ChildSegmentDiscoveryProfileBindingMap":                model.ChildSegmentDiscoveryProfileBindingMapBindingType,
+	"ChildSegmentMonitoringProfileBindingMap":               model.ChildSegmentMonitoringProfileBindingMapBindingType,
+	"ChildSegmentPort":                                      model.ChildSegmentPortBindingType,
+	"ChildSegmentQosProfileBindingMap":                      model.ChildSegmentQosProfileBindingMapBindingType,
+	"ChildSegmentRealTimeEthProfileBindingMap":              model.ChildSegmentRealTimeEthProfileBindingMapBindingType,
+	"ChildSegmentSecurityFeatures":                          model.ChildSegmentSecurityFeaturesBindingType,
+	"ChildSegmentSecurityProfile":                           model.ChildSegmentSecurityProfileBindingType,
+	"ChildSegmentSecurityProfileBindingMap":                 model.ChildSegmentSecurityProfileBindingMapBindingType,
+	"ChildService":                                          model.ChildServiceBindingType,
+	"ChildServiceEntry":                                     model.ChildServiceEntryBindingType,
+	"ChildServiceInstanceEndpoint":                          model.ChildServiceInstanceEndpointBindingType,
+	"ChildServiceInterface":                                 model.ChildServiceInterfaceBindingType,
+	"ChildServiceReference":                                 model.ChildServiceReferenceBindingType,
+	"ChildServiceSegment":                                   model.ChildServiceSegmentBindingType,
+	"ChildSessionTimerProfileBindingMap":                    model.ChildSessionTimerProfileBindingMapBindingType,
+	"ChildShaDynamicPlugin":                                 model.ChildShaDynamicPluginBindingType,
+	"ChildShaPluginProfile":                                 model.ChildShaPluginProfileBindingType,
+	"ChildShaPredefinedPlugin":                              model.ChildShaPredefinedPluginBindingType,
+	"ChildShare":                                            model.ChildShareBindingType,
+	"ChildSharedResource":                                   model.ChildSharedResourceBindingType,
+	"ChildSite":                                             model.ChildSiteBindingType,
+	"ChildSiteSecuritySetting":                              model.ChildSiteSecuritySettingBindingType,
+	"ChildSpoofGuardProfile":                                model.ChildSpoofGuardProfileBindingType,
+	"ChildStandaloneHostIdfwConfiguration":                  model.ChildStandaloneHostIdfwConfigurationBindingType,
+	"ChildStaticARPConfig":                                  model.ChildStaticARPConfigBindingType,
+	"ChildStaticMimeContent":                                model.ChildStaticMimeContentBindingType,
+	"ChildStaticRouteBfdPeer":                               model.ChildStaticRouteBfdPeerBindingType,
+	"ChildStaticRoutes":                                     model.ChildStaticRoutesBindingType,
+	"ChildSubnetConnectionBindingMap":                       model.ChildSubnetConnectionBindingMapBindingType,
+	"ChildTagBulkOperation":                                 model.ChildTagBulkOperationBindingType,
+	"ChildTier0":                                            model.ChildTier0BindingType,
+	"ChildTier0DeploymentMap":                               model.ChildTier0DeploymentMapBindingType,
+	"ChildTier0Interface":                                   model.ChildTier0InterfaceBindingType,
+	"ChildTier0InterfaceGroup":                              model.ChildTier0InterfaceGroupBindingType,
+	"ChildTier0RouteMap":                                    model.ChildTier0RouteMapBindingType,
+	"ChildTier0SecurityFeatures":                            model.ChildTier0SecurityFeaturesBindingType,
+	"ChildTier1":                                            model.ChildTier1BindingType,
+	"ChildTier1DeploymentMap":                               model.ChildTier1DeploymentMapBindingType,
+	"ChildTier1Interface":                                   model.ChildTier1InterfaceBindingType,
+	"ChildTier1InterfaceGroup":                              model.ChildTier1InterfaceGroupBindingType,
+	"ChildTlsCertificate":                                   model.ChildTlsCertificateBindingType,
+	"ChildTlsCrl":                                           model.ChildTlsCrlBindingType,
+	"ChildTlsPolicy":                                        model.ChildTlsPolicyBindingType,
+	"ChildTlsProfile":                                       model.ChildTlsProfileBindingType,
+	"ChildTlsRule":                                          model.ChildTlsRuleBindingType,
+	"ChildTlsTrustData":                                     model.ChildTlsTrustDataBindingType,
+	"ChildTraceflowConfig":                                  model.ChildTraceflowConfigBindingType,
+	"ChildTransitGateway":                                   model.ChildTransitGatewayBindingType,
+	"ChildTransitGatewayAttachment":                         model.ChildTransitGatewayAttachmentBindingType,
+	"ChildTransitGatewayNat":                                model.ChildTransitGatewayNatBindingType,
+	"ChildTransitGatewayNatRule":                            model.ChildTransitGatewayNatRuleBindingType,
+	"ChildTunnel":                                           model.ChildTunnelBindingType,
+	"ChildVMTagReplicationPolicy":                           model.ChildVMTagReplicationPolicyBindingType,
+	"ChildVirtualEndpoint":                                  model.ChildVirtualEndpointBindingType,
+	"ChildVniPoolConfig":                                    model.ChildVniPoolConfigBindingType,
+	"ChildVpc":                                              model.ChildVpcBindingType,
+	"ChildVpcAttachment":                                    model.ChildVpcAttachmentBindingType,
+	"ChildVpcConnectivityProfile":                           model.ChildVpcConnectivityProfileBindingType,
+	"ChildVpcIpAddressAllocation":                           model.ChildVpcIpAddressAllocationBindingType,
+	"ChildVpcSecurityProfile":                               model.ChildVpcSecurityProfileBindingType,
+	"ChildVpcServiceProfile":                                model.ChildVpcServiceProfileBindingType,
+	"ChildVpcSubnet":                                        model.ChildVpcSubnetBindingType,
+	"ChildVpcSubnetBridgeProfile":                           model.ChildVpcSubnetBridgeProfileBindingType,
+	"ChildVpcSubnetPort":                                    model.ChildVpcSubnetPortBindingType,
+	"ChildVpcSubnetPortProfileBindingMap":                   model.ChildVpcSubnetPortProfileBindingMapBindingType,
+	"CidrArrayConstraintValue":                              model.CidrArrayConstraintValueBindingType,
+	"CipherSuite":                                           model.CipherSuiteBindingType,
+	"ClasslessStaticRoute":                                  model.ClasslessStaticRouteBindingType,
+	"CloudNativeDeploymentAction":                           model.CloudNativeDeploymentActionBindingType,
+	"CloudNativeDeploymentConfig":                           model.CloudNativeDeploymentConfigBindingType,
+	"ClusterBackupInfo":                                     model.ClusterBackupInfoBindingType,
+	"ClusterBackupInfoListResult":                           model.ClusterBackupInfoListResultBindingType,
+	"ClusterControlPlane":                                   model.ClusterControlPlaneBindingType,
+	"ClusterControlPlaneListResult":                         model.ClusterControlPlaneListResultBindingType,
+	"ClusterInfo":                                           model.ClusterInfoBindingType,
+	"ClusterMemberDetails":                                  model.ClusterMemberDetailsBindingType,
+	"ClusterRestoreStatus":                                  model.ClusterRestoreStatusBindingType,
+	"ClusterSecurityConfiguration":                          model.ClusterSecurityConfigurationBindingType,
+	"ClusterSecurityConfigurationListResult":                model.ClusterSecurityConfigurationListResultBindingType,
+	"ClusterSecurityFeature":                                model.ClusterSecurityFeatureBindingType,
+	"ColumnItem":                                            model.ColumnItemBindingType,
+	"CommonAgentEndpointInfo":                               model.CommonAgentEndpointInfoBindingType,
+	"CommonAgentHostConfigurationInfo":                      model.CommonAgentHostConfigurationInfoBindingType,
+	"CommonAgentPrivateIpRangeInfo":                         model.CommonAgentPrivateIpRangeInfoBindingType,
+	"CommunicationEntry":                                    model.CommunicationEntryBindingType,
+	"CommunicationEntryListResult":                          model.CommunicationEntryListResultBindingType,
+	"CommunicationInsertParameters":                         model.CommunicationInsertParametersBindingType,
+	"CommunicationMap":                                      model.CommunicationMapBindingType,
+	"CommunicationMapListResult":                            model.CommunicationMapListResultBindingType,
+	"CommunityList":                                         model.CommunityListBindingType,
+	"CommunityListListResult":                               model.CommunityListListResultBindingType,
+	"CommunityMatchCriteria":                                model.CommunityMatchCriteriaBindingType,
+	"CompatibilityCheckResult":                              model.CompatibilityCheckResultBindingType,
+	"ComponentConditionItem":                                model.ComponentConditionItemBindingType,
+	"ComponentHealthMetric":                                 model.ComponentHealthMetricBindingType,
+	"ComputeClusterIdfwConfiguration":                       model.ComputeClusterIdfwConfigurationBindingType,
+	"ComputeClusterIdfwConfigurationListResult":             model.ComputeClusterIdfwConfigurationListResultBindingType,
+	"Condition":                                             model.ConditionBindingType,
+	"ConditionalForwarderStatistics":                        model.ConditionalForwarderStatisticsBindingType,
+	"ConditionalValueConstraintExpression":                  model.ConditionalValueConstraintExpressionBindingType,
+	"ConfigurationState":                                    model.ConfigurationStateBindingType,
+	"ConfigurationStateElement":                             model.ConfigurationStateElementBindingType,
+	"ConjunctionOperator":                                   model.ConjunctionOperatorBindingType,
+	"ConnectivityAdvancedConfig":                            model.ConnectivityAdvancedConfigBindingType,
+	"ConsolidatedEffectiveIPAddressMemberListResult":        model.ConsolidatedEffectiveIPAddressMemberListResultBindingType,
+	"ConsolidatedRealizedStatus":                            model.ConsolidatedRealizedStatusBindingType,
+	"ConsolidatedStatus":                                    model.ConsolidatedStatusBindingType,
+	"ConsolidatedStatusNsxt":                                model.ConsolidatedStatusNsxtBindingType,
+	"ConsolidatedStatusPerEnforcementPoint":                 model.ConsolidatedStatusPerEnforcementPointBindingType,
+	"ConstantFieldValue":                                    model.ConstantFieldValueBindingType,
+	"Constraint":                                            model.ConstraintBindingType,
+	"ConstraintExpression":                                  model.ConstraintExpressionBindingType,
+	"ConstraintGlobalConfig":                                model.ConstraintGlobalConfigBindingType,
+	"ConstraintListResult":                                  model.ConstraintListResultBindingType,
+	"ConstraintTarget":                                      model.ConstraintTargetBindingType,
+	"ConstraintValue":                                       model.ConstraintValueBindingType,
+	"ContainerClusterStatus":                                model.ContainerClusterStatusBindingType,
+	"ContainerClusterStatusList":                            model.ContainerClusterStatusListBindingType,
+	"ContainerClusterSummary":                               model.ContainerClusterSummaryBindingType,
+	"ContainerConfiguration":                                model.ContainerConfigurationBindingType,
+	"ContextProfileAttributesMetadata":                      model.ContextProfileAttributesMetadataBindingType,
+	"ControlConnStatus":                                     model.ControlConnStatusBindingType,
+	"CoreCountByEdition":                                    model.CoreCountByEditionBindingType,
+	"CoreCountByFeature":                                    model.CoreCountByFeatureBindingType,
+	"CountActionArgument":                                   model.CountActionArgumentBindingType,
+	"CountActionConfig":                                     model.CountActionConfigBindingType,
+	"CountObservation":                                      model.CountObservationBindingType,
+	"CounterResult":                                         model.CounterResultBindingType,
+	"CpuCoreConfigForEnhancedNetworkingStackSwitch":         model.CpuCoreConfigForEnhancedNetworkingStackSwitchBindingType,
+	"CpuUsage":                                              model.CpuUsageBindingType,
+	"Criterion":                                             model.CriterionBindingType,
+	"CrossSiteFlowInfo":                                     model.CrossSiteFlowInfoBindingType,
+	"CsrExtensions":                                         model.CsrExtensionsBindingType,
+	"CsvListResult":                                         model.CsvListResultBindingType,
+	"CsvRecord":                                             model.CsvRecordBindingType,
+	"CurrentBackupOperationStatus":                          model.CurrentBackupOperationStatusBindingType,
+	"CustomFilterWidgetConfiguration":                       model.CustomFilterWidgetConfigurationBindingType,
+	"CustomSignatureModification":                           model.CustomSignatureModificationBindingType,
+	"CustomSignatureValidationPayload":                      model.CustomSignatureValidationPayloadBindingType,
+	"CustomWidgetConfiguration":                             model.CustomWidgetConfigurationBindingType,
+	"CvxConnectionInfo":                                     model.CvxConnectionInfoBindingType,
+	"DNSForwarderStatisticsPerEnforcementPoint":             model.DNSForwarderStatisticsPerEnforcementPointBindingType,
+	"DNSForwarderStatusPerEnforcementPoint":                 model.DNSForwarderStatusPerEnforcementPointBindingType,
+	"DataCounter":                                           model.DataCounterBindingType,
+	"DataPathL3":                                            model.DataPathL3BindingType,
+	"DatapathCounterResult":                                 model.DatapathCounterResultBindingType,
+	"DatapathModuleCounter":                                 model.DatapathModuleCounterBindingType,
+	"DatapathPacketDrops":                                   model.DatapathPacketDropsBindingType,
+	"DatapathStatsActionArgument":                           model.DatapathStatsActionArgumentBindingType,
+	"DatapathStatsActionConfig":                             model.DatapathStatsActionConfigBindingType,
+	"DatapathStatsResult":                                   model.DatapathStatsResultBindingType,
+	"Datasource":                                            model.DatasourceBindingType,
+	"DedicatedResources":                                    model.DedicatedResourcesBindingType,
+	"DefaultFilterValue":                                    model.DefaultFilterValueBindingType,
+	"DependentServices":                                     model.DependentServicesBindingType,
+	"DeploymentChecksAction":                                model.DeploymentChecksActionBindingType,
+	"DeploymentChecksExecutionRequiredResult":               model.DeploymentChecksExecutionRequiredResultBindingType,
+	"DeploymentChecksStatus":                                model.DeploymentChecksStatusBindingType,
+	"DeploymentChecksStatusResult":                          model.DeploymentChecksStatusResultBindingType,
+	"DeploymentProgressStatus":                              model.DeploymentProgressStatusBindingType,
+	"DeploymentRegistry":                                    model.DeploymentRegistryBindingType,
+	"DeploymentTemplate":                                    model.DeploymentTemplateBindingType,
+	"DeploymentVersionSpec":                                 model.DeploymentVersionSpecBindingType,
+	"DeploymentVersions":                                    model.DeploymentVersionsBindingType,
+	"DeploymentZone":                                        model.DeploymentZoneBindingType,
+	"DeploymentZoneListResult":                              model.DeploymentZoneListResultBindingType,
+	"DfwDropCounters":                                       model.DfwDropCountersBindingType,
+	"DfwFirewallConfiguration":                              model.DfwFirewallConfigurationBindingType,
+	"DfwHeapMemoryUsage":                                    model.DfwHeapMemoryUsageBindingType,
+	"DhcpConfig":                                            model.DhcpConfigBindingType,
+	"DhcpDeleteLeaseRequestParameters":                      model.DhcpDeleteLeaseRequestParametersBindingType,
+	"DhcpDeleteLeases":                                      model.DhcpDeleteLeasesBindingType,
+	"DhcpHeader":                                            model.DhcpHeaderBindingType,
+	"DhcpIpPoolUsage":                                       model.DhcpIpPoolUsageBindingType,
+	"DhcpLeasePerIP":                                        model.DhcpLeasePerIPBindingType,
+	"DhcpLeases":                                            model.DhcpLeasesBindingType,
+	"DhcpLeasesResult":                                      model.DhcpLeasesResultBindingType,
+	"DhcpOption121":                                         model.DhcpOption121BindingType,
+	"DhcpRelayConfig":                                       model.DhcpRelayConfigBindingType,
+	"DhcpRelayConfigListResult":                             model.DhcpRelayConfigListResultBindingType,
+	"DhcpServerAdditionalConfig":                            model.DhcpServerAdditionalConfigBindingType,
+	"DhcpServerConfig":                                      model.DhcpServerConfigBindingType,
+	"DhcpServerConfigListResult":                            model.DhcpServerConfigListResultBindingType,
+	"DhcpServerState":                                       model.DhcpServerStateBindingType,
+	"DhcpServerStatistics":                                  model.DhcpServerStatisticsBindingType,
+	"DhcpServerStatus":                                      model.DhcpServerStatusBindingType,
+	"DhcpStaticBindingConfig":                               model.DhcpStaticBindingConfigBindingType,
+	"DhcpStaticBindingConfigListResult":                     model.DhcpStaticBindingConfigListResultBindingType,
+	"DhcpStaticBindingState":                                model.DhcpStaticBindingStateBindingType,
+	"DhcpStatistics":                                        model.DhcpStatisticsBindingType,
+	"DhcpV4Options":                                         model.DhcpV4OptionsBindingType,
+	"DhcpV4StaticBindingConfig":                             model.DhcpV4StaticBindingConfigBindingType,
+	"DhcpV6Lease":                                           model.DhcpV6LeaseBindingType,
+	"DhcpV6StaticBindingConfig":                             model.DhcpV6StaticBindingConfigBindingType,
+	"Dhcpv4":                                                model.Dhcpv4BindingType,
+	"Dhcpv6":                                                model.Dhcpv6BindingType,
+	"Dhcpv6Header":                                          model.Dhcpv6HeaderBindingType,
+	"DirectoryAdDomain":                                     model.DirectoryAdDomainBindingType,
+	"DirectoryAdGroup":                                      model.DirectoryAdGroupBindingType,
+	"DirectoryDomain":                                       model.DirectoryDomainBindingType,
+	"DirectoryDomainListResults":                            model.DirectoryDomainListResultsBindingType,
+	"DirectoryDomainSize":                                   model.DirectoryDomainSizeBindingType,
+	"DirectoryDomainSyncSettings":                           model.DirectoryDomainSyncSettingsBindingType,
+	"DirectoryDomainSyncStats":                              model.DirectoryDomainSyncStatsBindingType,
+	"DirectoryEventLogServer":                               model.DirectoryEventLogServerBindingType,
+	"DirectoryEventLogServerStatus":                         model.DirectoryEventLogServerStatusBindingType,
+	"DirectoryGroup":                                        model.DirectoryGroupBindingType,
+	"DirectoryGroupListResults":                             model.DirectoryGroupListResultsBindingType,
+	"DirectoryGroupMember":                                  model.DirectoryGroupMemberBindingType,
+	"DirectoryGroupMemberListResults":                       model.DirectoryGroupMemberListResultsBindingType,
+	"DirectoryLdapServer":                                   model.DirectoryLdapServerBindingType,
+	"DirectoryLdapServerListResults":                        model.DirectoryLdapServerListResultsBindingType,
+	"DirectoryLdapServerStatus":                             model.DirectoryLdapServerStatusBindingType,
+	"DirectoryOrgUnit":                                      model.DirectoryOrgUnitBindingType,
+	"DirectoryOrgUnitListResults":                           model.DirectoryOrgUnitListResultsBindingType,
+	"DiscoveredResource":                                    model.DiscoveredResourceBindingType,
+	"DiscoveredResourceScope":                               model.DiscoveredResourceScopeBindingType,
+	"DiscoveredVifState":                                    model.DiscoveredVifStateBindingType,
+	"DiscoveredVifStateListResult":                          model.DiscoveredVifStateListResultBindingType,
+	"DiscoveryProfileBindingMap":                            model.DiscoveryProfileBindingMapBindingType,
+	"DistributedDhcpServerPackets":                          model.DistributedDhcpServerPacketsBindingType,
+	"DistributedFirewall":                                   model.DistributedFirewallBindingType,
+	"DistributedFloodProtectionProfile":                     model.DistributedFloodProtectionProfileBindingType,
+	"DistributedVlanConnection":                             model.DistributedVlanConnectionBindingType,
+	"DistributedVlanConnectionListResult":                   model.DistributedVlanConnectionListResultBindingType,
+	"DnsClientConfig":                                       model.DnsClientConfigBindingType,
+	"DnsFailedQuery":                                        model.DnsFailedQueryBindingType,
+	"DnsForwarderStats":                                     model.DnsForwarderStatsBindingType,
+	"DnsHeader":                                             model.DnsHeaderBindingType,
+	"DnsSecurityProfile":                                    model.DnsSecurityProfileBindingType,
+	"DnsSecurityProfileBindingMap":                          model.DnsSecurityProfileBindingMapBindingType,
+	"DnsSecurityProfileBindingMapListResult":                model.DnsSecurityProfileBindingMapListResultBindingType,
+	"DnsSecurityProfileListResult":                          model.DnsSecurityProfileListResultBindingType,
+	"Domain":                                                model.DomainBindingType,
+	"DomainDeploymentMap":                                   model.DomainDeploymentMapBindingType,
+	"DomainDeploymentMapListResult":                         model.DomainDeploymentMapListResultBindingType,
+	"DomainListResult":                                      model.DomainListResultBindingType,
+	"DonutConfiguration":                                    model.DonutConfigurationBindingType,
+	"DonutPart":                                             model.DonutPartBindingType,
+	"DonutSection":                                          model.DonutSectionBindingType,
+	"DpuInfo":                                               model.DpuInfoBindingType,
+	"DpuStatusProperties":                                   model.DpuStatusPropertiesBindingType,
+	"DriverConfig":                                          model.DriverConfigBindingType,
+	"DropdownFilterPlotConfiguration":                       model.DropdownFilterPlotConfigurationBindingType,
+	"DropdownFilterWidgetConfiguration":                     model.DropdownFilterWidgetConfigurationBindingType,
+	"DropdownItem":                                          model.DropdownItemBindingType,
+	"DscpBit":                                               model.DscpBitBindingType,
+	"DscpIndicator":                                         model.DscpIndicatorBindingType,
+	"DscpValue":                                             model.DscpValueBindingType,
+	"DuplicateAddressBindingEntry":                          model.DuplicateAddressBindingEntryBindingType,
+	"DuplicateIPDetectionOptions":                           model.DuplicateIPDetectionOptionsBindingType,
+	"DynamicPluginFileProperties":                           model.DynamicPluginFilePropertiesBindingType,
+	"EULAAcceptance":                                        model.EULAAcceptanceBindingType,
+	"EULAContent":                                           model.EULAContentBindingType,
+	"EdgeClusterHighAvailabilityProfileListResult":          model.EdgeClusterHighAvailabilityProfileListResultBindingType,
+	"EdgeConfigurationState":                                model.EdgeConfigurationStateBindingType,
+	"EdgeHostAffinityConfig":                                model.EdgeHostAffinityConfigBindingType,
+	"EdgeTnRemoteTunnelEndpointState":                       model.EdgeTnRemoteTunnelEndpointStateBindingType,
+	"EdgeTnState":                                           model.EdgeTnStateBindingType,
+	"EdgeTnTunnelEndpointState":                             model.EdgeTnTunnelEndpointStateBindingType,
+	"EdgeTransportNodeDatapathMemoryPoolUsage":              model.EdgeTransportNodeDatapathMemoryPoolUsageBindingType,
+	"EdgeTransportNodeDatapathMemoryUsage":                  model.EdgeTransportNodeDatapathMemoryUsageBindingType,
+	"EdgeTransportNodeMemoryUsage":                          model.EdgeTransportNodeMemoryUsageBindingType,
+	"EdgeTransportNodeSwitchState":                          model.EdgeTransportNodeSwitchStateBindingType,
+	"EffectiveIPInfo":                                       model.EffectiveIPInfoBindingType,
+	"EffectiveProfilesResponse":                             model.EffectiveProfilesResponseBindingType,
+	"EgressRateLimiter":                                     model.EgressRateLimiterBindingType,
+	"Endpoint":                                              model.EndpointBindingType,
+	"EndpointPolicy":                                        model.EndpointPolicyBindingType,
+	"EndpointPolicyListResult":                              model.EndpointPolicyListResultBindingType,
+	"EndpointRule":                                          model.EndpointRuleBindingType,
+	"EndpointRuleListResult":                                model.EndpointRuleListResultBindingType,
+	"EnforcedStatusDetailsNsxt":                             model.EnforcedStatusDetailsNsxtBindingType,
+	"EnforcedStatusInfoNsxt":                                model.EnforcedStatusInfoNsxtBindingType,
+	"EnforcedStatusNsxt":                                    model.EnforcedStatusNsxtBindingType,
+	"EnforcedStatusPerScopeNsxt":                            model.EnforcedStatusPerScopeNsxtBindingType,
+	"EnforcedStatusPerTransportNode":                        model.EnforcedStatusPerTransportNodeBindingType,
+	"EnforcementDnsForwarderStatistics":                     model.EnforcementDnsForwarderStatisticsBindingType,
+	"EnforcementPoint":                                      model.EnforcementPointBindingType,
+	"EnforcementPointConnectionInfo":                        model.EnforcementPointConnectionInfoBindingType,
+	"EnforcementPointListResult":                            model.EnforcementPointListResultBindingType,
+	"EntityInstanceCountConstraintExpression":               model.EntityInstanceCountConstraintExpressionBindingType,
+	"ErrorResolverInfo":                                     model.ErrorResolverInfoBindingType,
+	"ErrorResolverInfoList":                                 model.ErrorResolverInfoListBindingType,
+	"ErrorResolverMetadata":                                 model.ErrorResolverMetadataBindingType,
+	"ErrorResolverMetadataList":                             model.ErrorResolverMetadataListBindingType,
+	"ErrorResolverSystemMetadata":                           model.ErrorResolverSystemMetadataBindingType,
+	"ErrorResolverUserInputData":                            model.ErrorResolverUserInputDataBindingType,
+	"ErrorResolverUserMetadata":                             model.ErrorResolverUserMetadataBindingType,
+	"EtherTypeServiceEntry":                                 model.EtherTypeServiceEntryBindingType,
+	"EthernetHeader":                                        model.EthernetHeaderBindingType,
+	"Evaluation":                                            model.EvaluationBindingType,
+	"Event":                                                 model.EventBindingType,
+	"EvpnConfig":                                            model.EvpnConfigBindingType,
+	"EvpnConfigAdvancedConfig":                              model.EvpnConfigAdvancedConfigBindingType,
+	"EvpnEncapConfig":                                       model.EvpnEncapConfigBindingType,
+	"EvpnTenantConfig":                                      model.EvpnTenantConfigBindingType,
+	"EvpnTenantConfigListResult":                            model.EvpnTenantConfigListResultBindingType,
+	"EvpnTunnelEndpointConfig":                              model.EvpnTunnelEndpointConfigBindingType,
+	"EvpnTunnelEndpointConfigListResult":                    model.EvpnTunnelEndpointConfigListResultBindingType,
+	"ExcludedMembersList":                                   model.ExcludedMembersListBindingType,
+	"ExportRequestParameter":                                model.ExportRequestParameterBindingType,
+	"ExportTask":                                            model.ExportTaskBindingType,
+	"Expression":                                            model.ExpressionBindingType,
+	"ExternalAddressBinding":                                model.ExternalAddressBindingBindingType,
+	"ExternalGatewayBfdConfig":                              model.ExternalGatewayBfdConfigBindingType,
+	"ExternalIDExpression":                                  model.ExternalIDExpressionBindingType,
+	"FIPSGlobalConfig":                                      model.FIPSGlobalConfigBindingType,
+	"FabricHostNode":                                        model.FabricHostNodeBindingType,
+	"FastPathLcoreUsageStats":                               model.FastPathLcoreUsageStatsBindingType,
+	"FastPathStats":                                         model.FastPathStatsBindingType,
+	"FastPathSysStats":                                      model.FastPathSysStatsBindingType,
+	"Fastpath":                                              model.FastpathBindingType,
+	"FeaturePermission":                                     model.FeaturePermissionBindingType,
+	"FeaturePermissionArray":                                model.FeaturePermissionArrayBindingType,
+	"FeaturePermissionListResult":                           model.FeaturePermissionListResultBindingType,
+	"FeatureSet":                                            model.FeatureSetBindingType,
+	"FeatureUsages":                                         model.FeatureUsagesBindingType,
+	"FederationComponentUpgradeStatus":                      model.FederationComponentUpgradeStatusBindingType,
+	"FederationConfig":                                      model.FederationConfigBindingType,
+	"FederationConnectivityConfig":                          model.FederationConnectivityConfigBindingType,
+	"FederationGatewayConfig":                               model.FederationGatewayConfigBindingType,
+	"FederationNodeSummary":                                 model.FederationNodeSummaryBindingType,
+	"FederationQueueInfo":                                   model.FederationQueueInfoBindingType,
+	"FederationUpgradeSummary":                              model.FederationUpgradeSummaryBindingType,
+	"FederationUpgradeSummaryListResult":                    model.FederationUpgradeSummaryListResultBindingType,
+	"FieldSanityConstraintExpression":                       model.FieldSanityConstraintExpressionBindingType,
+	"FieldSetting":                                          model.FieldSettingBindingType,
+	"FieldSettingValue":                                     model.FieldSettingValueBindingType,
+	"FieldsFilterData":                                      model.FieldsFilterDataBindingType,
+	"FieldsPacketData":                                      model.FieldsPacketDataBindingType,
+	"FileTransferAuthenticationScheme":                      model.FileTransferAuthenticationSchemeBindingType,
+	"FileTransferProtocol":                                  model.FileTransferProtocolBindingType,
+	"FilterRequest":                                         model.FilterRequestBindingType,
+	"FilterWidgetConfiguration":                             model.FilterWidgetConfigurationBindingType,
+	"FirewallConfiguration":                                 model.FirewallConfigurationBindingType,
+	"FirstnSampling":                                        model.FirstnSamplingBindingType,
+	"FloodProtectionProfile":                                model.FloodProtectionProfileBindingType,
+	"FloodProtectionProfileBindingListResult":               model.FloodProtectionProfileBindingListResultBindingType,
+	"FloodProtectionProfileBindingMap":                      model.FloodProtectionProfileBindingMapBindingType,
+	"FloodProtectionProfileListResult":                      model.FloodProtectionProfileListResultBindingType,
+	"FlowInfo":                                              model.FlowInfoBindingType,
+	"Footer":                                                model.FooterBindingType,
+	"FooterAction":                                          model.FooterActionBindingType,
+	"FormFactorDetail":                                      model.FormFactorDetailBindingType,
+	"FormFactors":                                           model.FormFactorsBindingType,
+	"FpCounters":                                            model.FpCountersBindingType,
+	"FqdnAnalysisConfig":                                    model.FqdnAnalysisConfigBindingType,
+	"FromEsxiVmknic":                                        model.FromEsxiVmknicBindingType,
+	"FullSyncInfo":                                          model.FullSyncInfoBindingType,
+	"FullSyncState":                                         model.FullSyncStateBindingType,
+	"FullSyncStateListResult":                               model.FullSyncStateListResultBindingType,
+	"GatewayConnection":                                     model.GatewayConnectionBindingType,
+	"GatewayConnectionListResult":                           model.GatewayConnectionListResultBindingType,
+	"GatewayFloodProtectionProfile":                         model.GatewayFloodProtectionProfileBindingType,
+	"GatewayGeneralSecurityProfile":                         model.GatewayGeneralSecurityProfileBindingType,
+	"GatewayInterfaceReference":                             model.GatewayInterfaceReferenceBindingType,
+	"GatewayL2ForwarderSiteSpanInfo":                        model.GatewayL2ForwarderSiteSpanInfoBindingType,
+	"GatewayPolicy":                                         model.GatewayPolicyBindingType,
+	"GatewayPolicyListResult":                               model.GatewayPolicyListResultBindingType,
+	"GatewayQosProfile":                                     model.GatewayQosProfileBindingType,
+	"GatewayQosProfileConfig":                               model.GatewayQosProfileConfigBindingType,
+	"GatewayQosProfileListResult":                           model.GatewayQosProfileListResultBindingType,
+	"GatewayRecoverySiteConfig":                             model.GatewayRecoverySiteConfigBindingType,
+	"GatewayRouteCsvRecord":                                 model.GatewayRouteCsvRecordBindingType,
+	"GatewayRouteTableInCsvFormat":                          model.GatewayRouteTableInCsvFormatBindingType,
+	"GatewaySiteFailoverActionConfig":                       model.GatewaySiteFailoverActionConfigBindingType,
+	"GeneralSecurityProfile":                                model.GeneralSecurityProfileBindingType,
+	"GeneralSecurityProfileBindingMap":                      model.GeneralSecurityProfileBindingMapBindingType,
+	"GenericDhcpOption":                                     model.GenericDhcpOptionBindingType,
+	"GenericGroupStaticRouteAlarm":                          model.GenericGroupStaticRouteAlarmBindingType,
+	"GenericPolicyRealizedResource":                         model.GenericPolicyRealizedResourceBindingType,
+	"GenericPolicyRealizedResourceListResult":               model.GenericPolicyRealizedResourceListResultBindingType,
+	"GenericVpcSecurityAndNatStatus":                        model.GenericVpcSecurityAndNatStatusBindingType,
+	"GenericVpcStatus":                                      model.GenericVpcStatusBindingType,
+	"GeoIpBlockedEvent":                                     model.GeoIpBlockedEventBindingType,
+	"GeoIpBlockedEventsList":                                model.GeoIpBlockedEventsListBindingType,
+	"GeoIpBundleInfo":                                       model.GeoIpBundleInfoBindingType,
+	"GeoIpLookUp":                                           model.GeoIpLookUpBindingType,
+	"GeoIpSetting":                                          model.GeoIpSettingBindingType,
+	"GeoLocationExpression":                                 model.GeoLocationExpressionBindingType,
+	"GlobalCollectorConfig":                                 model.GlobalCollectorConfigBindingType,
+	"GlobalConfig":                                          model.GlobalConfigBindingType,
+	"GlobalDfwConfiguration":                                model.GlobalDfwConfigurationBindingType,
+	"GlobalDfwConfigurationListResult":                      model.GlobalDfwConfigurationListResultBindingType,
+	"GlobalIdsSettings":                                     model.GlobalIdsSettingsBindingType,
+	"GlobalIdsSignature":                                    model.GlobalIdsSignatureBindingType,
+	"GlobalIdsSignatureListResult":                          model.GlobalIdsSignatureListResultBindingType,
+	"GlobalManager":                                         model.GlobalManagerBindingType,
+	"GlobalManagerConfig":                                   model.GlobalManagerConfigBindingType,
+	"GlobalManagerListResult":                               model.GlobalManagerListResultBindingType,
+	"GlobalManagerSwitchOverRequestParameter":               model.GlobalManagerSwitchOverRequestParameterBindingType,
+	"GlobalRestoreStatus":                                   model.GlobalRestoreStatusBindingType,
+	"GlobalTroubleshoot":                                    model.GlobalTroubleshootBindingType,
+	"GmFederationSiteConfig":                                model.GmFederationSiteConfigBindingType,
+	"GmRtepConfig":                                          model.GmRtepConfigBindingType,
+	"GraphConfiguration":                                    model.GraphConfigurationBindingType,
+	"GraphDefinition":                                       model.GraphDefinitionBindingType,
+	"GreTunnel":                                             model.GreTunnelBindingType,
+	"GreTunnelAggregateTrafficStatistics":                   model.GreTunnelAggregateTrafficStatisticsBindingType,
+	"GreTunnelEndpointStatusPerEdgeNode":                    model.GreTunnelEndpointStatusPerEdgeNodeBindingType,
+	"GreTunnelStatus":                                       model.GreTunnelStatusBindingType,
+	"GreTunnelTrafficStatistics":                            model.GreTunnelTrafficStatisticsBindingType,
+	"GridConfiguration":                                     model.GridConfigurationBindingType,
+	"Group":                                                 model.GroupBindingType,
+	"GroupDiscoveryProfileBindingMap":                       model.GroupDiscoveryProfileBindingMapBindingType,
+	"GroupDiscoveryProfileBindingMapListResult":             model.GroupDiscoveryProfileBindingMapListResultBindingType,
+	"GroupInfo":                                             model.GroupInfoBindingType,
+	"GroupListResult":                                       model.GroupListResultBindingType,
+	"GroupMemberList":                                       model.GroupMemberListBindingType,
+	"GroupMemberTagsList":                                   model.GroupMemberTagsListBindingType,
+	"GroupMemberTypeListResult":                             model.GroupMemberTypeListResultBindingType,
+	"GroupMonitoringProfileBindingMap":                      model.GroupMonitoringProfileBindingMapBindingType,
+	"GroupMonitoringProfileBindingMapListResult":            model.GroupMonitoringProfileBindingMapListResultBindingType,
+	"GroupScopeExpression":                                  model.GroupScopeExpressionBindingType,
+	"GroupTagsList":                                         model.GroupTagsListBindingType,
+	"GuestInfo":                                             model.GuestInfoBindingType,
+	"Header":                                                model.HeaderBindingType,
+	"HealthCheckResultOnHostSwitch":                         model.HealthCheckResultOnHostSwitchBindingType,
+	"HealthCheckResultPerUplink":                            model.HealthCheckResultPerUplinkBindingType,
+	"HealthCheckSpecVlans":                                  model.HealthCheckSpecVlansBindingType,
+	"HealthCheckVlanRange":                                  model.HealthCheckVlanRangeBindingType,
+	"HeatMapTransportNodesAggregateStatus":                  model.HeatMapTransportNodesAggregateStatusBindingType,
+	"HeatMapTransportZoneStatus":                            model.HeatMapTransportZoneStatusBindingType,
+	"HighPerformanceConfig":                                 model.HighPerformanceConfigBindingType,
+	"HighPerformanceConfigParams":                           model.HighPerformanceConfigParamsBindingType,
+	"HostMovementSpec":                                      model.HostMovementSpecBindingType,
+	"HostNodeLoginCredential":                               model.HostNodeLoginCredentialBindingType,
+	"HostSwitchConfigOption":                                model.HostSwitchConfigOptionBindingType,
+	"HostSwitchConfigSource":                                model.HostSwitchConfigSourceBindingType,
+	"HostSwitchProfileTypeIdEntry":                          model.HostSwitchProfileTypeIdEntryBindingType,
+	"HostSwitchSpec":                                        model.HostSwitchSpecBindingType,
+	"HostSwitchState":                                       model.HostSwitchStateBindingType,
+	"HostTransportNode":                                     model.HostTransportNodeBindingType,
+	"HostTransportNodeCollection":                           model.HostTransportNodeCollectionBindingType,
+	"HostTransportNodeCollectionListResult":                 model.HostTransportNodeCollectionListResultBindingType,
+	"HostTransportNodeListResult":                           model.HostTransportNodeListResultBindingType,
+	"HostUplink":                                            model.HostUplinkBindingType,
+	"HostVnic":                                              model.HostVnicBindingType,
+	"ICMPTypeServiceEntry":                                  model.ICMPTypeServiceEntryBindingType,
+	"IGMPTypeServiceEntry":                                  model.IGMPTypeServiceEntryBindingType,
+	"IPAddressExpression":                                   model.IPAddressExpressionBindingType,
+	"IPAddressList":                                         model.IPAddressListBindingType,
+	"IPAddresses":                                           model.IPAddressesBindingType,
+	"IPDiscoveryProfile":                                    model.IPDiscoveryProfileBindingType,
+	"IPDiscoveryProfileListResult":                          model.IPDiscoveryProfileListResultBindingType,
+	"IPFIXDFWCollector":                                     model.IPFIXDFWCollectorBindingType,
+	"IPFIXDFWCollectorProfile":                              model.IPFIXDFWCollectorProfileBindingType,
+	"IPFIXDFWCollectorProfileListResult":                    model.IPFIXDFWCollectorProfileListResultBindingType,
+	"IPFIXDFWProfile":                                       model.IPFIXDFWProfileBindingType,
+	"IPFIXDFWProfileListResult":                             model.IPFIXDFWProfileListResultBindingType,
+	"IPFIXL2Collector":                                      model.IPFIXL2CollectorBindingType,
+	"IPFIXL2CollectorProfile":                               model.IPFIXL2CollectorProfileBindingType,
+	"IPFIXL2CollectorProfileListResult":                     model.IPFIXL2CollectorProfileListResultBindingType,
+	"IPFIXL2Profile":                                        model.IPFIXL2ProfileBindingType,
+	"IPFIXL2ProfileListResult":                              model.IPFIXL2ProfileListResultBindingType,
+	"IPInfo":                                                model.IPInfoBindingType,
+	"IPProtocolServiceEntry":                                model.IPProtocolServiceEntryBindingType,
+	"IPReputationExclusionList":                             model.IPReputationExclusionListBindingType,
+	"IPReputationFeedDownloadStatus":                        model.IPReputationFeedDownloadStatusBindingType,
+	"IPReputationSiteMapping":                               model.IPReputationSiteMappingBindingType,
+	"IPReputationSiteMappingListResult":                     model.IPReputationSiteMappingListResultBindingType,
+	"IPSecVPNSessionTrafficSummary":                         model.IPSecVPNSessionTrafficSummaryBindingType,
+	"IPSecVPNTrafficCounters":                               model.IPSecVPNTrafficCountersBindingType,
+	"IPSecVpnDpdProfile":                                    model.IPSecVpnDpdProfileBindingType,
+	"IPSecVpnDpdProfileListResult":                          model.IPSecVpnDpdProfileListResultBindingType,
+	"IPSecVpnIkeProfile":                                    model.IPSecVpnIkeProfileBindingType,
+	"IPSecVpnIkeProfileListResult":                          model.IPSecVpnIkeProfileListResultBindingType,
+	"IPSecVpnIkeSessionStatus":                              model.IPSecVpnIkeSessionStatusBindingType,
+	"IPSecVpnIkeTrafficStatistics":                          model.IPSecVpnIkeTrafficStatisticsBindingType,
+	"IPSecVpnLocalEndpoint":                                 model.IPSecVpnLocalEndpointBindingType,
+	"IPSecVpnLocalEndpointListResult":                       model.IPSecVpnLocalEndpointListResultBindingType,
+	"IPSecVpnPolicyTrafficStatistics":                       model.IPSecVpnPolicyTrafficStatisticsBindingType,
+	"IPSecVpnRule":                                          model.IPSecVpnRuleBindingType,
+	"IPSecVpnService":                                       model.IPSecVpnServiceBindingType,
+	"IPSecVpnServiceListResult":                             model.IPSecVpnServiceListResultBindingType,
+	"IPSecVpnSession":                                       model.IPSecVpnSessionBindingType,
+	"IPSecVpnSessionListResult":                             model.IPSecVpnSessionListResultBindingType,
+	"IPSecVpnSessionStatistics":                             model.IPSecVpnSessionStatisticsBindingType,
+	"IPSecVpnSessionStatisticsNsxt":                         model.IPSecVpnSessionStatisticsNsxtBindingType,
+	"IPSecVpnSessionStatisticsPerEP":                        model.IPSecVpnSessionStatisticsPerEPBindingType,
+	"IPSecVpnSessionStatusNsxt":                             model.IPSecVpnSessionStatusNsxtBindingType,
+	"IPSecVpnSessionStatusPerEP":                            model.IPSecVpnSessionStatusPerEPBindingType,
+	"IPSecVpnSubnet":                                        model.IPSecVpnSubnetBindingType,
+	"IPSecVpnTrafficCounters":                               model.IPSecVpnTrafficCountersBindingType,
+	"IPSecVpnTransportStatus":                               model.IPSecVpnTransportStatusBindingType,
+	"IPSecVpnTunnelInterface":                               model.IPSecVpnTunnelInterfaceBindingType,
+	"IPSecVpnTunnelProfile":                                 model.IPSecVpnTunnelProfileBindingType,
+	"IPSecVpnTunnelProfileListResult":                       model.IPSecVpnTunnelProfileListResultBindingType,
+	"IPSecVpnTunnelTrafficStatistics":                       model.IPSecVpnTunnelTrafficStatisticsBindingType,
+	"IPSubnet":                                              model.IPSubnetBindingType,
+	"IPsecVPNIKESessionSummary":                             model.IPsecVPNIKESessionSummaryBindingType,
+	"IPv4DiscoveryOptions":                                  model.IPv4DiscoveryOptionsBindingType,
+	"IPv4Subnet":                                            model.IPv4SubnetBindingType,
+	"IPv6DADStatus":                                         model.IPv6DADStatusBindingType,
+	"IPv6DiscoveryOptions":                                  model.IPv6DiscoveryOptionsBindingType,
+	"IPv6EndPoint":                                          model.IPv6EndPointBindingType,
+	"IPv6Status":                                            model.IPv6StatusBindingType,
+	"IPv6Subnet":                                            model.IPv6SubnetBindingType,
+	"IcmpEchoRequestHeader":                                 model.IcmpEchoRequestHeaderBindingType,
+	"Icon":                                                  model.IconBindingType,
+	"IdentityFirewallAdStore":                               model.IdentityFirewallAdStoreBindingType,
+	"IdentityFirewallStore":                                 model.IdentityFirewallStoreBindingType,
+	"IdentityFirewallStoreEventLogServer":                   model.IdentityFirewallStoreEventLogServerBindingType,
+	"IdentityFirewallStoreLdapServer":                       model.IdentityFirewallStoreLdapServerBindingType,
+	"IdentityFirewallStoreLdapServerListResults":            model.IdentityFirewallStoreLdapServerListResultsBindingType,
+	"IdentityFirewallStoreListResults":                      model.IdentityFirewallStoreListResultsBindingType,
+	"IdentityFirewallStoreUserOrGroup":                      model.IdentityFirewallStoreUserOrGroupBindingType,
+	"IdentityFirewallStoreUserOrGrouptListResults":          model.IdentityFirewallStoreUserOrGrouptListResultsBindingType,
+	"IdentityGroupExpression":                               model.IdentityGroupExpressionBindingType,
+	"IdentityGroupInfo":                                     model.IdentityGroupInfoBindingType,
+	"IdentitySourceLdapServer":                              model.IdentitySourceLdapServerBindingType,
+	"IdentitySourceLdapServerEndpoint":                      model.IdentitySourceLdapServerEndpointBindingType,
+	"IdentitySourceLdapServerProbeResult":                   model.IdentitySourceLdapServerProbeResultBindingType,
+	"IdfwComputeCollectionCondition":                        model.IdfwComputeCollectionConditionBindingType,
+	"IdfwComputeCollectionListResult":                       model.IdfwComputeCollectionListResultBindingType,
+	"IdfwComputeCollectionStatus":                           model.IdfwComputeCollectionStatusBindingType,
+	"IdfwDirGroupUserSessionMapping":                        model.IdfwDirGroupUserSessionMappingBindingType,
+	"IdfwSystemStats":                                       model.IdfwSystemStatsBindingType,
+	"IdfwTransportNodeCondition":                            model.IdfwTransportNodeConditionBindingType,
+	"IdfwTransportNodeStatus":                               model.IdfwTransportNodeStatusBindingType,
+	"IdfwTransportNodeStatusListResult":                     model.IdfwTransportNodeStatusListResultBindingType,
+	"IdfwUserSessionData":                                   model.IdfwUserSessionDataBindingType,
+	"IdfwUserSessionDataAndMappings":                        model.IdfwUserSessionDataAndMappingsBindingType,
+	"IdfwUserStats":                                         model.IdfwUserStatsBindingType,
+	"IdfwVirtualMachineCondition":                           model.IdfwVirtualMachineConditionBindingType,
+	"IdfwVirtualMachineStatus":                              model.IdfwVirtualMachineStatusBindingType,
+	"IdfwVirtualMachineStatusListResult":                    model.IdfwVirtualMachineStatusListResultBindingType,
+	"IdfwVmDetail":                                          model.IdfwVmDetailBindingType,
+	"IdfwVmStats":                                           model.IdfwVmStatsBindingType,
+	"IdsClusterConfig":                                      model.IdsClusterConfigBindingType,
+	"IdsClusterConfigListResult":                            model.IdsClusterConfigListResultBindingType,
+	"IdsCustomSignature":                                    model.IdsCustomSignatureBindingType,
+	"IdsCustomSignatureExportActionParameter":               model.IdsCustomSignatureExportActionParameterBindingType,
+	"IdsCustomSignatureListResult":                          model.IdsCustomSignatureListResultBindingType,
+	"IdsCustomSignatureSettings":                            model.IdsCustomSignatureSettingsBindingType,
+	"IdsCustomSignatureVersion":                             model.IdsCustomSignatureVersionBindingType,
+	"IdsCustomSignatureVersionListResult":                   model.IdsCustomSignatureVersionListResultBindingType,
+	"IdsCustomSignaturesDiff":                               model.IdsCustomSignaturesDiffBindingType,
+	"IdsGatewayPolicy":                                      model.IdsGatewayPolicyBindingType,
+	"IdsGatewayPolicyListResult":                            model.IdsGatewayPolicyListResultBindingType,
+	"IdsGlobalEventConfig":                                  model.IdsGlobalEventConfigBindingType,
+	"IdsPcapConfig":                                         model.IdsPcapConfigBindingType,
+	"IdsPcapExport":                                         model.IdsPcapExportBindingType,
+	"IdsPcapFileMetadata":                                   model.IdsPcapFileMetadataBindingType,
+	"IdsPcapFileMetadataListResult":                         model.IdsPcapFileMetadataListResultBindingType,
+	"IdsPolicy":                                             model.IdsPolicyBindingType,
+	"IdsProfile":                                            model.IdsProfileBindingType,
+	"IdsProfileConjunctionOperator":                         model.IdsProfileConjunctionOperatorBindingType,
+	"IdsProfileCriteria":                                    model.IdsProfileCriteriaBindingType,
+	"IdsProfileFilterCriteria":                              model.IdsProfileFilterCriteriaBindingType,
+	"IdsProfileListResult":                                  model.IdsProfileListResultBindingType,
+	"IdsProfileLocalSignature":                              model.IdsProfileLocalSignatureBindingType,
+	"IdsProfileSeverity":                                    model.IdsProfileSeverityBindingType,
+	"IdsProfileSignatures":                                  model.IdsProfileSignaturesBindingType,
+	"IdsRawSignatures":                                      model.IdsRawSignaturesBindingType,
+	"IdsRule":                                               model.IdsRuleBindingType,
+	"IdsRuleListResult":                                     model.IdsRuleListResultBindingType,
+	"IdsRuleStatistics":                                     model.IdsRuleStatisticsBindingType,
+	"IdsRuleStatisticsForEnforcementPoint":                  model.IdsRuleStatisticsForEnforcementPointBindingType,
+	"IdsRuleStatisticsListResult":                           model.IdsRuleStatisticsListResultBindingType,
+	"IdsSecurityPolicy":                                     model.IdsSecurityPolicyBindingType,
+	"IdsSecurityPolicyListResult":                           model.IdsSecurityPolicyListResultBindingType,
+	"IdsSecurityPolicyStatistics":                           model.IdsSecurityPolicyStatisticsBindingType,
+	"IdsSecurityPolicyStatisticsForEnforcementPoint":        model.IdsSecurityPolicyStatisticsForEnforcementPointBindingType,
+	"IdsSecurityPolicyStatisticsListResult":                 model.IdsSecurityPolicyStatisticsListResultBindingType,
+	"IdsSettings":                                           model.IdsSettingsBindingType,
+	"IdsSignature":                                          model.IdsSignatureBindingType,
+	"IdsSignatureListResult":                                model.IdsSignatureListResultBindingType,
+	"IdsSignatureRateFilter":                                model.IdsSignatureRateFilterBindingType,
+	"IdsSignatureStatus":                                    model.IdsSignatureStatusBindingType,
+	"IdsSignatureThreshold":                                 model.IdsSignatureThresholdBindingType,
+	"IdsSignatureVersion":                                   model.IdsSignatureVersionBindingType,
+	"IdsSignatureVersionListResult":                         model.IdsSignatureVersionListResultBindingType,
+	"IdsSiteSettings":                                       model.IdsSiteSettingsBindingType,
+	"IdsSiteVersionMapping":                                 model.IdsSiteVersionMappingBindingType,
+	"IdsSiteVersionMappingActionsQueryParameters":           model.IdsSiteVersionMappingActionsQueryParametersBindingType,
+	"IdsSiteVersionMappingListResult":                       model.IdsSiteVersionMappingListResultBindingType,
+	"IdsStandaloneHostConfig":                               model.IdsStandaloneHostConfigBindingType,
+	"IdsThresholdConfig":                                    model.IdsThresholdConfigBindingType,
+	"IdsThresholdConfigListResult":                          model.IdsThresholdConfigListResultBindingType,
+	"IdsValidationError":                                    model.IdsValidationErrorBindingType,
+	"IgmpMembership":                                        model.IgmpMembershipBindingType,
+	"IgmpMembershipCsvRecord":                               model.IgmpMembershipCsvRecordBindingType,
+	"IgmpMemberships":                                       model.IgmpMembershipsBindingType,
+	"IgmpMembershipsInCsvFormat":                            model.IgmpMembershipsInCsvFormatBindingType,
+	"IgmpMembershipsPerEdge":                                model.IgmpMembershipsPerEdgeBindingType,
+	"ImportErrorMessage":                                    model.ImportErrorMessageBindingType,
+	"ImportErrorMessageDetail":                              model.ImportErrorMessageDetailBindingType,
+	"ImportTask":                                            model.ImportTaskBindingType,
+	"IncludedFieldsParameters":                              model.IncludedFieldsParametersBindingType,
+	"Infra":                                                 model.InfraBindingType,
+	"InfraSecurityConfig":                                   model.InfraSecurityConfigBindingType,
+	"IngressBroadcastRateLimiter":                           model.IngressBroadcastRateLimiterBindingType,
+	"IngressRateLimiter":                                    model.IngressRateLimiterBindingType,
+	"InitiateClusterRestoreRequest":                         model.InitiateClusterRestoreRequestBindingType,
+	"Injection":                                             model.InjectionBindingType,
+	"InjectionValue":                                        model.InjectionValueBindingType,
+	"InstanceRuntimeStatistic":                              model.InstanceRuntimeStatisticBindingType,
+	"InstructionInfo":                                       model.InstructionInfoBindingType,
+	"IntegerArrayConstraintValue":                           model.IntegerArrayConstraintValueBindingType,
+	"IntentRuntimeRequestParameters":                        model.IntentRuntimeRequestParametersBindingType,
+	"InterSitePortCounters":                                 model.InterSitePortCountersBindingType,
+	"InterfaceArpCsvRecord":                                 model.InterfaceArpCsvRecordBindingType,
+	"InterfaceArpEntry":                                     model.InterfaceArpEntryBindingType,
+	"InterfaceArpProxy":                                     model.InterfaceArpProxyBindingType,
+	"InterfaceArpProxyCsvEntry":                             model.InterfaceArpProxyCsvEntryBindingType,
+	"InterfaceArpTable":                                     model.InterfaceArpTableBindingType,
+	"InterfaceArpTableInCsvFormat":                          model.InterfaceArpTableInCsvFormatBindingType,
+	"InterfaceDADState":                                     model.InterfaceDADStateBindingType,
+	"InterfaceNeighborProperties":                           model.InterfaceNeighborPropertiesBindingType,
+	"InterfaceNeighborPropertyListResult":                   model.InterfaceNeighborPropertyListResultBindingType,
+	"InterfaceSubnet":                                       model.InterfaceSubnetBindingType,
+	"InterfaceiPv6DADStatus":                                model.InterfaceiPv6DADStatusBindingType,
+	"IntersiteGatewayConfig":                                model.IntersiteGatewayConfigBindingType,
+	"IntervalBackupSchedule":                                model.IntervalBackupScheduleBindingType,
+	"IntervalSampling":                                      model.IntervalSamplingBindingType,
+	"IpAddressAllocation":                                   model.IpAddressAllocationBindingType,
+	"IpAddressAllocationListResult":                         model.IpAddressAllocationListResultBindingType,
+	"IpAddressBlock":                                        model.IpAddressBlockBindingType,
+	"IpAddressBlockCidrAllocations":                         model.IpAddressBlockCidrAllocationsBindingType,
+	"IpAddressBlockListResult":                              model.IpAddressBlockListResultBindingType,
+	"IpAddressBlockState":                                   model.IpAddressBlockStateBindingType,
+	"IpAddressBlockUsage":                                   model.IpAddressBlockUsageBindingType,
+	"IpAddressBlockVPCSubnets":                              model.IpAddressBlockVPCSubnetsBindingType,
+	"IpAddressInfo":                                         model.IpAddressInfoBindingType,
+	"IpAddressPool":                                         model.IpAddressPoolBindingType,
+	"IpAddressPoolBlockSubnet":                              model.IpAddressPoolBlockSubnetBindingType,
+	"IpAddressPoolListResult":                               model.IpAddressPoolListResultBindingType,
+	"IpAddressPoolStaticSubnet":                             model.IpAddressPoolStaticSubnetBindingType,
+	"IpAddressPoolSubnet":                                   model.IpAddressPoolSubnetBindingType,
+	"IpAddressPoolSubnetListResult":                         model.IpAddressPoolSubnetListResultBindingType,
+	"IpAssignmentSpec":                                      model.IpAssignmentSpecBindingType,
+	"IpBlockQuota":                                          model.IpBlockQuotaBindingType,
+	"IpBlockQuotaState":                                     model.IpBlockQuotaStateBindingType,
+	"IpBlockQuotaUsageForConsumer":                          model.IpBlockQuotaUsageForConsumerBindingType,
+	"IpInfo":                                                model.IpInfoBindingType,
+	"IpMacPair":                                             model.IpMacPairBindingType,
+	"IpPoolRange":                                           model.IpPoolRangeBindingType,
+	"IpSecVpnPolicyTrafficStatistics":                       model.IpSecVpnPolicyTrafficStatisticsBindingType,
+	"IpSecVpnTunnelTrafficStatistics":                       model.IpSecVpnTunnelTrafficStatisticsBindingType,
+	"Ipv4Header":                                            model.Ipv4HeaderBindingType,
+	"Ipv6AssignmentSpec":                                    model.Ipv6AssignmentSpecBindingType,
+	"Ipv6DadProfile":                                        model.Ipv6DadProfileBindingType,
+	"Ipv6DadProfileListResult":                              model.Ipv6DadProfileListResultBindingType,
+	"Ipv6Header":                                            model.Ipv6HeaderBindingType,
+	"Ipv6MacPair":                                           model.Ipv6MacPairBindingType,
+	"Ipv6NdraProfile":                                       model.Ipv6NdraProfileBindingType,
+	"Ipv6NdraProfileListResult":                             model.Ipv6NdraProfileListResultBindingType,
+	"IssuerSerialNumber":                                    model.IssuerSerialNumberBindingType,
+	"KeyStoreInfo":                                          model.KeyStoreInfoBindingType,
+	"KeyValue":                                              model.KeyValueBindingType,
+	"KeyValuePair":                                          model.KeyValuePairBindingType,
+	"KubeconfigInfo":                                        model.KubeconfigInfoBindingType,
+	"KubernetesToolsInfo":                                   model.KubernetesToolsInfoBindingType,
+	"L2AutoRD":                                              model.L2AutoRDBindingType,
+	"L2BridgeEndpointProfile":                               model.L2BridgeEndpointProfileBindingType,
+	"L2BridgeEndpointProfileListResult":                     model.L2BridgeEndpointProfileListResultBindingType,
+	"L2Extension":                                           model.L2ExtensionBindingType,
+	"L2ForwarderRemoteMacsPerSite":                          model.L2ForwarderRemoteMacsPerSiteBindingType,
+	"L2ForwarderStatusPerNode":                              model.L2ForwarderStatusPerNodeBindingType,
+	"L2TcpMaxSegmentSizeClamping":                           model.L2TcpMaxSegmentSizeClampingBindingType,
+	"L2VPNService":                                          model.L2VPNServiceBindingType,
+	"L2VPNServiceListResult":                                model.L2VPNServiceListResultBindingType,
+	"L2VPNSession":                                          model.L2VPNSessionBindingType,
+	"L2VPNSessionData":                                      model.L2VPNSessionDataBindingType,
+	"L2VPNSessionListResult":                                model.L2VPNSessionListResultBindingType,
+	"L2VPNSessionPeerConfigNsxt":                            model.L2VPNSessionPeerConfigNsxtBindingType,
+	"L2VPNSessionPeerConfigPerEP":                           model.L2VPNSessionPeerConfigPerEPBindingType,
+	"L2VPNSessionStatisticsNsxt":                            model.L2VPNSessionStatisticsNsxtBindingType,
+	"L2VPNSessionStatisticsPerEP":                           model.L2VPNSessionStatisticsPerEPBindingType,
+	"L2VPNSessionStatusNsxt":                                model.L2VPNSessionStatusNsxtBindingType,
+	"L2VPNSessionStatusPerEP":                               model.L2VPNSessionStatusPerEPBindingType,
+	"L2VPNSessionTransportTunnelData":                       model.L2VPNSessionTransportTunnelDataBindingType,
+	"L2VPNSessionTransportTunnelPeerCode":                   model.L2VPNSessionTransportTunnelPeerCodeBindingType,
+	"L2VPNSessionTransportTunnelStatus":                     model.L2VPNSessionTransportTunnelStatusBindingType,
+	"L2VPNTapStatistics":                                    model.L2VPNTapStatisticsBindingType,
+	"L2VPNTrafficStatisticsPerSegment":                      model.L2VPNTrafficStatisticsPerSegmentBindingType,
+	"L2VPNTunnelEncapsulation":                              model.L2VPNTunnelEncapsulationBindingType,
+	"L2Vpn":                                                 model.L2VpnBindingType,
+	"L2VpnContext":                                          model.L2VpnContextBindingType,
+	"L2VpnListResult":                                       model.L2VpnListResultBindingType,
+	"L2VpnPeerCodes":                                        model.L2VpnPeerCodesBindingType,
+	"L2VpnPeerConfigPerEnforcementPoint":                    model.L2VpnPeerConfigPerEnforcementPointBindingType,
+	"L2VpnPerSegmentTrafficStatistics":                      model.L2VpnPerSegmentTrafficStatisticsBindingType,
+	"L2VpnSessionRemoteMacForSegment":                       model.L2VpnSessionRemoteMacForSegmentBindingType,
+	"L2VpnSessionRemoteMacNsxt":                             model.L2VpnSessionRemoteMacNsxtBindingType,
+	"L2VpnSessionRemoteMacPerEP":                            model.L2VpnSessionRemoteMacPerEPBindingType,
+	"L2VpnSessionStatistics":                                model.L2VpnSessionStatisticsBindingType,
+	"L2VpnStatisticsPerEnforcementPoint":                    model.L2VpnStatisticsPerEnforcementPointBindingType,
+	"L2VpnTapTrafficStatistics":                             model.L2VpnTapTrafficStatisticsBindingType,
+	"L2VpnTransportTunnelPeerCode":                          model.L2VpnTransportTunnelPeerCodeBindingType,
+	"L3Vpn":                                                 model.L3VpnBindingType,
+	"L3VpnContext":                                          model.L3VpnContextBindingType,
+	"L3VpnListResult":                                       model.L3VpnListResultBindingType,
+	"L3VpnPeerConfigRequestParameters":                      model.L3VpnPeerConfigRequestParametersBindingType,
+	"L3VpnRule":                                             model.L3VpnRuleBindingType,
+	"L3VpnSession":                                          model.L3VpnSessionBindingType,
+	"L3VpnStatisticsPerEnforcementPoint":                    model.L3VpnStatisticsPerEnforcementPointBindingType,
+	"L3VpnSubnet":                                           model.L3VpnSubnetBindingType,
+	"L4PortSetServiceEntry":                                 model.L4PortSetServiceEntryBindingType,
+	"L7AccessAttributes":                                    model.L7AccessAttributesBindingType,
+	"L7AccessEntry":                                         model.L7AccessEntryBindingType,
+	"L7AccessEntryListResult":                               model.L7AccessEntryListResultBindingType,
+	"L7AccessProfile":                                       model.L7AccessProfileBindingType,
+	"L7AccessProfileListResult":                             model.L7AccessProfileListResultBindingType,
+	"LBAccessListControl":                                   model.LBAccessListControlBindingType,
+	"LBActiveMonitor":                                       model.LBActiveMonitorBindingType,
+	"LBAppProfile":                                          model.LBAppProfileBindingType,
+	"LBAppProfileListResult":                                model.LBAppProfileListResultBindingType,
+	"LBClientCertificateIssuerDnCondition":                  model.LBClientCertificateIssuerDnConditionBindingType,
+	"LBClientCertificateSubjectDnCondition":                 model.LBClientCertificateSubjectDnConditionBindingType,
+	"LBClientSslProfile":                                    model.LBClientSslProfileBindingType,
+	"LBClientSslProfileBinding":                             model.LBClientSslProfileBindingBindingType,
+	"LBClientSslProfileListResult":                          model.LBClientSslProfileListResultBindingType,
+	"LBConnectionDropAction":                                model.LBConnectionDropActionBindingType,
+	"LBCookiePersistenceProfile":                            model.LBCookiePersistenceProfileBindingType,
+	"LBCookieTime":                                          model.LBCookieTimeBindingType,
+	"LBEdgeNodeCapacity":                                    model.LBEdgeNodeCapacityBindingType,
+	"LBEdgeNodeUsage":                                       model.LBEdgeNodeUsageBindingType,
+	"LBFastTcpProfile":                                      model.LBFastTcpProfileBindingType,
+	"LBFastUdpProfile":                                      model.LBFastUdpProfileBindingType,
+	"LBGenericPersistenceProfile":                           model.LBGenericPersistenceProfileBindingType,
+	"LBHttpMonitorProfile":                                  model.LBHttpMonitorProfileBindingType,
+	"LBHttpProfile":                                         model.LBHttpProfileBindingType,
+	"LBHttpRedirectAction":                                  model.LBHttpRedirectActionBindingType,
+	"LBHttpRejectAction":                                    model.LBHttpRejectActionBindingType,
+	"LBHttpRequestBodyCondition":                            model.LBHttpRequestBodyConditionBindingType,
+	"LBHttpRequestCookieCondition":                          model.LBHttpRequestCookieConditionBindingType,
+	"LBHttpRequestHeaderCondition":                          model.LBHttpRequestHeaderConditionBindingType,
+	"LBHttpRequestHeaderDeleteAction":                       model.LBHttpRequestHeaderDeleteActionBindingType,
+	"LBHttpRequestHeaderRewriteAction":                      model.LBHttpRequestHeaderRewriteActionBindingType,
+	"LBHttpRequestMethodCondition":                          model.LBHttpRequestMethodConditionBindingType,
+	"LBHttpRequestUriArgumentsCondition":                    model.LBHttpRequestUriArgumentsConditionBindingType,
+	"LBHttpRequestUriCondition":                             model.LBHttpRequestUriConditionBindingType,
+	"LBHttpRequestUriRewriteAction":                         model.LBHttpRequestUriRewriteActionBindingType,
+	"LBHttpRequestVersionCondition":                         model.LBHttpRequestVersionConditionBindingType,
+	"LBHttpResponseHeaderCondition":                         model.LBHttpResponseHeaderConditionBindingType,
+	"LBHttpResponseHeaderDeleteAction":                      model.LBHttpResponseHeaderDeleteActionBindingType,
+	"LBHttpResponseHeaderRewriteAction":                     model.LBHttpResponseHeaderRewriteActionBindingType,
+	"LBHttpSslCondition":                                    model.LBHttpSslConditionBindingType,
+	"LBHttpsMonitorProfile":                                 model.LBHttpsMonitorProfileBindingType,
+	"LBIcmpMonitorProfile":                                  model.LBIcmpMonitorProfileBindingType,
+	"LBIpHeaderCondition":                                   model.LBIpHeaderConditionBindingType,
+	"LBJwtAuthAction":                                       model.LBJwtAuthActionBindingType,
+	"LBJwtCertificateKey":                                   model.LBJwtCertificateKeyBindingType,
+	"LBJwtKey":                                              model.LBJwtKeyBindingType,
+	"LBJwtPublicKey":                                        model.LBJwtPublicKeyBindingType,
+	"LBJwtSymmetricKey":                                     model.LBJwtSymmetricKeyBindingType,
+	"LBMonitorProfile":                                      model.LBMonitorProfileBindingType,
+	"LBMonitorProfileListResult":                            model.LBMonitorProfileListResultBindingType,
+	"LBNodeCapacity":                                        model.LBNodeCapacityBindingType,
+	"LBNodeCapacitySummary":                                 model.LBNodeCapacitySummaryBindingType,
+	"LBNodeCountPerSeverity":                                model.LBNodeCountPerSeverityBindingType,
+	"LBNodeUsage":                                           model.LBNodeUsageBindingType,
+	"LBNodeUsageSummary":                                    model.LBNodeUsageSummaryBindingType,
+	"LBPassiveMonitorProfile":                               model.LBPassiveMonitorProfileBindingType,
+	"LBPersistenceCookieTime":                               model.LBPersistenceCookieTimeBindingType,
+	"LBPersistenceProfile":                                  model.LBPersistenceProfileBindingType,
+	"LBPersistenceProfileListResult":                        model.LBPersistenceProfileListResultBindingType,
+	"LBPool":                                                model.LBPoolBindingType,
+	"LBPoolListResult":                                      model.LBPoolListResultBindingType,
+	"LBPoolMember":                                          model.LBPoolMemberBindingType,
+	"LBPoolMemberGroup":                                     model.LBPoolMemberGroupBindingType,
+	"LBPoolMemberStatistics":                                model.LBPoolMemberStatisticsBindingType,
+	"LBPoolMemberStatus":                                    model.LBPoolMemberStatusBindingType,
+	"LBPoolStatistics":                                      model.LBPoolStatisticsBindingType,
+	"LBPoolStatisticsPerEP":                                 model.LBPoolStatisticsPerEPBindingType,
+	"LBPoolStatus":                                          model.LBPoolStatusBindingType,
+	"LBPoolStatusPerEP":                                     model.LBPoolStatusPerEPBindingType,
+	"LBRule":                                                model.LBRuleBindingType,
+	"LBRuleAction":                                          model.LBRuleActionBindingType,
+	"LBRuleCondition":                                       model.LBRuleConditionBindingType,
+	"LBSelectPoolAction":                                    model.LBSelectPoolActionBindingType,
+	"LBServerSslProfile":                                    model.LBServerSslProfileBindingType,
+	"LBServerSslProfileBinding":                             model.LBServerSslProfileBindingBindingType,
+	"LBServerSslProfileListResult":                          model.LBServerSslProfileListResultBindingType,
+	"LBService":                                             model.LBServiceBindingType,
+	"LBServiceApplianceNodeCapacity":                        model.LBServiceApplianceNodeCapacityBindingType,
+	"LBServiceCountPerSeverity":                             model.LBServiceCountPerSeverityBindingType,
+	"LBServiceDebugInfo":                                    model.LBServiceDebugInfoBindingType,
+	"LBServiceInstanceDetail":                               model.LBServiceInstanceDetailBindingType,
+	"LBServiceInstanceDetailPerStatus":                      model.LBServiceInstanceDetailPerStatusBindingType,
+	"LBServiceInstanceDetailPerTransportNode":               model.LBServiceInstanceDetailPerTransportNodeBindingType,
+	"LBServiceListResult":                                   model.LBServiceListResultBindingType,
+	"LBServiceStatistics":                                   model.LBServiceStatisticsBindingType,
+	"LBServiceStatisticsCounter":                            model.LBServiceStatisticsCounterBindingType,
+	"LBServiceStatisticsPerEP":                              model.LBServiceStatisticsPerEPBindingType,
+	"LBServiceStatus":                                       model.LBServiceStatusBindingType,
+	"LBServiceStatusPerEP":                                  model.LBServiceStatusPerEPBindingType,
+	"LBServiceUsage":                                        model.LBServiceUsageBindingType,
+	"LBServiceUsagePerEP":                                   model.LBServiceUsagePerEPBindingType,
+	"LBServiceUsageSummary":                                 model.LBServiceUsageSummaryBindingType,
+	"LBSessionCookieTime":                                   model.LBSessionCookieTimeBindingType,
+	"LBSnatAutoMap":                                         model.LBSnatAutoMapBindingType,
+	"LBSnatDisabled":                                        model.LBSnatDisabledBindingType,
+	"LBSnatIpElement":                                       model.LBSnatIpElementBindingType,
+	"LBSnatIpPool":                                          model.LBSnatIpPoolBindingType,
+	"LBSnatTranslation":                                     model.LBSnatTranslationBindingType,
+	"LBSourceIpPersistenceProfile":                          model.LBSourceIpPersistenceProfileBindingType,
+	"LBSslModeSelectionAction":                              model.LBSslModeSelectionActionBindingType,
+	"LBSslProfile":                                          model.LBSslProfileBindingType,
+	"LBSslSniCondition":                                     model.LBSslSniConditionBindingType,
+	"LBStatisticsCounter":                                   model.LBStatisticsCounterBindingType,
+	"LBTcpHeaderCondition":                                  model.LBTcpHeaderConditionBindingType,
+	"LBTcpMonitorProfile":                                   model.LBTcpMonitorProfileBindingType,
+	"LBUdpMonitorProfile":                                   model.LBUdpMonitorProfileBindingType,
+	"LBVariableAssignmentAction":                            model.LBVariableAssignmentActionBindingType,
+	"LBVariableCondition":                                   model.LBVariableConditionBindingType,
+	"LBVariablePersistenceLearnAction":                      model.LBVariablePersistenceLearnActionBindingType,
+	"LBVariablePersistenceOnAction":                         model.LBVariablePersistenceOnActionBindingType,
+	"LBVirtualServer":                                       model.LBVirtualServerBindingType,
+	"LBVirtualServerListResult":                             model.LBVirtualServerListResultBindingType,
+	"LBVirtualServerStatistics":                             model.LBVirtualServerStatisticsBindingType,
+	"LBVirtualServerStatisticsPerEP":                        model.LBVirtualServerStatisticsPerEPBindingType,
+	"LBVirtualServerStatus":                                 model.LBVirtualServerStatusBindingType,
+	"LBVirtualServerStatusPerEP":                            model.LBVirtualServerStatusPerEPBindingType,
+	"Label":                                                 model.LabelBindingType,
+	"LabelValueConfiguration":                               model.LabelValueConfigurationBindingType,
+	"LacpMigrationConfig":                                   model.LacpMigrationConfigBindingType,
+	"Lag":                                                   model.LagBindingType,
+	"LatencyMeasurement":                                    model.LatencyMeasurementBindingType,
+	"LatencyMeasurementConfig":                              model.LatencyMeasurementConfigBindingType,
+	"Layout":                                                model.LayoutBindingType,
+	"LayoutProperties":                                      model.LayoutPropertiesBindingType,
+	"LbHttpRequestHeader":                                   model.LbHttpRequestHeaderBindingType,
+	"LbSslCipherAndProtocolListResult":                      model.LbSslCipherAndProtocolListResultBindingType,
+	"LbSslCipherInfo":                                       model.LbSslCipherInfoBindingType,
+	"LbSslProtocolInfo":                                     model.LbSslProtocolInfoBindingType,
+	"LdapIdentitySource":                                    model.LdapIdentitySourceBindingType,
+	"LdapIdentitySourceListResult":                          model.LdapIdentitySourceListResultBindingType,
+	"LdapIdentitySourceProbeResults":                        model.LdapIdentitySourceProbeResultsBindingType,
+	"LdapIdentitySourceSearchResultItem":                    model.LdapIdentitySourceSearchResultItemBindingType,
+	"LdapIdentitySourceSearchResultList":                    model.LdapIdentitySourceSearchResultListBindingType,
+	"LdapProbeError":                                        model.LdapProbeErrorBindingType,
+	"Legend":                                                model.LegendBindingType,
+	"LegendWidgetConfiguration":                             model.LegendWidgetConfigurationBindingType,
+	"Limit":                                                 model.LimitBindingType,
+	"LimitListResult":                                       model.LimitListResultBindingType,
+	"LimitState":                                            model.LimitStateBindingType,
+	"LimitStateListResult":                                  model.LimitStateListResultBindingType,
+	"LimitStateListResultForVpc":                            model.LimitStateListResultForVpcBindingType,
+	"LimitStateListResultForVpcs":                           model.LimitStateListResultForVpcsBindingType,
+	"LineChartPlotConfiguration":                            model.LineChartPlotConfigurationBindingType,
+	"ListResult":                                            model.ListResultBindingType,
+	"LiveTraceActionConfig":                                 model.LiveTraceActionConfigBindingType,
+	"LiveTraceConfig":                                       model.LiveTraceConfigBindingType,
+	"LiveTraceConfigListResult":                             model.LiveTraceConfigListResultBindingType,
+	"LiveTraceFilterData":                                   model.LiveTraceFilterDataBindingType,
+	"LiveTraceFilterInvalidInfo":                            model.LiveTraceFilterInvalidInfoBindingType,
+	"LiveTraceIpsecVpnConfig":                               model.LiveTraceIpsecVpnConfigBindingType,
+	"LiveTraceListResult":                                   model.LiveTraceListResultBindingType,
+	"LiveTracePacketGranularActionConfig":                   model.LiveTracePacketGranularActionConfigBindingType,
+	"LiveTraceResult":                                       model.LiveTraceResultBindingType,
+	"LiveTraceSamplingConfig":                               model.LiveTraceSamplingConfigBindingType,
+	"LiveTraceStatus":                                       model.LiveTraceStatusBindingType,
+	"LoadBalancerVPCEndpoint":                               model.LoadBalancerVPCEndpointBindingType,
+	"LocalEgress":                                           model.LocalEgressBindingType,
+	"LocalEgressRoutingEntry":                               model.LocalEgressRoutingEntryBindingType,
+	"LocalWorkloadsTransmittedReceivedBroadcastPackets":     model.LocalWorkloadsTransmittedReceivedBroadcastPacketsBindingType,
+	"LocalWorkloadsTransmittedReceivedMulticastPackets":     model.LocalWorkloadsTransmittedReceivedMulticastPacketsBindingType,
+	"LocalWorkloadsTransmittedReceivedPackets":              model.LocalWorkloadsTransmittedReceivedPacketsBindingType,
+	"LocaleServices":                                        model.LocaleServicesBindingType,
+	"LocaleServicesListResult":                              model.LocaleServicesListResultBindingType,
+	"LogicalPortOperationalStatus":                          model.LogicalPortOperationalStatusBindingType,
+	"LogicalPortStatistics":                                 model.LogicalPortStatisticsBindingType,
+	"LogicalRouterPortCounters":                             model.LogicalRouterPortCountersBindingType,
+	"LogicalRouterPortStatistics":                           model.LogicalRouterPortStatisticsBindingType,
+	"LogicalRouterPortStatisticsPerNode":                    model.LogicalRouterPortStatisticsPerNodeBindingType,
+	"LogicalRouterPortStatisticsSummary":                    model.LogicalRouterPortStatisticsSummaryBindingType,
+	"LogicalRouterState":                                    model.LogicalRouterStateBindingType,
+	"LogicalRouterStatus":                                   model.LogicalRouterStatusBindingType,
+	"LogicalRouterStatusPerNode":                            model.LogicalRouterStatusPerNodeBindingType,
+	"LogicalSwitchStatistics":                               model.LogicalSwitchStatisticsBindingType,
+	"LoginLogoutEventCollection":                            model.LoginLogoutEventCollectionBindingType,
+	"LoginLogoutEventMap":                                   model.LoginLogoutEventMapBindingType,
+	"MACAddressExpression":                                  model.MACAddressExpressionBindingType,
+	"MACAddressList":                                        model.MACAddressListBindingType,
+	"MacAddressCsvListResult":                               model.MacAddressCsvListResultBindingType,
+	"MacDiscoveryProfile":                                   model.MacDiscoveryProfileBindingType,
+	"MacDiscoveryProfileListResult":                         model.MacDiscoveryProfileListResultBindingType,
+	"MacLearningCounters":                                   model.MacLearningCountersBindingType,
+	"MacTableCsvRecord":                                     model.MacTableCsvRecordBindingType,
+	"MacTableEntry":                                         model.MacTableEntryBindingType,
+	"MalwarePreventionProfile":                              model.MalwarePreventionProfileBindingType,
+	"MalwarePreventionProfileListResult":                    model.MalwarePreventionProfileListResultBindingType,
+	"MalwarePreventionSignature":                            model.MalwarePreventionSignatureBindingType,
+	"MalwarePreventionSignatureListResult":                  model.MalwarePreventionSignatureListResultBindingType,
+	"MalwarePreventionTeaserCloudRegions":                   model.MalwarePreventionTeaserCloudRegionsBindingType,
+	"MalwarePreventionTeaserRegionsListResult":              model.MalwarePreventionTeaserRegionsListResultBindingType,
+	"MalwarePreventionTeaserRegistrationStatus":             model.MalwarePreventionTeaserRegistrationStatusBindingType,
+	"MalwarePreventionTeaserRegistrationURL":                model.MalwarePreventionTeaserRegistrationURLBindingType,
+	"ManagedResource":                                       model.ManagedResourceBindingType,
+	"ManagementConfig":                                      model.ManagementConfigBindingType,
+	"ManagementInterfaceEndpointState":                      model.ManagementInterfaceEndpointStateBindingType,
+	"MemoryReservation":                                     model.MemoryReservationBindingType,
+	"MetadataProxyConfig":                                   model.MetadataProxyConfigBindingType,
+	"MetadataProxyConfigListResult":                         model.MetadataProxyConfigListResultBindingType,
+	"MetadataProxyStatisticsPerSegment":                     model.MetadataProxyStatisticsPerSegmentBindingType,
+	"MetricsAgent":                                          model.MetricsAgentBindingType,
+	"MirrorStackStatusListResult":                           model.MirrorStackStatusListResultBindingType,
+	"MiscConfig":                                            model.MiscConfigBindingType,
+	"MitreAttack":                                           model.MitreAttackBindingType,
+	"MonitoringError":                                       model.MonitoringErrorBindingType,
+	"MonitoringInfo":                                        model.MonitoringInfoBindingType,
+	"MonitoringProfileBindingMap":                           model.MonitoringProfileBindingMapBindingType,
+	"MpOspfExternalLinkState":                               model.MpOspfExternalLinkStateBindingType,
+	"MpOspfNetworkLinkStates":                               model.MpOspfNetworkLinkStatesBindingType,
+	"MpOspfRouterLinkStates":                                model.MpOspfRouterLinkStatesBindingType,
+	"MpOspfSummaryLinkStates":                               model.MpOspfSummaryLinkStatesBindingType,
+	"MpsComputeCollectionStatus":                            model.MpsComputeCollectionStatusBindingType,
+	"MpsErrorClass":                                         model.MpsErrorClassBindingType,
+	"MpsOvfSpec":                                            model.MpsOvfSpecBindingType,
+	"MpsOvfSpecListResult":                                  model.MpsOvfSpecListResultBindingType,
+	"MpsSubClusterSvmPropertiesPair":                        model.MpsSubClusterSvmPropertiesPairBindingType,
+	"MpsSvmConfig":                                          model.MpsSvmConfigBindingType,
+	"MpsSvmDeploymentSpec":                                  model.MpsSvmDeploymentSpecBindingType,
+	"MpsSvmNewApplianceSpec":                                model.MpsSvmNewApplianceSpecBindingType,
+	"MpsSvmProperties":                                      model.MpsSvmPropertiesBindingType,
+	"MpsTnComponentHealthStatus":                            model.MpsTnComponentHealthStatusBindingType,
+	"MpsTransportNodeStatus":                                model.MpsTransportNodeStatusBindingType,
+	"MultiWidgetConfiguration":                              model.MultiWidgetConfigurationBindingType,
+	"MulticastForwarding":                                   model.MulticastForwardingBindingType,
+	"MulticastForwardingCsvRecord":                          model.MulticastForwardingCsvRecordBindingType,
+	"MulticastForwardingInterface":                          model.MulticastForwardingInterfaceBindingType,
+	"MulticastForwardingPerEdge":                            model.MulticastForwardingPerEdgeBindingType,
+	"MulticastRoute":                                        model.MulticastRouteBindingType,
+	"MulticastRouteCsvRecord":                               model.MulticastRouteCsvRecordBindingType,
+	"MulticastRoutesPerEdge":                                model.MulticastRoutesPerEdgeBindingType,
+	"NDRAAdvertisedRoute":                                   model.NDRAAdvertisedRouteBindingType,
+	"NSXManagerDeploymentInfo":                              model.NSXManagerDeploymentInfoBindingType,
+	"NSXTConnectionInfo":                                    model.NSXTConnectionInfoBindingType,
+	"NSXVConnectionInfo":                                    model.NSXVConnectionInfoBindingType,
+	"NamedTeamingPolicy":                                    model.NamedTeamingPolicyBindingType,
+	"NamespaceMemberDetails":                                model.NamespaceMemberDetailsBindingType,
+	"NappRegistration":                                      model.NappRegistrationBindingType,
+	"NappRegistrationList":                                  model.NappRegistrationListBindingType,
+	"NatServiceEntry":                                       model.NatServiceEntryBindingType,
+	"NdSnoopingConfig":                                      model.NdSnoopingConfigBindingType,
+	"NdpHeader":                                             model.NdpHeaderBindingType,
+	"NeighborProperties":                                    model.NeighborPropertiesBindingType,
+	"NestedExpression":                                      model.NestedExpressionBindingType,
+	"NestedServiceServiceEntry":                             model.NestedServiceServiceEntryBindingType,
+	"NetworkInfo":                                           model.NetworkInfoBindingType,
+	"NetworkPolicyImportRequest":                            model.NetworkPolicyImportRequestBindingType,
+	"NetworkPolicyImportResponse":                           model.NetworkPolicyImportResponseBindingType,
+	"NewRole":                                               model.NewRoleBindingType,
+	"NicMetadata":                                           model.NicMetadataBindingType,
+	"NoAssignment":                                          model.NoAssignmentBindingType,
+	"NoIpv4":                                                model.NoIpv4BindingType,
+	"NoIpv6":                                                model.NoIpv6BindingType,
+	"NodeFileSystemProperties":                              model.NodeFileSystemPropertiesBindingType,
+	"NodeInterfaceNeighborProperties":                       model.NodeInterfaceNeighborPropertiesBindingType,
+	"NodeResources":                                         model.NodeResourcesBindingType,
+	"NodeStatus":                                            model.NodeStatusBindingType,
+	"NodeStatusProperties":                                  model.NodeStatusPropertiesBindingType,
+	"NodesInterfaceNeighborPropertyListResult":              model.NodesInterfaceNeighborPropertyListResultBindingType,
+	"NorthSouthFirewall":                                    model.NorthSouthFirewallBindingType,
+	"NsxRole":                                               model.NsxRoleBindingType,
+	"NsxTDNSForwarderStatistics":                            model.NsxTDNSForwarderStatisticsBindingType,
+	"NsxTDNSForwarderStatus":                                model.NsxTDNSForwarderStatusBindingType,
+	"NsxTDNSForwarderZoneStatistics":                        model.NsxTDNSForwarderZoneStatisticsBindingType,
+	"NsxtDnsAnswer":                                         model.NsxtDnsAnswerBindingType,
+	"NsxtDnsQueryAnswer":                                    model.NsxtDnsQueryAnswerBindingType,
+	"NsxtPerNodeUsedCacheStatistics":                        model.NsxtPerNodeUsedCacheStatisticsBindingType,
+	"NsxtUpstreamServerStatistics":                          model.NsxtUpstreamServerStatisticsBindingType,
+	"ObjectRolePermissionGroup":                             model.ObjectRolePermissionGroupBindingType,
+	"ObjectRolePermissionGroupListResult":                   model.ObjectRolePermissionGroupListResultBindingType,
+	"ObservabilityCounterDebugQueryResult":                  model.ObservabilityCounterDebugQueryResultBindingType,
+	"ObservabilityCounterMonitorQueryResult":                model.ObservabilityCounterMonitorQueryResultBindingType,
+	"OdsDynamicRunbook":                                     model.OdsDynamicRunbookBindingType,
+	"OdsDynamicRunbookInstance":                             model.OdsDynamicRunbookInstanceBindingType,
+	"OdsDynamicRunbookInstanceState":                        model.OdsDynamicRunbookInstanceStateBindingType,
+	"OdsDynamicRunbookListResult":                           model.OdsDynamicRunbookListResultBindingType,
+	"OdsDynamicRunbookNodeInstallStatus":                    model.OdsDynamicRunbookNodeInstallStatusBindingType,
+	"OdsDynamicdRunbookInstanceListResult":                  model.OdsDynamicdRunbookInstanceListResultBindingType,
+	"OdsPredefinedRunbook":                                  model.OdsPredefinedRunbookBindingType,
+	"OdsPredefinedRunbookListResult":                        model.OdsPredefinedRunbookListResultBindingType,
+	"OdsRunbookArtifactListResult":                          model.OdsRunbookArtifactListResultBindingType,
+	"OdsRunbookArtifactStatus":                              model.OdsRunbookArtifactStatusBindingType,
+	"OdsRunbookCompoundParameter":                           model.OdsRunbookCompoundParameterBindingType,
+	"OdsRunbookEnumParameter":                               model.OdsRunbookEnumParameterBindingType,
+	"OdsRunbookHelpInfo":                                    model.OdsRunbookHelpInfoBindingType,
+	"OdsRunbookIntegerParameter":                            model.OdsRunbookIntegerParameterBindingType,
+	"OdsRunbookInvocation":                                  model.OdsRunbookInvocationBindingType,
+	"OdsRunbookInvocationArtifactBatchRequest":              model.OdsRunbookInvocationArtifactBatchRequestBindingType,
+	"OdsRunbookInvocationArtifactBatchResult":               model.OdsRunbookInvocationArtifactBatchResultBindingType,
+	"OdsRunbookInvocationArtifactListResult":                model.OdsRunbookInvocationArtifactListResultBindingType,
+	"OdsRunbookInvocationListResult":                        model.OdsRunbookInvocationListResultBindingType,
+	"OdsRunbookInvocationReport":                            model.OdsRunbookInvocationReportBindingType,
+	"OdsRunbookInvocationStatus":                            model.OdsRunbookInvocationStatusBindingType,
+	"OdsRunbookInvocationStepInfo":                          model.OdsRunbookInvocationStepInfoBindingType,
+	"OdsRunbookParameter":                                   model.OdsRunbookParameterBindingType,
+	"OdsRunbookParameterHelpInfo":                           model.OdsRunbookParameterHelpInfoBindingType,
+	"OdsRunbookParameterRange":                              model.OdsRunbookParameterRangeBindingType,
+	"OdsRunbookPatternParameter":                            model.OdsRunbookPatternParameterBindingType,
+	"OdsRunbookSetting":                                     model.OdsRunbookSettingBindingType,
+	"OdsRunbookSettingData":                                 model.OdsRunbookSettingDataBindingType,
+	"OdsRunbookSettingListResult":                           model.OdsRunbookSettingListResultBindingType,
+	"OdsRunbookStringParameter":                             model.OdsRunbookStringParameterBindingType,
+	"OdsRunbookSysInfo":                                     model.OdsRunbookSysInfoBindingType,
+	"OdsRunbookVersion":                                     model.OdsRunbookVersionBindingType,
+	"OpenLdapIdentitySource":                                model.OpenLdapIdentitySourceBindingType,
+	"OperationVerticalConfig":                               model.OperationVerticalConfigBindingType,
+	"OpsGlobalConfig":                                       model.OpsGlobalConfigBindingType,
+	"Org":                                                   model.OrgBindingType,
+	"OrgListResult":                                         model.OrgListResultBindingType,
+	"OrgRoot":                                               model.OrgRootBindingType,
+	"OspfAreaConfig":                                        model.OspfAreaConfigBindingType,
+	"OspfAreaConfigListResult":                              model.OspfAreaConfigListResultBindingType,
+	"OspfAuthenticationConfig":                              model.OspfAuthenticationConfigBindingType,
+	"OspfDabtabaseCsvRecord":                                model.OspfDabtabaseCsvRecordBindingType,
+	"OspfDatabaseListResultInCsvFormat":                     model.OspfDatabaseListResultInCsvFormatBindingType,
+	"OspfDatabaseStatus":                                    model.OspfDatabaseStatusBindingType,
+	"OspfNeighbor":                                          model.OspfNeighborBindingType,
+	"OspfNeighborStatus":                                    model.OspfNeighborStatusBindingType,
+	"OspfNeighborsStatusListResult":                         model.OspfNeighborsStatusListResultBindingType,
+	"OspfRoute":                                             model.OspfRouteBindingType,
+	"OspfRouteDetailsInCsvRecord":                           model.OspfRouteDetailsInCsvRecordBindingType,
+	"OspfRouteNextHopResult":                                model.OspfRouteNextHopResultBindingType,
+	"OspfRoutes":                                            model.OspfRoutesBindingType,
+	"OspfRoutesListResult":                                  model.OspfRoutesListResultBindingType,
+	"OspfRoutesListResultInCsvFormat":                       model.OspfRoutesListResultInCsvFormatBindingType,
+	"OspfRoutingConfig":                                     model.OspfRoutingConfigBindingType,
+	"OspfStatusInfo":                                        model.OspfStatusInfoBindingType,
+	"OspfSummaryAddressConfig":                              model.OspfSummaryAddressConfigBindingType,
+	"OtherCidrsMsg":                                         model.OtherCidrsMsgBindingType,
+	"OverlayDataPathL2":                                     model.OverlayDataPathL2BindingType,
+	"OverriddenResource":                                    model.OverriddenResourceBindingType,
+	"OverriddenResourceListResult":                          model.OverriddenResourceListResultBindingType,
+	"OverriddenSignature":                                   model.OverriddenSignatureBindingType,
+	"OverrideListRequestParameters":                         model.OverrideListRequestParametersBindingType,
+	"PacketAddressClassifier":                               model.PacketAddressClassifierBindingType,
+	"PacketData":                                            model.PacketDataBindingType,
+	"PacketDebugStats":                                      model.PacketDebugStatsBindingType,
+	"PacketMonitorStats":                                    model.PacketMonitorStatsBindingType,
+	"PacketNumberSampling":                                  model.PacketNumberSamplingBindingType,
+	"PacketProcessingEfficiencyWatch":                       model.PacketProcessingEfficiencyWatchBindingType,
+	"PacketTypeAndCounter":                                  model.PacketTypeAndCounterBindingType,
+	"PacketsDroppedBySecurity":                              model.PacketsDroppedBySecurityBindingType,
+	"ParallelRedundancyProt":                                model.ParallelRedundancyProtBindingType,
+	"ParallelRedundancyProtocolConfig":                      model.ParallelRedundancyProtocolConfigBindingType,
+	"PartialPatchConfig":                                    model.PartialPatchConfigBindingType,
+	"PatchResources":                                        model.PatchResourcesBindingType,
+	"PathExpression":                                        model.PathExpressionBindingType,
+	"PathPermissionGroup":                                   model.PathPermissionGroupBindingType,
+	"PathPrefixListRequestParameter":                        model.PathPrefixListRequestParameterBindingType,
+	"PeerCertificateChain":                                  model.PeerCertificateChainBindingType,
+	"PendingChangesInfoNsxt":                                model.PendingChangesInfoNsxtBindingType,
+	"PerNodeDnsFailedQueries":                               model.PerNodeDnsFailedQueriesBindingType,
+	"PerNodeShaPluginStatusListResult":                      model.PerNodeShaPluginStatusListResultBindingType,
+	"PerStepRestoreStatus":                                  model.PerStepRestoreStatusBindingType,
+	"PimRpMapping":                                          model.PimRpMappingBindingType,
+	"PimRpMappingCsvRecord":                                 model.PimRpMappingCsvRecordBindingType,
+	"PimRpMappings":                                         model.PimRpMappingsBindingType,
+	"PimRpMappingsInCsvFormat":                              model.PimRpMappingsInCsvFormatBindingType,
+	"PimRpMappingsPerEdge":                                  model.PimRpMappingsPerEdgeBindingType,
+	"PktCapResource":                                        model.PktCapResourceBindingType,
+	"PktCapResult":                                          model.PktCapResultBindingType,
+	"PktCaptureOption":                                      model.PktCaptureOptionBindingType,
+	"PktCaptureOptionList":                                  model.PktCaptureOptionListBindingType,
+	"PktCaptureRequest":                                     model.PktCaptureRequestBindingType,
+	"PktCaptureSession":                                     model.PktCaptureSessionBindingType,
+	"PktCaptureSessionList":                                 model.PktCaptureSessionListBindingType,
+	"PktcapActionArgument":                                  model.PktcapActionArgumentBindingType,
+	"PktcapActionConfig":                                    model.PktcapActionConfigBindingType,
+	"PlainFilterData":                                       model.PlainFilterDataBindingType,
+	"PlatformCpuStats":                                      model.PlatformCpuStatsBindingType,
+	"PlatformCpuUsageDebugStats":                            model.PlatformCpuUsageDebugStatsBindingType,
+	"PlatformCpuUsageMonitorStats":                          model.PlatformCpuUsageMonitorStatsBindingType,
+	"PlatformDeploymentChecksAction":                        model.PlatformDeploymentChecksActionBindingType,
+	"PlatformDeploymentConfig":                              model.PlatformDeploymentConfigBindingType,
+	"PlatformFormFactors":                                   model.PlatformFormFactorsBindingType,
+	"PlatformNetworkThreadCpuStats":                         model.PlatformNetworkThreadCpuStatsBindingType,
+	"PlatformPacketStats":                                   model.PlatformPacketStatsBindingType,
+	"PluginStatusEntry":                                     model.PluginStatusEntryBindingType,
+	"PmaasCompatibilityCheckListResult":                     model.PmaasCompatibilityCheckListResultBindingType,
+	"PmaasCompatibilityCheckResult":                         model.PmaasCompatibilityCheckResultBindingType,
+	"PmaasProjectCreationCheckListResult":                   model.PmaasProjectCreationCheckListResultBindingType,
+	"PmaasProjectCreationCheckResult":                       model.PmaasProjectCreationCheckResultBindingType,
+	"PmaasSiteOffBoardingStatus":                            model.PmaasSiteOffBoardingStatusBindingType,
+	"Pnic":                                                  model.PnicBindingType,
+	"PnicBondStatus":                                        model.PnicBondStatusBindingType,
+	"PnicBondStatusListResult":                              model.PnicBondStatusListResultBindingType,
+	"PnicsTransmittedReceivedPackets":                       model.PnicsTransmittedReceivedPacketsBindingType,
+	"PointDefinition":                                       model.PointDefinitionBindingType,
+	"Policy":                                                model.PolicyBindingType,
+	"PolicyAdvertisedNetwork":                               model.PolicyAdvertisedNetworkBindingType,
+	"PolicyAdvertisedNetworkInCsvFormat":                    model.PolicyAdvertisedNetworkInCsvFormatBindingType,
+	"PolicyAdvertisedNetworksListResult":                    model.PolicyAdvertisedNetworksListResultBindingType,
+	"PolicyAlarmResource":                                   model.PolicyAlarmResourceBindingType,
+	"PolicyAlarmResourceListResult":                         model.PolicyAlarmResourceListResultBindingType,
+	"PolicyAllocatedService":                                model.PolicyAllocatedServiceBindingType,
+	"PolicyAntreaEgress":                                    model.PolicyAntreaEgressBindingType,
+	"PolicyAntreaIPPool":                                    model.PolicyAntreaIPPoolBindingType,
+	"PolicyAntreaIPPoolIPRange":                             model.PolicyAntreaIPPoolIPRangeBindingType,
+	"PolicyApiError":                                        model.PolicyApiErrorBindingType,
+	"PolicyArpProxyEntry":                                   model.PolicyArpProxyEntryBindingType,
+	"PolicyArpProxyTableCsvListResult":                      model.PolicyArpProxyTableCsvListResultBindingType,
+	"PolicyArpProxyTableListResult":                         model.PolicyArpProxyTableListResultBindingType,
+	"PolicyAttributes":                                      model.PolicyAttributesBindingType,
+	"PolicyAutomaticHealthCheckListResult":                  model.PolicyAutomaticHealthCheckListResultBindingType,
+	"PolicyAutomaticHealthCheckResult":                      model.PolicyAutomaticHealthCheckResultBindingType,
+	"PolicyBaseHostSwitchProfile":                           model.PolicyBaseHostSwitchProfileBindingType,
+	"PolicyBasedIPSecVpnSession":                            model.PolicyBasedIPSecVpnSessionBindingType,
+	"PolicyBasedL3VpnSession":                               model.PolicyBasedL3VpnSessionBindingType,
+	"PolicyBgpNeighborStatus":                               model.PolicyBgpNeighborStatusBindingType,
+	"PolicyBgpNeighborsStatusListResult":                    model.PolicyBgpNeighborsStatusListResultBindingType,
+	"PolicyCapacityDashboardUsage":                          model.PolicyCapacityDashboardUsageBindingType,
+	"PolicyCapacityUsageResponse":                           model.PolicyCapacityUsageResponseBindingType,
+	"PolicyComplianceStatus":                                model.PolicyComplianceStatusBindingType,
+	"PolicyConfigResource":                                  model.PolicyConfigResourceBindingType,
+	"PolicyContainerGroupMemberDetails":                     model.PolicyContainerGroupMemberDetailsBindingType,
+	"PolicyContainerGroupMembersListResult":                 model.PolicyContainerGroupMembersListResultBindingType,
+	"PolicyContextProfile":                                  model.PolicyContextProfileBindingType,
+	"PolicyContextProfileListResult":                        model.PolicyContextProfileListResultBindingType,
+	"PolicyCountObservation":                                model.PolicyCountObservationBindingType,
+	"PolicyCustomAttributes":                                model.PolicyCustomAttributesBindingType,
+	"PolicyDnsAnswerPerEnforcementPoint":                    model.PolicyDnsAnswerPerEnforcementPointBindingType,
+	"PolicyDnsFailedQueries":                                model.PolicyDnsFailedQueriesBindingType,
+	"PolicyDnsForwarder":                                    model.PolicyDnsForwarderBindingType,
+	"PolicyDnsForwarderZone":                                model.PolicyDnsForwarderZoneBindingType,
+	"PolicyDnsForwarderZoneListResult":                      model.PolicyDnsForwarderZoneListResultBindingType,
+	"PolicyDraft":                                           model.PolicyDraftBindingType,
+	"PolicyDraftListResult":                                 model.PolicyDraftListResultBindingType,
+	"PolicyDraftModifications":                              model.PolicyDraftModificationsBindingType,
+	"PolicyDraftPaginatedAggregatedConfigurationResult":     model.PolicyDraftPaginatedAggregatedConfigurationResultBindingType,
+	"PolicyEdgeCluster":                                     model.PolicyEdgeClusterBindingType,
+	"PolicyEdgeClusterAllocationStatus":                     model.PolicyEdgeClusterAllocationStatusBindingType,
+	"PolicyEdgeClusterInterSiteBgpSummary":                  model.PolicyEdgeClusterInterSiteBgpSummaryBindingType,
+	"PolicyEdgeClusterInterSiteStatus":                      model.PolicyEdgeClusterInterSiteStatusBindingType,
+	"PolicyEdgeClusterListResult":                           model.PolicyEdgeClusterListResultBindingType,
+	"PolicyEdgeClusterMember":                               model.PolicyEdgeClusterMemberBindingType,
+	"PolicyEdgeClusterMemberInterSiteBgpSummary":            model.PolicyEdgeClusterMemberInterSiteBgpSummaryBindingType,
+	"PolicyEdgeClusterMemberInterSiteStatus":                model.PolicyEdgeClusterMemberInterSiteStatusBindingType,
+	"PolicyEdgeClusterMemberRemoteTunnelConnectivityStatus": model.PolicyEdgeClusterMemberRemoteTunnelConnectivityStatusBindingType,
+	"PolicyEdgeClusterMemberState":                          model.PolicyEdgeClusterMemberStateBindingType,
+	"PolicyEdgeClusterMemberStatus":                         model.PolicyEdgeClusterMemberStatusBindingType,
+	"PolicyEdgeClusterRemoteTunnelBgpSummary":               model.PolicyEdgeClusterRemoteTunnelBgpSummaryBindingType,
+	"PolicyEdgeClusterRemoteTunnelConnectivityStatus":       model.PolicyEdgeClusterRemoteTunnelConnectivityStatusBindingType,
+	"PolicyEdgeClusterState":                                model.PolicyEdgeClusterStateBindingType,
+	"PolicyEdgeClusterStatus":                               model.PolicyEdgeClusterStatusBindingType,
+	"PolicyEdgeHighAvailabilityProfile":                     model.PolicyEdgeHighAvailabilityProfileBindingType,
+	"PolicyEdgeMemberAllocationStatus":                      model.PolicyEdgeMemberAllocationStatusBindingType,
+	"PolicyEdgeNode":                                        model.PolicyEdgeNodeBindingType,
+	"PolicyEdgeNodeInterSiteBgpSummary":                     model.PolicyEdgeNodeInterSiteBgpSummaryBindingType,
+	"PolicyEdgeNodeListResult":                              model.PolicyEdgeNodeListResultBindingType,
+	"PolicyEdgeNodeRelocateAndRemoveMember":                 model.PolicyEdgeNodeRelocateAndRemoveMemberBindingType,
+	"PolicyEdgeNodeReplaceMember":                           model.PolicyEdgeNodeReplaceMemberBindingType,
+	"PolicyEdgeTransportManagementInterface":                model.PolicyEdgeTransportManagementInterfaceBindingType,
+	"PolicyEdgeTransportNode":                               model.PolicyEdgeTransportNodeBindingType,
+	"PolicyEdgeTransportNodeCredential":                     model.PolicyEdgeTransportNodeCredentialBindingType,
+	"PolicyEdgeTransportNodeDatapathTroubleshooting":        model.PolicyEdgeTransportNodeDatapathTroubleshootingBindingType,
+	"PolicyEdgeTransportNodeListResult":                     model.PolicyEdgeTransportNodeListResultBindingType,
+	"PolicyEdgeTransportNodePnic":                           model.PolicyEdgeTransportNodePnicBindingType,
+	"PolicyEdgeTransportNodeRtepConfig":                     model.PolicyEdgeTransportNodeRtepConfigBindingType,
+	"PolicyEdgeTransportNodeState":                          model.PolicyEdgeTransportNodeStateBindingType,
+	"PolicyEdgeTransportNodeSwitch":                         model.PolicyEdgeTransportNodeSwitchBindingType,
+	"PolicyEdgeTransportNodeSwitchProfileTypePathEntry":     model.PolicyEdgeTransportNodeSwitchProfileTypePathEntryBindingType,
+	"PolicyEdgeTransportNodeSwitchSpec":                     model.PolicyEdgeTransportNodeSwitchSpecBindingType,
+	"PolicyEdgeTransportNodeSwitchTunnelEndPoint":           model.PolicyEdgeTransportNodeSwitchTunnelEndPointBindingType,
+	"PolicyExcludeList":                                     model.PolicyExcludeListBindingType,
+	"PolicyExtraConfig":                                     model.PolicyExtraConfigBindingType,
+	"PolicyExtraConfigHostSwitchProfile":                    model.PolicyExtraConfigHostSwitchProfileBindingType,
+	"PolicyFailureDomain":                                   model.PolicyFailureDomainBindingType,
+	"PolicyFailureDomainListResult":                         model.PolicyFailureDomainListResultBindingType,
+	"PolicyFineTuningResourceInfo":                          model.PolicyFineTuningResourceInfoBindingType,
+	"PolicyFineTuningResourceInfoDetail":                    model.PolicyFineTuningResourceInfoDetailBindingType,
+	"PolicyFirewallCPUMemThresholdsProfileBindingMap":       model.PolicyFirewallCPUMemThresholdsProfileBindingMapBindingType,
+	"PolicyFirewallCPUMemThresholdsProfileBindingMapListResult": model.PolicyFirewallCPUMemThresholdsProfileBindingMapListResultBindingType,
+	"PolicyFirewallCpuMemThresholdsProfile":                     model.PolicyFirewallCpuMemThresholdsProfileBindingType,
+	"PolicyFirewallCpuMemThresholdsProfileListResult":           model.PolicyFirewallCpuMemThresholdsProfileListResultBindingType,
+	"PolicyFirewallFloodProtectionProfileBindingMap":            model.PolicyFirewallFloodProtectionProfileBindingMapBindingType,
+	"PolicyFirewallFloodProtectionProfileBindingMapListResult":  model.PolicyFirewallFloodProtectionProfileBindingMapListResultBindingType,
+	"PolicyFirewallIpReputationConfig":                          model.PolicyFirewallIpReputationConfigBindingType,
+	"PolicyFirewallScheduler":                                   model.PolicyFirewallSchedulerBindingType,
+	"PolicyFirewallSchedulerListResult":                         model.PolicyFirewallSchedulerListResultBindingType,
+	"PolicyFirewallSessionTimerProfile":                         model.PolicyFirewallSessionTimerProfileBindingType,
+	"PolicyFirewallSessionTimerProfileBindingMap":               model.PolicyFirewallSessionTimerProfileBindingMapBindingType,
+	"PolicyFirewallSessionTimerProfileBindingMapListResult":     model.PolicyFirewallSessionTimerProfileBindingMapListResultBindingType,
+	"PolicyFirewallSessionTimerProfileListResult":               model.PolicyFirewallSessionTimerProfileListResultBindingType,
+	"PolicyGroupAntreaEgressCluster":                            model.PolicyGroupAntreaEgressClusterBindingType,
+	"PolicyGroupAntreaEgressList":                               model.PolicyGroupAntreaEgressListBindingType,
+	"PolicyGroupAntreaEgressListResult":                         model.PolicyGroupAntreaEgressListResultBindingType,
+	"PolicyGroupAntreaIPPoolCluster":                            model.PolicyGroupAntreaIPPoolClusterBindingType,
+	"PolicyGroupAntreaIPPoolList":                               model.PolicyGroupAntreaIPPoolListBindingType,
+	"PolicyGroupAntreaIPPoolListResult":                         model.PolicyGroupAntreaIPPoolListResultBindingType,
+	"PolicyGroupAssociatedKubernetesCluster":                    model.PolicyGroupAssociatedKubernetesClusterBindingType,
+	"PolicyGroupAssociatedKubernetesClusterList":                model.PolicyGroupAssociatedKubernetesClusterListBindingType,
+	"PolicyGroupAssociatedKubernetesClusterListResult":          model.PolicyGroupAssociatedKubernetesClusterListResultBindingType,
+	"PolicyGroupIPMembersListResult":                            model.PolicyGroupIPMembersListResultBindingType,
+	"PolicyGroupKubernetesGatewayCluster":                       model.PolicyGroupKubernetesGatewayClusterBindingType,
+	"PolicyGroupKubernetesGatewayList":                          model.PolicyGroupKubernetesGatewayListBindingType,
+	"PolicyGroupKubernetesGatewayListResult":                    model.PolicyGroupKubernetesGatewayListResultBindingType,
+	"PolicyGroupKubernetesGatewayNamespace":                     model.PolicyGroupKubernetesGatewayNamespaceBindingType,
+	"PolicyGroupKubernetesIngressCluster":                       model.PolicyGroupKubernetesIngressClusterBindingType,
+	"PolicyGroupKubernetesIngressList":                          model.PolicyGroupKubernetesIngressListBindingType,
+	"PolicyGroupKubernetesIngressListResult":                    model.PolicyGroupKubernetesIngressListResultBindingType,
+	"PolicyGroupKubernetesIngressNamespace":                     model.PolicyGroupKubernetesIngressNamespaceBindingType,
+	"PolicyGroupKubernetesNodeCluster":                          model.PolicyGroupKubernetesNodeClusterBindingType,
+	"PolicyGroupKubernetesNodeList":                             model.PolicyGroupKubernetesNodeListBindingType,
+	"PolicyGroupKubernetesNodeListResult":                       model.PolicyGroupKubernetesNodeListResultBindingType,
+	"PolicyGroupKubernetesServiceCluster":                       model.PolicyGroupKubernetesServiceClusterBindingType,
+	"PolicyGroupKubernetesServiceList":                          model.PolicyGroupKubernetesServiceListBindingType,
+	"PolicyGroupKubernetesServiceListResult":                    model.PolicyGroupKubernetesServiceListResultBindingType,
+	"PolicyGroupKubernetesServiceNamespace":                     model.PolicyGroupKubernetesServiceNamespaceBindingType,
+	"PolicyGroupMemberDetails":                                  model.PolicyGroupMemberDetailsBindingType,
+	"PolicyGroupMembersListResult":                              model.PolicyGroupMembersListResultBindingType,
+	"PolicyGroupPodCidrCluster":                                 model.PolicyGroupPodCidrClusterBindingType,
+	"PolicyGroupPodCidrList":                                    model.PolicyGroupPodCidrListBindingType,
+	"PolicyGroupPodCidrListResult":                              model.PolicyGroupPodCidrListResultBindingType,
+	"PolicyHealthCheckResult":                                   model.PolicyHealthCheckResultBindingType,
+	"PolicyHighPerformanceHostSwitchProfile":                    model.PolicyHighPerformanceHostSwitchProfileBindingType,
+	"PolicyHostInfraTrafficType":                                model.PolicyHostInfraTrafficTypeBindingType,
+	"PolicyHostSwitchProfilesListResult":                        model.PolicyHostSwitchProfilesListResultBindingType,
+	"PolicyHostTransportNodeProfile":                            model.PolicyHostTransportNodeProfileBindingType,
+	"PolicyHostTransportNodeProfileListResult":                  model.PolicyHostTransportNodeProfileListResultBindingType,
+	"PolicyIPAddressInfo":                                       model.PolicyIPAddressInfoBindingType,
+	"PolicyIdfwGroupVmDetailListResult":                         model.PolicyIdfwGroupVmDetailListResultBindingType,
+	"PolicyIdsEventDataRequest":                                 model.PolicyIdsEventDataRequestBindingType,
+	"PolicyIdsEventFlowData":                                    model.PolicyIdsEventFlowDataBindingType,
+	"PolicyIdsEventsBySignature":                                model.PolicyIdsEventsBySignatureBindingType,
+	"PolicyIdsEventsBySignatureResult":                          model.PolicyIdsEventsBySignatureResultBindingType,
+	"PolicyIdsEventsSummary":                                    model.PolicyIdsEventsSummaryBindingType,
+	"PolicyIdsIpList":                                           model.PolicyIdsIpListBindingType,
+	"PolicyIdsSummaryListResult":                                model.PolicyIdsSummaryListResultBindingType,
+	"PolicyIdsUserList":                                         model.PolicyIdsUserListBindingType,
+	"PolicyIdsUserStats":                                        model.PolicyIdsUserStatsBindingType,
+	"PolicyIdsVmList":                                           model.PolicyIdsVmListBindingType,
+	"PolicyIdsVmStats":                                          model.PolicyIdsVmStatsBindingType,
+	"PolicyIgmpProfile":                                         model.PolicyIgmpProfileBindingType,
+	"PolicyIgmpProfileListResult":                               model.PolicyIgmpProfileListResultBindingType,
+	"PolicyInsertParameters":                                    model.PolicyInsertParametersBindingType,
+	"PolicyInterVrfRoutingConfig":                               model.PolicyInterVrfRoutingConfigBindingType,
+	"PolicyInterVrfRoutingConfigListResult":                     model.PolicyInterVrfRoutingConfigListResultBindingType,
+	"PolicyInterfaceGroupStatistics":                            model.PolicyInterfaceGroupStatisticsBindingType,
+	"PolicyInterfaceOspfConfig":                                 model.PolicyInterfaceOspfConfigBindingType,
+	"PolicyInterfaceStatistics":                                 model.PolicyInterfaceStatisticsBindingType,
+	"PolicyInterfaceStatisticsSummary":                          model.PolicyInterfaceStatisticsSummaryBindingType,
+	"PolicyIpAssignmentSpec":                                    model.PolicyIpAssignmentSpecBindingType,
+	"PolicyIpsecVpnIkeServiceSummary":                           model.PolicyIpsecVpnIkeServiceSummaryBindingType,
+	"PolicyIpsecVpnSessionSummary":                              model.PolicyIpsecVpnSessionSummaryBindingType,
+	"PolicyKubernetesGateway":                                   model.PolicyKubernetesGatewayBindingType,
+	"PolicyKubernetesIngress":                                   model.PolicyKubernetesIngressBindingType,
+	"PolicyKubernetesNode":                                      model.PolicyKubernetesNodeBindingType,
+	"PolicyKubernetesNodePodCidr":                               model.PolicyKubernetesNodePodCidrBindingType,
+	"PolicyKubernetesResource":                                  model.PolicyKubernetesResourceBindingType,
+	"PolicyKubernetesService":                                   model.PolicyKubernetesServiceBindingType,
+	"PolicyKubernetesServiceLoadBalancerIngress":                model.PolicyKubernetesServiceLoadBalancerIngressBindingType,
+	"PolicyKubernetesServiceLoadBalancerIngressPortStatus":      model.PolicyKubernetesServiceLoadBalancerIngressPortStatusBindingType,
+	"PolicyKubernetesServiceNodePortLocalEndpoint":              model.PolicyKubernetesServiceNodePortLocalEndpointBindingType,
+	"PolicyLabel":                                               model.PolicyLabelBindingType,
+	"PolicyLabelListResult":                                     model.PolicyLabelListResultBindingType,
+	"PolicyLatencyStatProfile":                                  model.PolicyLatencyStatProfileBindingType,
+	"PolicyLatencyStatProfileListResult":                        model.PolicyLatencyStatProfileListResultBindingType,
+	"PolicyListResult":                                          model.PolicyListResultBindingType,
+	"PolicyLiveTraceActionConfig":                               model.PolicyLiveTraceActionConfigBindingType,
+	"PolicyLiveTraceIpsecVpnConfig":                             model.PolicyLiveTraceIpsecVpnConfigBindingType,
+	"PolicyLldpHostSwitchProfile":                               model.PolicyLldpHostSwitchProfileBindingType,
+	"PolicyManualHealthCheck":                                   model.PolicyManualHealthCheckBindingType,
+	"PolicyManualHealthCheckList":                               model.PolicyManualHealthCheckListBindingType,
+	"PolicyManualHealthCheckResult":                             model.PolicyManualHealthCheckResultBindingType,
+	"PolicyMetadataProxyStatistics":                             model.PolicyMetadataProxyStatisticsBindingType,
+	"PolicyMetadataProxyStatus":                                 model.PolicyMetadataProxyStatusBindingType,
+	"PolicyMulticastConfig":                                     model.PolicyMulticastConfigBindingType,
+	"PolicyMulticastForwarding":                                 model.PolicyMulticastForwardingBindingType,
+	"PolicyMulticastForwardingInCsvFormat":                      model.PolicyMulticastForwardingInCsvFormatBindingType,
+	"PolicyMulticastRoutes":                                     model.PolicyMulticastRoutesBindingType,
+	"PolicyMulticastRoutesInCsvFormat":                          model.PolicyMulticastRoutesInCsvFormatBindingType,
+	"PolicyNATRuleCounters":                                     model.PolicyNATRuleCountersBindingType,
+	"PolicyNat":                                                 model.PolicyNatBindingType,
+	"PolicyNatListResult":                                       model.PolicyNatListResultBindingType,
+	"PolicyNatRule":                                             model.PolicyNatRuleBindingType,
+	"PolicyNatRuleListResult":                                   model.PolicyNatRuleListResultBindingType,
+	"PolicyNatRuleStatistics":                                   model.PolicyNatRuleStatisticsBindingType,
+	"PolicyNatRuleStatisticsListResult":                         model.PolicyNatRuleStatisticsListResultBindingType,
+	"PolicyNatRuleStatisticsPerEnforcementPoint":                model.PolicyNatRuleStatisticsPerEnforcementPointBindingType,
+	"PolicyNatRuleStatisticsPerLogicalRouter":                   model.PolicyNatRuleStatisticsPerLogicalRouterBindingType,
+	"PolicyNatRuleStatisticsPerLogicalRouterListResult":         model.PolicyNatRuleStatisticsPerLogicalRouterListResultBindingType,
+	"PolicyNatRuleStatisticsPerTransportNode":                   model.PolicyNatRuleStatisticsPerTransportNodeBindingType,
+	"PolicyNiocProfile":                                         model.PolicyNiocProfileBindingType,
+	"PolicyNonCompliantConfig":                                  model.PolicyNonCompliantConfigBindingType,
+	"PolicyOspfDatabaseListResult":                              model.PolicyOspfDatabaseListResultBindingType,
+	"PolicyPerNodeDnsFailedQueries":                             model.PolicyPerNodeDnsFailedQueriesBindingType,
+	"PolicyPimProfile":                                          model.PolicyPimProfileBindingType,
+	"PolicyPimProfileListResult":                                model.PolicyPimProfileListResultBindingType,
+	"PolicyPktCapResource":                                      model.PolicyPktCapResourceBindingType,
+	"PolicyPolicyResourceAllocation":                            model.PolicyPolicyResourceAllocationBindingType,
+	"PolicyPoolUsage":                                           model.PolicyPoolUsageBindingType,
+	"PolicyRealizedResource":                                    model.PolicyRealizedResourceBindingType,
+	"PolicyRelatedApiError":                                     model.PolicyRelatedApiErrorBindingType,
+	"PolicyRequestParameter":                                    model.PolicyRequestParameterBindingType,
+	"PolicyResource":                                            model.PolicyResourceBindingType,
+	"PolicyResourceReference":                                   model.PolicyResourceReferenceBindingType,
+	"PolicyResourceReferenceForEP":                              model.PolicyResourceReferenceForEPBindingType,
+	"PolicyResourceReferenceForEPListResult":                    model.PolicyResourceReferenceForEPListResultBindingType,
+	"PolicyResourceReferenceListResult":                         model.PolicyResourceReferenceListResultBindingType,
+	"PolicyRouteAdvertisementRule":                              model.PolicyRouteAdvertisementRuleBindingType,
+	"PolicyRuntimeAlarm":                                        model.PolicyRuntimeAlarmBindingType,
+	"PolicyRuntimeInfoPerEP":                                    model.PolicyRuntimeInfoPerEPBindingType,
+	"PolicySIExcludeList":                                       model.PolicySIExcludeListBindingType,
+	"PolicySIStatusConfiguration":                               model.PolicySIStatusConfigurationBindingType,
+	"PolicyServiceChain":                                        model.PolicyServiceChainBindingType,
+	"PolicyServiceChainListResult":                              model.PolicyServiceChainListResultBindingType,
+	"PolicyServiceInstance":                                     model.PolicyServiceInstanceBindingType,
+	"PolicyServiceInstanceListResult":                           model.PolicyServiceInstanceListResultBindingType,
+	"PolicyServiceInstanceStatistics":                           model.PolicyServiceInstanceStatisticsBindingType,
+	"PolicyServiceProfile":                                      model.PolicyServiceProfileBindingType,
+	"PolicyServiceProfileListResult":                            model.PolicyServiceProfileListResultBindingType,
+	"PolicyStaticRouteAdvertisement":                            model.PolicyStaticRouteAdvertisementBindingType,
+	"PolicySubAttributes":                                       model.PolicySubAttributesBindingType,
+	"PolicyTask":                                                model.PolicyTaskBindingType,
+	"PolicyTepCsvListResult":                                    model.PolicyTepCsvListResultBindingType,
+	"PolicyTepListResult":                                       model.PolicyTepListResultBindingType,
+	"PolicyTepTableCsvRecord":                                   model.PolicyTepTableCsvRecordBindingType,
+	"PolicyTepTableEntry":                                       model.PolicyTepTableEntryBindingType,
+	"PolicyTgwChildrenAlarm":                                    model.PolicyTgwChildrenAlarmBindingType,
+	"PolicyTier1MulticastConfig":                                model.PolicyTier1MulticastConfigBindingType,
+	"PolicyTimeIntervalValue":                                   model.PolicyTimeIntervalValueBindingType,
+	"PolicyTraceflowObservationDelivered":                       model.PolicyTraceflowObservationDeliveredBindingType,
+	"PolicyTraceflowObservationDropped":                         model.PolicyTraceflowObservationDroppedBindingType,
+	"PolicyTraceflowObservationDroppedLogical":                  model.PolicyTraceflowObservationDroppedLogicalBindingType,
+	"PolicyTraceflowObservationForwardedLogical":                model.PolicyTraceflowObservationForwardedLogicalBindingType,
+	"PolicyTraceflowObservationIpsecVpn":                        model.PolicyTraceflowObservationIpsecVpnBindingType,
+	"PolicyTraceflowObservationReceivedLogical":                 model.PolicyTraceflowObservationReceivedLogicalBindingType,
+	"PolicyTraceflowObservationRelayedLogical":                  model.PolicyTraceflowObservationRelayedLogicalBindingType,
+	"PolicyTransitGatewayAlarm":                                 model.PolicyTransitGatewayAlarmBindingType,
+	"PolicyTransportZone":                                       model.PolicyTransportZoneBindingType,
+	"PolicyTransportZoneListResult":                             model.PolicyTransportZoneListResultBindingType,
+	"PolicyTransportZoneProfile":                                model.PolicyTransportZoneProfileBindingType,
+	"PolicyTransportZoneProfileListResult":                      model.PolicyTransportZoneProfileListResultBindingType,
+	"PolicyTunnelListResult":                                    model.PolicyTunnelListResultBindingType,
+	"PolicyUplinkHostSwitchProfile":                             model.PolicyUplinkHostSwitchProfileBindingType,
+	"PolicyUrlCategorizationConfig":                             model.PolicyUrlCategorizationConfigBindingType,
+	"PolicyUrlCategory":                                         model.PolicyUrlCategoryBindingType,
+	"PolicyUrlCategoryListResult":                               model.PolicyUrlCategoryListResultBindingType,
+	"PolicyUrlReputationSeverity":                               model.PolicyUrlReputationSeverityBindingType,
+	"PolicyUrlReputationSeverityListResult":                     model.PolicyUrlReputationSeverityListResultBindingType,
+	"PolicyVmApplianceConfig":                                   model.PolicyVmApplianceConfigBindingType,
+	"PolicyVmDeploymentConfig":                                  model.PolicyVmDeploymentConfigBindingType,
+	"PolicyVpcAlarm":                                            model.PolicyVpcAlarmBindingType,
+	"PolicyVpcLogPortEipBinding":                                model.PolicyVpcLogPortEipBindingBindingType,
+	"PolicyVpcNatRule":                                          model.PolicyVpcNatRuleBindingType,
+	"PolicyVpcNatRuleListResult":                                model.PolicyVpcNatRuleListResultBindingType,
+	"PolicyVpcSecurityPoliciesAlarm":                            model.PolicyVpcSecurityPoliciesAlarmBindingType,
+	"PolicyVpcSecurityPoliciesChildrenAlarm":                    model.PolicyVpcSecurityPoliciesChildrenAlarmBindingType,
+	"PolicyVpcSubnetAlarm":                                      model.PolicyVpcSubnetAlarmBindingType,
+	"PolicyVpcSubnetChildrenAlarm":                              model.PolicyVpcSubnetChildrenAlarmBindingType,
+	"PolicyVsphereDeploymentConfig":                             model.PolicyVsphereDeploymentConfigBindingType,
+	"PolicyVtepHAHostSwitchProfile":                             model.PolicyVtepHAHostSwitchProfileBindingType,
+	"PoolMemberSetting":                                         model.PoolMemberSettingBindingType,
+	"PortAddressBindingEntry":                                   model.PortAddressBindingEntryBindingType,
+	"PortAttacher":                                              model.PortAttacherBindingType,
+	"PortAttachment":                                            model.PortAttachmentBindingType,
+	"PortDiscoveryProfileBindingMap":                            model.PortDiscoveryProfileBindingMapBindingType,
+	"PortDiscoveryProfileBindingMapListResult":                  model.PortDiscoveryProfileBindingMapListResultBindingType,
+	"PortMirrorFilter":                                          model.PortMirrorFilterBindingType,
+	"PortMirroringInstance":                                     model.PortMirroringInstanceBindingType,
+	"PortMirroringInstanceListResult":                           model.PortMirroringInstanceListResultBindingType,
+	"PortMirroringProfile":                                      model.PortMirroringProfileBindingType,
+	"PortMirroringProfileListResult":                            model.PortMirroringProfileListResultBindingType,
+	"PortMonitoringProfileBindingMap":                           model.PortMonitoringProfileBindingMapBindingType,
+	"PortMonitoringProfileBindingMapListResult":                 model.PortMonitoringProfileBindingMapListResultBindingType,
+	"PortQosProfileBindingMap":                                  model.PortQosProfileBindingMapBindingType,
+	"PortQosProfileBindingMapListResult":                        model.PortQosProfileBindingMapListResultBindingType,
+	"PortRealTimeEthProfileBindingMap":                          model.PortRealTimeEthProfileBindingMapBindingType,
+	"PortRealTimeEthProfileBindingMapListResult":                model.PortRealTimeEthProfileBindingMapListResultBindingType,
+	"PortSecurityProfileBindingMap":                             model.PortSecurityProfileBindingMapBindingType,
+	"PortSecurityProfileBindingMapListResult":                   model.PortSecurityProfileBindingMapListResultBindingType,
+	"PreconfiguredEndpoint":                                     model.PreconfiguredEndpointBindingType,
+	"PreconfiguredHostSwitch":                                   model.PreconfiguredHostSwitchBindingType,
+	"PreconfiguredHostSwitchSpec":                               model.PreconfiguredHostSwitchSpecBindingType,
+	"PrefixEntry":                                               model.PrefixEntryBindingType,
+	"PrefixList":                                                model.PrefixListBindingType,
+	"PrefixListResult":                                          model.PrefixListResultBindingType,
+	"Principal":                                                 model.PrincipalBindingType,
+	"ProcessInformation":                                        model.ProcessInformationBindingType,
+	"ProfileBindingMap":                                         model.ProfileBindingMapBindingType,
+	"ProfileSupportedAttributeTypesResult":                      model.ProfileSupportedAttributeTypesResultBindingType,
+	"Profinet":                                                  model.ProfinetBindingType,
+	"Project":                                                   model.ProjectBindingType,
+	"ProjectIpAddressAllocation":                                model.ProjectIpAddressAllocationBindingType,
+	"ProjectIpAddressAllocationListResult":                      model.ProjectIpAddressAllocationListResultBindingType,
+	"ProjectIpAddressBlockState":                                model.ProjectIpAddressBlockStateBindingType,
+	"ProjectListResult":                                         model.ProjectListResultBindingType,
+	"ProjectRouteFilter":                                        model.ProjectRouteFilterBindingType,
+	"ProjectRouteFilterListResult":                              model.ProjectRouteFilterListResultBindingType,
+	"PropertyItem":                                              model.PropertyItemBindingType,
+	"ProtocolVersion":                                           model.ProtocolVersionBindingType,
+	"QosBaseRateLimiter":                                        model.QosBaseRateLimiterBindingType,
+	"QosDscp":                                                   model.QosDscpBindingType,
+	"QosProfile":                                                model.QosProfileBindingType,
+	"QosProfileBindingMap":                                      model.QosProfileBindingMapBindingType,
+	"QosProfileListResult":                                      model.QosProfileListResultBindingType,
+	"Quota":                                                     model.QuotaBindingType,
+	"QuotaState":                                                model.QuotaStateBindingType,
+	"QuotaStats":                                                model.QuotaStatsBindingType,
+	"QuotaStatsListResult":                                      model.QuotaStatsListResultBindingType,
+	"QuotaStatsPerPrefix":                                       model.QuotaStatsPerPrefixBindingType,
+	"QuotaStatsPerPrefixListResult":                             model.QuotaStatsPerPrefixListResultBindingType,
+	"RAConfig":                                                  model.RAConfigBindingType,
+	"RaDNSConfig":                                               model.RaDNSConfigBindingType,
+	"RawCoreCount":                                              model.RawCoreCountBindingType,
+	"RdPerEdgeEntry":                                            model.RdPerEdgeEntryBindingType,
+	"RdPerEdgeMapping":                                          model.RdPerEdgeMappingBindingType,
+	"Reaction":                                                  model.ReactionBindingType,
+	"ReactionListResult":                                        model.ReactionListResultBindingType,
+	"RealTimeEthProfile":                                        model.RealTimeEthProfileBindingType,
+	"RealTimeEthProfileBindingMap":                              model.RealTimeEthProfileBindingMapBindingType,
+	"RealTimeEthProfileListResult":                              model.RealTimeEthProfileListResultBindingType,
+	"RealTimeEthernetConfigForEnhancedNetworkingStackSwitch":    model.RealTimeEthernetConfigForEnhancedNetworkingStackSwitchBindingType,
+	"RealizationEntity":                                         model.RealizationEntityBindingType,
+	"RealizedEnforcementPoint":                                  model.RealizedEnforcementPointBindingType,
+	"RealizedEnforcementPointListResult":                        model.RealizedEnforcementPointListResultBindingType,
+	"RealizedFirewall":                                          model.RealizedFirewallBindingType,
+	"RealizedFirewallRule":                                      model.RealizedFirewallRuleBindingType,
+	"RealizedFirewallSection":                                   model.RealizedFirewallSectionBindingType,
+	"RealizedFirewallSectionListResult":                         model.RealizedFirewallSectionListResultBindingType,
+	"RealizedFirewalls":                                         model.RealizedFirewallsBindingType,
+	"RealizedGroup":                                             model.RealizedGroupBindingType,
+	"RealizedGroups":                                            model.RealizedGroupsBindingType,
+	"RealizedIp":                                                model.RealizedIpBindingType,
+	"RealizedLogicalPort":                                       model.RealizedLogicalPortBindingType,
+	"RealizedLogicalSwitch":                                     model.RealizedLogicalSwitchBindingType,
+	"RealizedNSGroup":                                           model.RealizedNSGroupBindingType,
+	"RealizedNSGroupMemberEvaluation":                           model.RealizedNSGroupMemberEvaluationBindingType,
+	"RealizedNSService":                                         model.RealizedNSServiceBindingType,
+	"RealizedSecurityGroup":                                     model.RealizedSecurityGroupBindingType,
+	"RealizedSecurityGroupListResult":                           model.RealizedSecurityGroupListResultBindingType,
+	"RealizedSecurityGroupMemberEvaluation":                     model.RealizedSecurityGroupMemberEvaluationBindingType,
+	"RealizedService":                                           model.RealizedServiceBindingType,
+	"RealizedServices":                                          model.RealizedServicesBindingType,
+	"RealizedVirtualMachine":                                    model.RealizedVirtualMachineBindingType,
+	"RealizedVirtualMachineListResult":                          model.RealizedVirtualMachineListResultBindingType,
+	"RecommendedFeaturePermission":                              model.RecommendedFeaturePermissionBindingType,
+	"RecommendedFeaturePermissionListResult":                    model.RecommendedFeaturePermissionListResultBindingType,
+	"RedirectionPolicy":                                         model.RedirectionPolicyBindingType,
+	"RedirectionPolicyListResult":                               model.RedirectionPolicyListResultBindingType,
+	"RedirectionRule":                                           model.RedirectionRuleBindingType,
+	"RedirectionRuleListResult":                                 model.RedirectionRuleListResultBindingType,
+	"RegTokenQuery":                                             model.RegTokenQueryBindingType,
+	"RegistrationToken":                                         model.RegistrationTokenBindingType,
+	"RelatedApiError":                                           model.RelatedApiErrorBindingType,
+	"RelatedAttribute":                                          model.RelatedAttributeBindingType,
+	"RelatedAttributeConditionalExpression":                     model.RelatedAttributeConditionalExpressionBindingType,
+	"RemoteFileServer":                                          model.RemoteFileServerBindingType,
+	"RemoteServerFingerprint":                                   model.RemoteServerFingerprintBindingType,
+	"RemoteServerFingerprintRequest":                            model.RemoteServerFingerprintRequestBindingType,
+	"RemoteTunnelEndpoint":                                      model.RemoteTunnelEndpointBindingType,
+	"RemoteTunnelEndpointConfigState":                           model.RemoteTunnelEndpointConfigStateBindingType,
+	"RenderConfiguration":                                       model.RenderConfigurationBindingType,
+	"ReservationInfo":                                           model.ReservationInfoBindingType,
+	"Resource":                                                  model.ResourceBindingType,
+	"ResourceFieldPointer":                                      model.ResourceFieldPointerBindingType,
+	"ResourceInfo":                                              model.ResourceInfoBindingType,
+	"ResourceInfoListResult":                                    model.ResourceInfoListResultBindingType,
+	"ResourceLink":                                              model.ResourceLinkBindingType,
+	"ResourceObject":                                            model.ResourceObjectBindingType,
+	"ResourceOperation":                                         model.ResourceOperationBindingType,
+	"ResourceReference":                                         model.ResourceReferenceBindingType,
+	"ResourceTagStatus":                                         model.ResourceTagStatusBindingType,
+	"ResourceTypeTagStatus":                                     model.ResourceTypeTagStatusBindingType,
+	"RestoreConfiguration":                                      model.RestoreConfigurationBindingType,
+	"RestoreStep":                                               model.RestoreStepBindingType,
+	"RetryProjectsOnboardingRequestParameters":                  model.RetryProjectsOnboardingRequestParametersBindingType,
+	"RevisionedResource":                                        model.RevisionedResourceBindingType,
+	"Role":                                                      model.RoleBindingType,
+	"RoleAssignmentPermissionConfig":                            model.RoleAssignmentPermissionConfigBindingType,
+	"RoleBinding":                                               model.RoleBindingBindingType,
+	"RoleBindingListResult":                                     model.RoleBindingListResultBindingType,
+	"RoleListResult":                                            model.RoleListResultBindingType,
+	"RoleWithFeatures":                                          model.RoleWithFeaturesBindingType,
+	"RoleWithFeaturesListResult":                                model.RoleWithFeaturesListResultBindingType,
+	"RolesForPath":                                              model.RolesForPathBindingType,
+	"RouteAdvertisementRule":                                    model.RouteAdvertisementRuleBindingType,
+	"RouteAggregationEntry":                                     model.RouteAggregationEntryBindingType,
+	"RouteBasedIPSecVpnSession":                                 model.RouteBasedIPSecVpnSessionBindingType,
+	"RouteBasedL3VpnSession":                                    model.RouteBasedL3VpnSessionBindingType,
+	"RouteDetails":                                              model.RouteDetailsBindingType,
+	"RouteMapEntry":                                             model.RouteMapEntryBindingType,
+	"RouteMapEntrySet":                                          model.RouteMapEntrySetBindingType,
+	"RouterNexthop":                                             model.RouterNexthopBindingType,
+	"RoutesPerTransportNode":                                    model.RoutesPerTransportNodeBindingType,
+	"RoutingEntry":                                              model.RoutingEntryBindingType,
+	"RoutingTable":                                              model.RoutingTableBindingType,
+	"RoutingTableListResult":                                    model.RoutingTableListResultBindingType,
+	"RowListField":                                              model.RowListFieldBindingType,
+	"RpAddressMulticastRanges":                                  model.RpAddressMulticastRangesBindingType,
+	"RtepBgpTroubleshoot":                                       model.RtepBgpTroubleshootBindingType,
+	"Rule":                                                      model.RuleBindingType,
+	"RuleListResult":                                            model.RuleListResultBindingType,
+	"RuleStatistics":                                            model.RuleStatisticsBindingType,
+	"RuleStatisticsForEnforcementPoint":                         model.RuleStatisticsForEnforcementPointBindingType,
+	"RuleStatisticsListResult":                                  model.RuleStatisticsListResultBindingType,
+	"RuntimeInterfaceStatistics":                                model.RuntimeInterfaceStatisticsBindingType,
+	"SIAggregatedDataCounter":                                   model.SIAggregatedDataCounterBindingType,
+	"SIAggregatedDataCounterEx":                                 model.SIAggregatedDataCounterExBindingType,
+	"SIDataCounter":                                             model.SIDataCounterBindingType,
+	"SIMacLearningCounters":                                     model.SIMacLearningCountersBindingType,
+	"SIPacketTypeAndCounter":                                    model.SIPacketTypeAndCounterBindingType,
+	"SIPacketsDroppedBySecurity":                                model.SIPacketsDroppedBySecurityBindingType,
+	"SVMDeploymentSpec":                                         model.SVMDeploymentSpecBindingType,
+	"SamplingArgument":                                          model.SamplingArgumentBindingType,
+	"SandboxedResource":                                         model.SandboxedResourceBindingType,
+	"SandboxedResourceListResult":                               model.SandboxedResourceListResultBindingType,
+	"SearchReconcileStatus":                                     model.SearchReconcileStatusBindingType,
+	"SearchResponse":                                            model.SearchResponseBindingType,
+	"SecurityFeature":                                           model.SecurityFeatureBindingType,
+	"SecurityFeatureBase":                                       model.SecurityFeatureBaseBindingType,
+	"SecurityFeatures":                                          model.SecurityFeaturesBindingType,
+	"SecurityLicenseUsageCSVRecord":                             model.SecurityLicenseUsageCSVRecordBindingType,
+	"SecurityLicenseUsageReport":                                model.SecurityLicenseUsageReportBindingType,
+	"SecurityLicenseUsageReportInCSV":                           model.SecurityLicenseUsageReportInCSVBindingType,
+	"SecurityPolicy":                                            model.SecurityPolicyBindingType,
+	"SecurityPolicyContainerCluster":                            model.SecurityPolicyContainerClusterBindingType,
+	"SecurityPolicyContainerClusterListResult":                  model.SecurityPolicyContainerClusterListResultBindingType,
+	"SecurityPolicyListResult":                                  model.SecurityPolicyListResultBindingType,
+	"SecurityPolicyStatistics":                                  model.SecurityPolicyStatisticsBindingType,
+	"SecurityPolicyStatisticsForEnforcementPoint":               model.SecurityPolicyStatisticsForEnforcementPointBindingType,
+	"SecurityPolicyStatisticsListResult":                        model.SecurityPolicyStatisticsListResultBindingType,
+	"SecurityProfileBindingMap":                                 model.SecurityProfileBindingMapBindingType,
+	"Segment":                                                   model.SegmentBindingType,
+	"SegmentAdvancedConfig":                                     model.SegmentAdvancedConfigBindingType,
+	"SegmentConfigurationState":                                 model.SegmentConfigurationStateBindingType,
+	"SegmentConfigurationStateListResult":                       model.SegmentConfigurationStateListResultBindingType,
+	"SegmentConnectionBindingMap":                               model.SegmentConnectionBindingMapBindingType,
+	"SegmentConnectionBindingMapListResult":                     model.SegmentConnectionBindingMapListResultBindingType,
+	"SegmentCrossSiteTrafficStats":                              model.SegmentCrossSiteTrafficStatsBindingType,
+	"SegmentDhcpConfig":                                         model.SegmentDhcpConfigBindingType,
+	"SegmentDhcpV4Config":                                       model.SegmentDhcpV4ConfigBindingType,
+	"SegmentDhcpV6Config":                                       model.SegmentDhcpV6ConfigBindingType,
+	"SegmentDiscoveryProfileBindingMap":                         model.SegmentDiscoveryProfileBindingMapBindingType,
+	"SegmentDiscoveryProfileBindingMapListResult":               model.SegmentDiscoveryProfileBindingMapListResultBindingType,
+	"SegmentExtraConfig":                                        model.SegmentExtraConfigBindingType,
+	"SegmentL2ForwarderSiteSpanInfo":                            model.SegmentL2ForwarderSiteSpanInfoBindingType,
+	"SegmentListResult":                                         model.SegmentListResultBindingType,
+	"SegmentMacAddressListResult":                               model.SegmentMacAddressListResultBindingType,
+	"SegmentMonitoringProfileBindingMap":                        model.SegmentMonitoringProfileBindingMapBindingType,
+	"SegmentMonitoringProfileBindingMapListResult":              model.SegmentMonitoringProfileBindingMapListResultBindingType,
+	"SegmentPort":                                               model.SegmentPortBindingType,
+	"SegmentPortAttachmentState":                                model.SegmentPortAttachmentStateBindingType,
+	"SegmentPortListResult":                                     model.SegmentPortListResultBindingType,
+	"SegmentPortMacAddressCsvListResult":                        model.SegmentPortMacAddressCsvListResultBindingType,
+	"SegmentPortMacAddressListResult":                           model.SegmentPortMacAddressListResultBindingType,
+	"SegmentPortMacTableCsvEntry":                               model.SegmentPortMacTableCsvEntryBindingType,
+	"SegmentPortMacTableEntry":                                  model.SegmentPortMacTableEntryBindingType,
+	"SegmentPortState":                                          model.SegmentPortStateBindingType,
+	"SegmentPortStatistics":                                     model.SegmentPortStatisticsBindingType,
+	"SegmentPortStatus":                                         model.SegmentPortStatusBindingType,
+	"SegmentQosProfileBindingMap":                               model.SegmentQosProfileBindingMapBindingType,
+	"SegmentQosProfileBindingMapListResult":                     model.SegmentQosProfileBindingMapListResultBindingType,
+	"SegmentRealTimeEthProfileBindingMap":                       model.SegmentRealTimeEthProfileBindingMapBindingType,
+	"SegmentRealTimeEthProfileBindingMapListResult":             model.SegmentRealTimeEthProfileBindingMapListResultBindingType,
+	"SegmentRequestParameter":                                   model.SegmentRequestParameterBindingType,
+	"SegmentSecurityFeature":                                    model.SegmentSecurityFeatureBindingType,
+	"SegmentSecurityFeatures":                                   model.SegmentSecurityFeaturesBindingType,
+	"SegmentSecurityProfile":                                    model.SegmentSecurityProfileBindingType,
+	"SegmentSecurityProfileBindingMap":                          model.SegmentSecurityProfileBindingMapBindingType,
+	"SegmentSecurityProfileBindingMapListResult":                model.SegmentSecurityProfileBindingMapListResultBindingType,
+	"SegmentSecurityProfileListResult":                          model.SegmentSecurityProfileListResultBindingType,
+	"SegmentStatistics":                                         model.SegmentStatisticsBindingType,
+	"SegmentSubnet":                                             model.SegmentSubnetBindingType,
+	"SelectableResourceReference":                               model.SelectableResourceReferenceBindingType,
+	"SelectiveSyncSettings":                                     model.SelectiveSyncSettingsBindingType,
+	"SelfResourceLink":                                          model.SelfResourceLinkBindingType,
+	"Service":                                                   model.ServiceBindingType,
+	"ServiceCapability":                                         model.ServiceCapabilityBindingType,
+	"ServiceChainMapping":                                       model.ServiceChainMappingBindingType,
+	"ServiceChainMappingListResult":                             model.ServiceChainMappingListResultBindingType,
+	"ServiceDefinition":                                         model.ServiceDefinitionBindingType,
+	"ServiceDeploymentSpec":                                     model.ServiceDeploymentSpecBindingType,
+	"ServiceEntry":                                              model.ServiceEntryBindingType,
+	"ServiceEntryListResult":                                    model.ServiceEntryListResultBindingType,
+	"ServiceGateway":                                            model.ServiceGatewayBindingType,
+	"ServiceGatewayCounter":                                     model.ServiceGatewayCounterBindingType,
+	"ServiceGatewayCounterCommon":                               model.ServiceGatewayCounterCommonBindingType,
+	"ServiceInsertionServiceListResult":                         model.ServiceInsertionServiceListResultBindingType,
+	"ServiceInstanceEndpoint":                                   model.ServiceInstanceEndpointBindingType,
+	"ServiceInstanceEndpointListResult":                         model.ServiceInstanceEndpointListResultBindingType,
+	"ServiceInterface":                                          model.ServiceInterfaceBindingType,
+	"ServiceInterfaceListResult":                                model.ServiceInterfaceListResultBindingType,
+	"ServiceListResult":                                         model.ServiceListResultBindingType,
+	"ServicePath":                                               model.ServicePathBindingType,
+	"ServicePathHop":                                            model.ServicePathHopBindingType,
+	"ServicePathListResult":                                     model.ServicePathListResultBindingType,
+	"ServiceProfileGroups":                                      model.ServiceProfileGroupsBindingType,
+	"ServiceReference":                                          model.ServiceReferenceBindingType,
+	"ServiceReferenceListResult":                                model.ServiceReferenceListResultBindingType,
+	"ServiceSegment":                                            model.ServiceSegmentBindingType,
+	"ServiceSegmentListResult":                                  model.ServiceSegmentListResultBindingType,
+	"SessionAuthenticationCredentials":                          model.SessionAuthenticationCredentialsBindingType,
+	"SessionTimerProfileBindingListResult":                      model.SessionTimerProfileBindingListResultBindingType,
+	"SessionTimerProfileBindingMap":                             model.SessionTimerProfileBindingMapBindingType,
+	"SetFields":                                                 model.SetFieldsBindingType,
+	"ShaDynamicPlugin":                                          model.ShaDynamicPluginBindingType,
+	"ShaDynamicPluginListResult":                                model.ShaDynamicPluginListResultBindingType,
+	"ShaDynamicPluginProfile":                                   model.ShaDynamicPluginProfileBindingType,
+	"ShaEsxObsrvAlarmsMonitorConfig":                            model.ShaEsxObsrvAlarmsMonitorConfigBindingType,
+	"ShaEsxObsrvDatapathTrafficStatsMonitorConfig":              model.ShaEsxObsrvDatapathTrafficStatsMonitorConfigBindingType,
+	"ShaEsxObsrvInfraStatsMonitorConfig":                        model.ShaEsxObsrvInfraStatsMonitorConfigBindingType,
+	"ShaEsxObsrvSegmentStatsFileDumpMonitorConfig":              model.ShaEsxObsrvSegmentStatsFileDumpMonitorConfigBindingType,
+	"ShaEsxObsrvStatsManagementConfig":                          model.ShaEsxObsrvStatsManagementConfigBindingType,
+	"ShaEsxObsrvStatsMonitorConfig":                             model.ShaEsxObsrvStatsMonitorConfigBindingType,
+	"ShaEsxObsrvTnStatsFileDumpMonitorConfig":                   model.ShaEsxObsrvTnStatsFileDumpMonitorConfigBindingType,
+	"ShaHardwareUsageMonitorConfig":                             model.ShaHardwareUsageMonitorConfigBindingType,
+	"ShaMetricExporterMonitor":                                  model.ShaMetricExporterMonitorBindingType,
+	"ShaMonitor":                                                model.ShaMonitorBindingType,
+	"ShaMonitorConfig":                                          model.ShaMonitorConfigBindingType,
+	"ShaMonitorDependOnConfig":                                  model.ShaMonitorDependOnConfigBindingType,
+	"ShaMonitorDependOnConfigDetail":                            model.ShaMonitorDependOnConfigDetailBindingType,
+	"ShaMonitorEffectiveConfig":                                 model.ShaMonitorEffectiveConfigBindingType,
+	"ShaMonitorExportedMetricDetail":                            model.ShaMonitorExportedMetricDetailBindingType,
+	"ShaMonitorExportedMetricSpec":                              model.ShaMonitorExportedMetricSpecBindingType,
+	"ShaMonitorListResult":                                      model.ShaMonitorListResultBindingType,
+	"ShaMonitorMetricKey":                                       model.ShaMonitorMetricKeyBindingType,
+	"ShaMonitorProfile":                                         model.ShaMonitorProfileBindingType,
+	"ShaMonitorProfileListResult":                               model.ShaMonitorProfileListResultBindingType,
+	"ShaMonitorStatus":                                          model.ShaMonitorStatusBindingType,
+	"ShaMonitorStatusDetail":                                    model.ShaMonitorStatusDetailBindingType,
+	"ShaMonitorStatusListResult":                                model.ShaMonitorStatusListResultBindingType,
+	"ShaPluginProfile":                                          model.ShaPluginProfileBindingType,
+	"ShaPluginProfileListResult":                                model.ShaPluginProfileListResultBindingType,
+	"ShaPreDefinedPluginListResult":                             model.ShaPreDefinedPluginListResultBindingType,
+	"ShaPredefinedPlugin":                                       model.ShaPredefinedPluginBindingType,
+	"ShaPredefinedPluginProfile":                                model.ShaPredefinedPluginProfileBindingType,
+	"ShaPredefinedPluginProfileData":                            model.ShaPredefinedPluginProfileDataBindingType,
+	"ShaStandardMonitor":                                        model.ShaStandardMonitorBindingType,
+	"ShaSubMetricExporterMonitor":                               model.ShaSubMetricExporterMonitorBindingType,
+	"ShaSubMonitorConfig":                                       model.ShaSubMonitorConfigBindingType,
+	"ShaSubMonitorStatusDetail":                                 model.ShaSubMonitorStatusDetailBindingType,
+	"ShaSystemPluginProfile":                                    model.ShaSystemPluginProfileBindingType,
+	"ShaUaMetricMonitorConfig":                                  model.ShaUaMetricMonitorConfigBindingType,
+	"Share":                                                     model.ShareBindingType,
+	"ShareListResult":                                           model.ShareListResultBindingType,
+	"SharedResource":                                            model.SharedResourceBindingType,
+	"SharedResourceListResult":                                  model.SharedResourceListResultBindingType,
+	"Site":                                                      model.SiteBindingType,
+	"SiteAllocationIndexForEdge":                                model.SiteAllocationIndexForEdgeBindingType,
+	"SiteFederationConfig":                                      model.SiteFederationConfigBindingType,
+	"SiteInfo":                                                  model.SiteInfoBindingType,
+	"SiteListResult":                                            model.SiteListResultBindingType,
+	"SiteNodeConnectionInfo":                                    model.SiteNodeConnectionInfoBindingType,
+	"SiteOffBoardingState":                                      model.SiteOffBoardingStateBindingType,
+	"SiteOverride":                                              model.SiteOverrideBindingType,
+	"SiteSecuritySetting":                                       model.SiteSecuritySettingBindingType,
+	"SiteToElsServerMapping":                                    model.SiteToElsServerMappingBindingType,
+	"SiteToElsServerMappingListResult":                          model.SiteToElsServerMappingListResultBindingType,
+	"SiteToLdapServerMapping":                                   model.SiteToLdapServerMappingBindingType,
+	"SiteToLdapServerMappingListResult":                         model.SiteToLdapServerMappingListResultBindingType,
+	"SmartnicAgentStatus":                                       model.SmartnicAgentStatusBindingType,
+	"SoftwareModule":                                            model.SoftwareModuleBindingType,
+	"SoftwareModuleResult":                                      model.SoftwareModuleResultBindingType,
+	"Source":                                                    model.SourceBindingType,
+	"SourceFieldEvaluation":                                     model.SourceFieldEvaluationBindingType,
+	"SpacerWidgetConfiguration":                                 model.SpacerWidgetConfigurationBindingType,
+	"Span":                                                      model.SpanBindingType,
+	"SpanSiteInfo":                                              model.SpanSiteInfoBindingType,
+	"SpoofGuardProfile":                                         model.SpoofGuardProfileBindingType,
+	"SpoofGuardProfileListResult":                               model.SpoofGuardProfileListResultBindingType,
+	"StandaloneHostIdfwConfiguration":                           model.StandaloneHostIdfwConfigurationBindingType,
+	"StandardHostSwitch":                                        model.StandardHostSwitchBindingType,
+	"StandardHostSwitchSpec":                                    model.StandardHostSwitchSpecBindingType,
+	"StandbyRelocationConfig":                                   model.StandbyRelocationConfigBindingType,
+	"StatItem":                                                  model.StatItemBindingType,
+	"StaticARPConfig":                                           model.StaticARPConfigBindingType,
+	"StaticFilter":                                              model.StaticFilterBindingType,
+	"StaticIpAllocation":                                        model.StaticIpAllocationBindingType,
+	"StaticIpListSpec":                                          model.StaticIpListSpecBindingType,
+	"StaticIpMacListSpec":                                       model.StaticIpMacListSpecBindingType,
+	"StaticIpPoolSpec":                                          model.StaticIpPoolSpecBindingType,
+	"StaticIpv4":                                                model.StaticIpv4BindingType,
+	"StaticIpv4List":                                            model.StaticIpv4ListBindingType,
+	"StaticIpv4MacList":                                         model.StaticIpv4MacListBindingType,
+	"StaticIpv4Pool":                                            model.StaticIpv4PoolBindingType,
+	"StaticIpv6":                                                model.StaticIpv6BindingType,
+	"StaticIpv6List":                                            model.StaticIpv6ListBindingType,
+	"StaticIpv6ListSpec":                                        model.StaticIpv6ListSpecBindingType,
+	"StaticIpv6MacList":                                         model.StaticIpv6MacListBindingType,
+	"StaticIpv6MacListSpec":                                     model.StaticIpv6MacListSpecBindingType,
+	"StaticIpv6Pool":                                            model.StaticIpv6PoolBindingType,
+	"StaticIpv6PoolSpec":                                        model.StaticIpv6PoolSpecBindingType,
+	"StaticMimeContent":                                         model.StaticMimeContentBindingType,
+	"StaticMimeContentListResult":                               model.StaticMimeContentListResultBindingType,
+	"StaticPoolConfig":                                          model.StaticPoolConfigBindingType,
+	"StaticRouteBfdPeer":                                        model.StaticRouteBfdPeerBindingType,
+	"StaticRouteBfdPeerListResult":                              model.StaticRouteBfdPeerListResultBindingType,
+	"StaticRouteBfdTroubleshootConfig":                          model.StaticRouteBfdTroubleshootConfigBindingType,
+	"StaticRoutes":                                              model.StaticRoutesBindingType,
+	"StaticRoutesListResult":                                    model.StaticRoutesListResultBindingType,
+	"StatsConfiguration":                                        model.StatsConfigurationBindingType,
+	"StatusCount":                                               model.StatusCountBindingType,
+	"StatusEnum":                                                model.StatusEnumBindingType,
+	"StorageClasses":                                            model.StorageClassesBindingType,
+	"StringArrayConstraintValue":                                model.StringArrayConstraintValueBindingType,
+	"SubCluster":                                                model.SubClusterBindingType,
+	"SubClusterConfig":                                          model.SubClusterConfigBindingType,
+	"SubClusterInfo":                                            model.SubClusterInfoBindingType,
+	"SubClusterListResult":                                      model.SubClusterListResultBindingType,
+	"SubPool":                                                   model.SubPoolBindingType,
+	"SubjectAltNames":                                           model.SubjectAltNamesBindingType,
+	"SubjectPublicKeyHash":                                      model.SubjectPublicKeyHashBindingType,
+	"SubnetAdvancedConfig":                                      model.SubnetAdvancedConfigBindingType,
+	"SubnetAndPortStatus":                                       model.SubnetAndPortStatusBindingType,
+	"SubnetConnectionBindingMap":                                model.SubnetConnectionBindingMapBindingType,
+	"SubnetConnectionBindingMapListResult":                      model.SubnetConnectionBindingMapListResultBindingType,
+	"SubnetDhcpConfig":                                          model.SubnetDhcpConfigBindingType,
+	"SubnetExtraConfig":                                         model.SubnetExtraConfigBindingType,
+	"SubnetProfiles":                                            model.SubnetProfilesBindingType,
+	"SubnetStatistics":                                          model.SubnetStatisticsBindingType,
+	"SwitchSecurity":                                            model.SwitchSecurityBindingType,
+	"SwitchingProfileTypeIdEntry":                               model.SwitchingProfileTypeIdEntryBindingType,
+	"SwsecCounters":                                             model.SwsecCountersBindingType,
+	"SyslogConfiguration":                                       model.SyslogConfigurationBindingType,
+	"Tag":                                                       model.TagBindingType,
+	"TagBulkOperation":                                          model.TagBulkOperationBindingType,
+	"TagBulkOperationStatus":                                    model.TagBulkOperationStatusBindingType,
+	"TagInfo":                                                   model.TagInfoBindingType,
+	"TagInfoListResult":                                         model.TagInfoListResultBindingType,
+	"TaskListResult":                                            model.TaskListResultBindingType,
+	"TaskProperties":                                            model.TaskPropertiesBindingType,
+	"TcpHeader":                                                 model.TcpHeaderBindingType,
+	"TcpMaximumSegmentSizeClamping":                             model.TcpMaximumSegmentSizeClampingBindingType,
+	"TeamingPolicy":                                             model.TeamingPolicyBindingType,
+	"TepGroupConfig":                                            model.TepGroupConfigBindingType,
+	"ThreatStatus":                                              model.ThreatStatusBindingType,
+	"Tier0":                                                     model.Tier0BindingType,
+	"Tier0AdvancedConfig":                                       model.Tier0AdvancedConfigBindingType,
+	"Tier0DeploymentMap":                                        model.Tier0DeploymentMapBindingType,
+	"Tier0DeploymentMapListResult":                              model.Tier0DeploymentMapListResultBindingType,
+	"Tier0GatewayState":                                         model.Tier0GatewayStateBindingType,
+	"Tier0HaVipConfig":                                          model.Tier0HaVipConfigBindingType,
+	"Tier0Interface":                                            model.Tier0InterfaceBindingType,
+	"Tier0InterfaceGroup":                                       model.Tier0InterfaceGroupBindingType,
+	"Tier0InterfaceGroupListResult":                             model.Tier0InterfaceGroupListResultBindingType,
+	"Tier0InterfaceListResult":                                  model.Tier0InterfaceListResultBindingType,
+	"Tier0InterfacePimConfig":                                   model.Tier0InterfacePimConfigBindingType,
+	"Tier0ListResult":                                           model.Tier0ListResultBindingType,
+	"Tier0NumberOfRoutesResult":                                 model.Tier0NumberOfRoutesResultBindingType,
+	"Tier0RouteMap":                                             model.Tier0RouteMapBindingType,
+	"Tier0RouteMapListResult":                                   model.Tier0RouteMapListResultBindingType,
+	"Tier0RouteRedistributionConfig":                            model.Tier0RouteRedistributionConfigBindingType,
+	"Tier0RouteRedistributionRule":                              model.Tier0RouteRedistributionRuleBindingType,
+	"Tier0SecurityFeature":                                      model.Tier0SecurityFeatureBindingType,
+	"Tier0SecurityFeatures":                                     model.Tier0SecurityFeaturesBindingType,
+	"Tier0StatefulServicesConfig":                               model.Tier0StatefulServicesConfigBindingType,
+	"Tier0Status":                                               model.Tier0StatusBindingType,
+	"Tier0VrfConfig":                                            model.Tier0VrfConfigBindingType,
+	"Tier1":                                                     model.Tier1BindingType,
+	"Tier1DeploymentMap":                                        model.Tier1DeploymentMapBindingType,
+	"Tier1GatewayState":                                         model.Tier1GatewayStateBindingType,
+	"Tier1Interface":                                            model.Tier1InterfaceBindingType,
+	"Tier1InterfaceGroup":                                       model.Tier1InterfaceGroupBindingType,
+	"Tier1InterfaceGroupListResult":                             model.Tier1InterfaceGroupListResultBindingType,
+	"Tier1InterfaceListResult":                                  model.Tier1InterfaceListResultBindingType,
+	"Tier1ListResult":                                           model.Tier1ListResultBindingType,
+	"TimeRangeDropdownFilterWidgetConfiguration":                model.TimeRangeDropdownFilterWidgetConfigurationBindingType,
+	"TimeRangeFilterInfo":                                       model.TimeRangeFilterInfoBindingType,
+	"TlsCertificate":                                            model.TlsCertificateBindingType,
+	"TlsCertificateList":                                        model.TlsCertificateListBindingType,
+	"TlsCrl":                                                    model.TlsCrlBindingType,
+	"TlsCrlListResult":                                          model.TlsCrlListResultBindingType,
+	"TlsCsr":                                                    model.TlsCsrBindingType,
+	"TlsCsrListResult":                                          model.TlsCsrListResultBindingType,
+	"TlsCsrWithDaysValid":                                       model.TlsCsrWithDaysValidBindingType,
+	"TlsInspectionExternalProfile":                              model.TlsInspectionExternalProfileBindingType,
+	"TlsInspectionInternalProfile":                              model.TlsInspectionInternalProfileBindingType,
+	"TlsListenerCertificate":                                    model.TlsListenerCertificateBindingType,
+	"TlsPolicy":                                                 model.TlsPolicyBindingType,
+	"TlsPolicyListResult":                                       model.TlsPolicyListResultBindingType,
+	"TlsProfile":                                                model.TlsProfileBindingType,
+	"TlsProfileListResult":                                      model.TlsProfileListResultBindingType,
+	"TlsRule":                                                   model.TlsRuleBindingType,
+	"TlsRuleListResult":                                         model.TlsRuleListResultBindingType,
+	"TlsStateObject":                                            model.TlsStateObjectBindingType,
+	"TlsStateObjectListResult":                                  model.TlsStateObjectListResultBindingType,
+	"TlsTrustData":                                              model.TlsTrustDataBindingType,
+	"TnHyperbusStatus":                                          model.TnHyperbusStatusBindingType,
+	"TnNodeAgentStatusListResult":                               model.TnNodeAgentStatusListResultBindingType,
+	"TnNodeAgentstatus":                                         model.TnNodeAgentstatusBindingType,
+	"TnNodeStackSpanStatus":                                     model.TnNodeStackSpanStatusBindingType,
+	"Tooltip":                                                   model.TooltipBindingType,
+	"TopologyDiscoveryInterfaceNeighborProperties":              model.TopologyDiscoveryInterfaceNeighborPropertiesBindingType,
+	"TraceActionArgument":                                       model.TraceActionArgumentBindingType,
+	"TraceActionConfig":                                         model.TraceActionConfigBindingType,
+	"TraceResult":                                               model.TraceResultBindingType,
+	"Traceflow":                                                 model.TraceflowBindingType,
+	"TraceflowConfig":                                           model.TraceflowConfigBindingType,
+	"TraceflowConfigListResult":                                 model.TraceflowConfigListResultBindingType,
+	"TraceflowObservation":                                      model.TraceflowObservationBindingType,
+	"TraceflowObservationCounters":                              model.TraceflowObservationCountersBindingType,
+	"TraceflowObservationDelivered":                             model.TraceflowObservationDeliveredBindingType,
+	"TraceflowObservationDropped":                               model.TraceflowObservationDroppedBindingType,
+	"TraceflowObservationDroppedLogical":                        model.TraceflowObservationDroppedLogicalBindingType,
+	"TraceflowObservationForwarded":                             model.TraceflowObservationForwardedBindingType,
+	"TraceflowObservationForwardedLogical":                      model.TraceflowObservationForwardedLogicalBindingType,
+	"TraceflowObservationIpsecVpn":                              model.TraceflowObservationIpsecVpnBindingType,
+	"TraceflowObservationListResult":                            model.TraceflowObservationListResultBindingType,
+	"TraceflowObservationProtected":                             model.TraceflowObservationProtectedBindingType,
+	"TraceflowObservationReceived":                              model.TraceflowObservationReceivedBindingType,
+	"TraceflowObservationReceivedLogical":                       model.TraceflowObservationReceivedLogicalBindingType,
+	"TraceflowObservationRelayedLogical":                        model.TraceflowObservationRelayedLogicalBindingType,
+	"TraceflowObservationReplicationLogical":                    model.TraceflowObservationReplicationLogicalBindingType,
+	"TraceflowRequestParameter":                                 model.TraceflowRequestParameterBindingType,
+	"TrafficRateLimits":                                         model.TrafficRateLimitsBindingType,
+	"TransitGateway":                                            model.TransitGatewayBindingType,
+	"TransitGatewayAttachment":                                  model.TransitGatewayAttachmentBindingType,
+	"TransitGatewayAttachmentListResult":                        model.TransitGatewayAttachmentListResultBindingType,
+	"TransitGatewayAttachmentStatistics":                        model.TransitGatewayAttachmentStatisticsBindingType,
+	"TransitGatewayListResult":                                  model.TransitGatewayListResultBindingType,
+	"TransitGatewayNat":                                         model.TransitGatewayNatBindingType,
+	"TransitGatewayNatListResult":                               model.TransitGatewayNatListResultBindingType,
+	"TransitGatewayNatRule":                                     model.TransitGatewayNatRuleBindingType,
+	"TransitGatewayNatRuleListResult":                           model.TransitGatewayNatRuleListResultBindingType,
+	"TransitGatewayPortExternalCounters":                        model.TransitGatewayPortExternalCountersBindingType,
+	"TransitGatewayPortInternalCounters":                        model.TransitGatewayPortInternalCountersBindingType,
+	"TransitGatewayState":                                       model.TransitGatewayStateBindingType,
+	"TransitGatewayStateNodeStatus":                             model.TransitGatewayStateNodeStatusBindingType,
+	"TransitGatewayStatistics":                                  model.TransitGatewayStatisticsBindingType,
+	"TransportInfo":                                             model.TransportInfoBindingType,
+	"TransportNodeCollectionState":                              model.TransportNodeCollectionStateBindingType,
+	"TransportNodeDatapathStats":                                model.TransportNodeDatapathStatsBindingType,
+	"TransportNodeDeploymentProgressState":                      model.TransportNodeDeploymentProgressStateBindingType,
+	"TransportNodeHealthCheckResult":                            model.TransportNodeHealthCheckResultBindingType,
+	"TransportNodeProfileSubConfig":                             model.TransportNodeProfileSubConfigBindingType,
+	"TransportNodeSpanEnforcedStatus":                           model.TransportNodeSpanEnforcedStatusBindingType,
+	"TransportNodeState":                                        model.TransportNodeStateBindingType,
+	"TransportNodeStateListResult":                              model.TransportNodeStateListResultBindingType,
+	"TransportNodeStatus":                                       model.TransportNodeStatusBindingType,
+	"TransportNodeStatusListResult":                             model.TransportNodeStatusListResultBindingType,
+	"TransportNodeStatusReport":                                 model.TransportNodeStatusReportBindingType,
+	"TransportNodeStatusReportListResult":                       model.TransportNodeStatusReportListResultBindingType,
+	"TransportNodeVtepAction":                                   model.TransportNodeVtepActionBindingType,
+	"TransportNodeVtepActionReference":                          model.TransportNodeVtepActionReferenceBindingType,
+	"TransportNodeVtepActionStatus":                             model.TransportNodeVtepActionStatusBindingType,
+	"TransportNodeVtepActionsStatusListResult":                  model.TransportNodeVtepActionsStatusListResultBindingType,
+	"TransportNodeVtepAdminStateMgmtRequest":                    model.TransportNodeVtepAdminStateMgmtRequestBindingType,
+	"TransportNodeVtepAdminStateMgmtStatus":                     model.TransportNodeVtepAdminStateMgmtStatusBindingType,
+	"TransportNodeVtepRecoveryRequest":                          model.TransportNodeVtepRecoveryRequestBindingType,
+	"TransportNodeVtepRecoveryStatus":                           model.TransportNodeVtepRecoveryStatusBindingType,
+	"TransportProtocolHeader":                                   model.TransportProtocolHeaderBindingType,
+	"TransportZoneEndPoint":                                     model.TransportZoneEndPointBindingType,
+	"TransportZoneProfileTypeIdEntry":                           model.TransportZoneProfileTypeIdEntryBindingType,
+	"Tunnel":                                                    model.TunnelBindingType,
+	"TunnelAddress":                                             model.TunnelAddressBindingType,
+	"TunnelInterfaceIPSubnet":                                   model.TunnelInterfaceIPSubnetBindingType,
+	"TunnelKeepAlive":                                           model.TunnelKeepAliveBindingType,
+	"TunnelList":                                                model.TunnelListBindingType,
+	"TunnelPortStatisticsPerNode":                               model.TunnelPortStatisticsPerNodeBindingType,
+	"TunnelProperties":                                          model.TunnelPropertiesBindingType,
+	"TunnelStatus":                                              model.TunnelStatusBindingType,
+	"TunnelStatusCount":                                         model.TunnelStatusCountBindingType,
+	"TunnelSubnet":                                              model.TunnelSubnetBindingType,
+	"TunnelTrafficStatistics":                                   model.TunnelTrafficStatisticsBindingType,
+	"UAAgentCommonResource":                                     model.UAAgentCommonResourceBindingType,
+	"UdpHeader":                                                 model.UdpHeaderBindingType,
+	"UnaryOperation":                                            model.UnaryOperationBindingType,
+	"UnaryOperationBasedInjectionValue":                         model.UnaryOperationBasedInjectionValueBindingType,
+	"UnboundedKeyValuePair":                                     model.UnboundedKeyValuePairBindingType,
+	"UnicastPacketFlooding":                                     model.UnicastPacketFloodingBindingType,
+	"UnidirectionalServicePath":                                 model.UnidirectionalServicePathBindingType,
+	"UpdateIdsSiteVersionMappingQueryParameters":                model.UpdateIdsSiteVersionMappingQueryParametersBindingType,
+	"UpgradeCoordinatorDeploymentConfig":                        model.UpgradeCoordinatorDeploymentConfigBindingType,
+	"Uplink":                                                    model.UplinkBindingType,
+	"UploadBundleId":                                            model.UploadBundleIdBindingType,
+	"UploadBundleRemoteServer":                                  model.UploadBundleRemoteServerBindingType,
+	"UploadBundleStatus":                                        model.UploadBundleStatusBindingType,
+	"UpstreamStatistics":                                        model.UpstreamStatisticsBindingType,
+	"UrlAlias":                                                  model.UrlAliasBindingType,
+	"UserInfo":                                                  model.UserInfoBindingType,
+	"UserSession":                                               model.UserSessionBindingType,
+	"VCFolderStatus":                                            model.VCFolderStatusBindingType,
+	"VDSFolderState":                                            model.VDSFolderStateBindingType,
+	"VMTagReplicationPolicy":                                    model.VMTagReplicationPolicyBindingType,
+	"VMTagReplicationPolicyListResult":                          model.VMTagReplicationPolicyListResultBindingType,
+	"ValidationError":                                           model.ValidationErrorBindingType,
+	"ValidationNote":                                            model.ValidationNoteBindingType,
+	"ValueConstraintExpression":                                 model.ValueConstraintExpressionBindingType,
+	"Vdl2Counters":                                              model.Vdl2CountersBindingType,
+	"VdrbCounters":                                              model.VdrbCountersBindingType,
+	"VdsUplink":                                                 model.VdsUplinkBindingType,
+	"VersionList":                                               model.VersionListBindingType,
+	"VidmInfo":                                                  model.VidmInfoBindingType,
+	"VidmInfoListResult":                                        model.VidmInfoListResultBindingType,
+	"View":                                                      model.ViewBindingType,
+	"ViewList":                                                  model.ViewListBindingType,
+	"VirtualEndpoint":                                           model.VirtualEndpointBindingType,
+	"VirtualEndpointListResult":                                 model.VirtualEndpointListResultBindingType,
+	"VirtualMachine":                                            model.VirtualMachineBindingType,
+	"VirtualMachineDetails":                                     model.VirtualMachineDetailsBindingType,
+	"VirtualMachineListResult":                                  model.VirtualMachineListResultBindingType,
+	"VirtualMachineRuntimeInfo":                                 model.VirtualMachineRuntimeInfoBindingType,
+	"VirtualMachineTagsUpdate":                                  model.VirtualMachineTagsUpdateBindingType,
+	"VirtualNetworkInterface":                                   model.VirtualNetworkInterfaceBindingType,
+	"VirtualNetworkInterfaceListResult":                         model.VirtualNetworkInterfaceListResultBindingType,
+	"VirtualNetworkInterfaceRuntimeInfo":                        model.VirtualNetworkInterfaceRuntimeInfoBindingType,
+	"VirtualSwitchCounter":                                      model.VirtualSwitchCounterBindingType,
+	"VlanVniRangePair":                                          model.VlanVniRangePairBindingType,
+	"VmApplianceState":                                          model.VmApplianceStateBindingType,
+	"VmPlacementState":                                          model.VmPlacementStateBindingType,
+	"VmknicNetwork":                                             model.VmknicNetworkBindingType,
+	"VniPoolConfig":                                             model.VniPoolConfigBindingType,
+	"VniPoolConfigListResult":                                   model.VniPoolConfigListResultBindingType,
+	"Vpc":                                                       model.VpcBindingType,
+	"VpcAndChildrenStatus":                                      model.VpcAndChildrenStatusBindingType,
+	"VpcAttachment":                                             model.VpcAttachmentBindingType,
+	"VpcAttachmentListResult":                                   model.VpcAttachmentListResultBindingType,
+	"VpcConnectivityProfile":                                    model.VpcConnectivityProfileBindingType,
+	"VpcConnectivityProfileListResult":                          model.VpcConnectivityProfileListResultBindingType,
+	"VpcDhcpAdvancedConfig":                                     model.VpcDhcpAdvancedConfigBindingType,
+	"VpcDhcpRelayConfig":                                        model.VpcDhcpRelayConfigBindingType,
+	"VpcDhcpServerConfig":                                       model.VpcDhcpServerConfigBindingType,
+	"VpcIntentRealizationStatus":                                model.VpcIntentRealizationStatusBindingType,
+	"VpcIpAddress":                                              model.VpcIpAddressBindingType,
+	"VpcIpAddressAllocation":                                    model.VpcIpAddressAllocationBindingType,
+	"VpcIpAddressAllocationListResult":                          model.VpcIpAddressAllocationListResultBindingType,
+	"VpcIpAddressBlock":                                         model.VpcIpAddressBlockBindingType,
+	"VpcIpAddressBlocks":                                        model.VpcIpAddressBlocksBindingType,
+	"VpcListResult":                                             model.VpcListResultBindingType,
+	"VpcNatConfig":                                              model.VpcNatConfigBindingType,
+	"VpcProfileDhcpConfig":                                      model.VpcProfileDhcpConfigBindingType,
+	"VpcSecurityProfile":                                        model.VpcSecurityProfileBindingType,
+	"VpcSecurityProfileListResult":                              model.VpcSecurityProfileListResultBindingType,
+	"VpcServiceGatewayConfig":                                   model.VpcServiceGatewayConfigBindingType,
+	"VpcServiceGatewayPortExternalCounters":                     model.VpcServiceGatewayPortExternalCountersBindingType,
+	"VpcServiceGatewayPortInternalCounters":                     model.VpcServiceGatewayPortInternalCountersBindingType,
+	"VpcServiceProfile":                                         model.VpcServiceProfileBindingType,
+	"VpcServiceProfileListResult":                               model.VpcServiceProfileListResultBindingType,
+	"VpcState":                                                  model.VpcStateBindingType,
+	"VpcStateNodeStatus":                                        model.VpcStateNodeStatusBindingType,
+	"VpcStatistics":                                             model.VpcStatisticsBindingType,
+	"VpcStatus":                                                 model.VpcStatusBindingType,
+	"VpcStatusAdditionalDetails":                                model.VpcStatusAdditionalDetailsBindingType,
+	"VpcStatusReason":                                           model.VpcStatusReasonBindingType,
+	"VpcSubnet":                                                 model.VpcSubnetBindingType,
+	"VpcSubnetBridgeProfile":                                    model.VpcSubnetBridgeProfileBindingType,
+	"VpcSubnetDhcpConfig":                                       model.VpcSubnetDhcpConfigBindingType,
+	"VpcSubnetListResult":                                       model.VpcSubnetListResultBindingType,
+	"VpcSubnetPort":                                             model.VpcSubnetPortBindingType,
+	"VpcSubnetPortListResult":                                   model.VpcSubnetPortListResultBindingType,
+	"VpcSubnetPortProfileBindingMap":                            model.VpcSubnetPortProfileBindingMapBindingType,
+	"VpcSubnetStatus":                                           model.VpcSubnetStatusBindingType,
+	"VpcSubnetStatusListResult":                                 model.VpcSubnetStatusListResultBindingType,
+	"VpcTransitGatewayStatusResult":                             model.VpcTransitGatewayStatusResultBindingType,
+	"VrfEvpnL2VniConfig":                                        model.VrfEvpnL2VniConfigBindingType,
+	"VrfL2VniConfig":                                            model.VrfL2VniConfigBindingType,
+	"VrfRouteTargets":                                           model.VrfRouteTargetsBindingType,
+	"VrniGlobalCollector":                                       model.VrniGlobalCollectorBindingType,
+	"VrniStreamingGlobalCollector":                              model.VrniStreamingGlobalCollectorBindingType,
+	"VsipCounters":                                              model.VsipCountersBindingType,
+	"VswitchCounters":                                           model.VswitchCountersBindingType,
+	"WaveFrontGlobalCollector":                                  model.WaveFrontGlobalCollectorBindingType,
+	"WeeklyBackupSchedule":                                      model.WeeklyBackupScheduleBindingType,
+	"WidgetConfiguration":                                       model.WidgetConfigurationBindingType,
+	"WidgetConfigurationList":                                   model.WidgetConfigurationListBindingType,
+	"WidgetItem":                                                model.WidgetItemBindingType,
+	"WidgetPlotConfiguration":                                   model.WidgetPlotConfigurationBindingType,
+	"X509Certificate":                                           model.X509CertificateBindingType,
+	"X509Crl":                                                   model.X509CrlBindingType,
+	"X509CrlEntry":                                              model.X509CrlEntryBindingType,
+}
@@ -0,0 +1,143 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+var terraformTypes = map[string]string{
+	"string": "schema.TypeString",
+	"int":    "schema.TypeInt",
+	"bool":   "schema.TypeBool",
+}
+
+// buildEnums generates variables with possible values for enum attributes
+func buildEnums(objName string, attrs []*attribute) string {
+	var b strings.Builder
+	for _, attr := range attrs {
+		if len(attr.Enums) > 0 {
+			fmt.Fprintf(&b, "\nvar %s = []string{\n", attr.enumVarName(objName))
+			for _, enum := range attr.Enums {
+				fmt.Fprintf(&b, "model.%s,\n", enum.ConstName)
+			}
+			b.WriteString("}\n")
+		}
+		if len(attr.Children) > 0 {
+			b.WriteString(buildEnums(objName, attr.Children))
+		}
+	}
+	return b.String()
+}
+
+// buildExtendedSchema generates metadata.ExtendedSchema definitions for the attributes
+func buildExtendedSchema(objName string, attrs []*attribute, topLevel bool) string {
+	var b strings.Builder
+	for _, attr := range attrs {
+		fmt.Fprintf(&b, "%q: {\n", attr.SchemaName)
+		b.WriteString("Schema: schema.Schema{\n")
+		switch {
+		case attr.SchemaType == "struct":
+			b.WriteString("Type: schema.TypeList,\n")
+			if !attr.List {
+				b.WriteString("MaxItems: 1,\n")
+			}
+			b.WriteString("Elem: &metadata.ExtendedResource{\n")
+			b.WriteString("Schema: map[string]*metadata.ExtendedSchema{\n")
+			b.WriteString(buildExtendedSchema(objName, attr.Children, false))
+			b.WriteString("},\n},\n")
+		case attr.List:
+			b.WriteString("Type: schema.TypeList,\n")
+			b.WriteString("Elem: &metadata.ExtendedSchema{\n")
+			fmt.Fprintf(&b, "Schema: schema.Schema{\nType: %s,\n", terraformTypes[attr.SchemaType])
+			if len(attr.Enums) > 0 {
+				fmt.Fprintf(&b, "ValidateFunc: validation.StringInSlice(%s, false),\n", attr.enumVarName(objName))
+			}
+			b.WriteString("},\n")
+			fmt.Fprintf(&b, "Metadata: metadata.Metadata{\nSchemaType: %q,\n},\n", attr.SchemaType)
+			b.WriteString("},\n")
+		default:
+			fmt.Fprintf(&b, "Type: %s,\n", terraformTypes[attr.SchemaType])
+			if len(attr.Enums) > 0 {
+				fmt.Fprintf(&b, "ValidateFunc: validation.StringInSlice(%s, false),\n", attr.enumVarName(objName))
+			}
+		}
+		if attr.Required {
+			b.WriteString("Required: true,\n")
+		} else {
+			b.WriteString("Optional: true,\n")
+		}
+		b.WriteString("},\n")
+
+		b.WriteString("Metadata: metadata.Metadata{\n")
+		switch {
+		case attr.List:
+			b.WriteString("SchemaType: \"list\",\n")
+		default:
+			fmt.Fprintf(&b, "SchemaType: %q,\n", attr.SchemaType)
+		}
+		fmt.Fprintf(&b, "SdkFieldName: %q,\n", attr.SdkName)
+		if attr.SchemaType == "struct" {
+			fmt.Fprintf(&b, "ReflectType: reflect.TypeOf(model.%s{}),\n", attr.StructName)
+		}
+		if topLevel && attr.SchemaType != "struct" && !attr.List {
+			b.WriteString("TestData: metadata.Testdata{\n")
+			fmt.Fprintf(&b, "CreateValue: %q,\n", attr.testValue(true))
+			fmt.Fprintf(&b, "UpdateValue: %q,\n", attr.testValue(false))
+			b.WriteString("},\n")
+		}
+		b.WriteString("},\n")
+		b.WriteString("},\n")
+	}
+	return b.String()
+}
+
+func hasEnums(attrs []*attribute) bool {
+	for _, attr := range attrs {
+		if len(attr.Enums) > 0 || hasEnums(attr.Children) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildDocAttrs generates attribute assignments for documentation example
+func buildDocAttrs(attrs []*attribute, indent string) string {
+	var b strings.Builder
+	for _, attr := range attrs {
+		if attr.SchemaType == "struct" {
+			fmt.Fprintf(&b, "\n%s%s {\n", indent, attr.SchemaName)
+			b.WriteString(buildDocAttrs(attr.Children, indent+"  "))
+			fmt.Fprintf(&b, "%s}\n", indent)
+			continue
+		}
+		fmt.Fprintf(&b, "%s%s = %s\n", indent, attr.SchemaName, attr.docValue())
+	}
+	return b.String()
+}
+
+// buildDocReference generates argument reference for documentation
+func buildDocReference(attrs []*attribute, indent string) string {
+	var b strings.Builder
+	for _, attr := range attrs {
+		qualifier := "Optional"
+		if attr.Required {
+			qualifier = "Required"
+		}
+		description := attr.Description
+		if len(attr.Enums) > 0 {
+			var values []string
+			for _, enum := range attr.Enums {
+				values = append(values, fmt.Sprintf("`%s`", enum.Value))
+			}
+			description = fmt.Sprintf("%s. Possible values are: %s.", strings.TrimSuffix(description, "."), strings.Join(values, ", "))
+		}
+		fmt.Fprintf(&b, "%s* `%s` - (%s) %s\n", indent, attr.SchemaName, qualifier, description)
+		if attr.SchemaType == "struct" {
+			b.WriteString(buildDocReference(attr.Children, indent+"  "))
+		}
+	}
+	return b.String()
+}
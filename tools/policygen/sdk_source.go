@@ -0,0 +1,170 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// enumValue is a single possible value of SDK string attribute
+type enumValue struct {
+	ConstName string
+	Value     string
+}
+
+// fieldDoc holds documentation details for a single SDK struct field
+type fieldDoc struct {
+	Description string
+	Enums       []enumValue
+}
+
+// sdkSource provides documentation and enum values for SDK model structs.
+// Both are only available in the SDK source code, and not via reflection.
+type sdkSource struct {
+	structs   map[string]*ast.StructType
+	constants map[string]string
+}
+
+func loadSdkSource(dir string) (*sdkSource, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, nil, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SDK model in %s: %v", dir, err)
+	}
+
+	src := &sdkSource{
+		structs:   make(map[string]*ast.StructType),
+		constants: make(map[string]string),
+	}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					switch s := spec.(type) {
+					case *ast.TypeSpec:
+						if st, ok := s.Type.(*ast.StructType); ok {
+							src.structs[s.Name.Name] = st
+						}
+					case *ast.ValueSpec:
+						if genDecl.Tok != token.CONST {
+							continue
+						}
+						for i, name := range s.Names {
+							if i >= len(s.Values) {
+								continue
+							}
+							if lit, ok := s.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+								value, err := strconv.Unquote(lit.Value)
+								if err == nil {
+									src.constants[name.Name] = value
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return src, nil
+}
+
+// fieldDoc returns description and possible values for given struct field
+func (s *sdkSource) fieldDoc(structName string, fieldName string) fieldDoc {
+	var result fieldDoc
+	st, ok := s.structs[structName]
+	if !ok {
+		return result
+	}
+
+	for _, field := range st.Fields.List {
+		found := false
+		for _, name := range field.Names {
+			if name.Name == fieldName {
+				found = true
+			}
+		}
+		if !found || field.Doc == nil {
+			continue
+		}
+
+		var description []string
+		for _, line := range strings.Split(field.Doc.Text(), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "Possible values are:" || line == "" {
+				continue
+			}
+			if strings.HasPrefix(line, "* ") && strings.Contains(line, "#") {
+				constName := line[strings.Index(line, "#")+1:]
+				if value, ok := s.constants[constName]; ok {
+					result.Enums = append(result.Enums, enumValue{ConstName: constName, Value: value})
+				}
+				continue
+			}
+			description = append(description, line)
+		}
+		text := strings.Join(description, " ")
+		if idx := strings.Index(text, " format: "); idx > 0 {
+			text = text[:idx]
+		}
+		result.Description = strings.TrimSpace(strings.ReplaceAll(text, `\"`, `"`))
+		break
+	}
+
+	return result
+}
+
+// clientHasOverrideParam checks whether given method of SDK client interface accepts
+// override parameter, which is the case for objects that can be created by the system,
+// such as profiles. Generated code needs to pass the parameter explicitly.
+func clientHasOverrideParam(clientDir string, clientName string, methodName string) (bool, error) {
+	path := filepath.Join(clientDir, clientName+".go")
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse SDK client %s: %v", path, err)
+	}
+
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok || typeSpec.Name.Name != clientName {
+				continue
+			}
+			iface, ok := typeSpec.Type.(*ast.InterfaceType)
+			if !ok {
+				continue
+			}
+			for _, method := range iface.Methods.List {
+				if len(method.Names) == 0 || method.Names[0].Name != methodName {
+					continue
+				}
+				funcType := method.Type.(*ast.FuncType)
+				for _, param := range funcType.Params.List {
+					for _, name := range param.Names {
+						if name.Name == "overrideParam" {
+							return true, nil
+						}
+					}
+				}
+				return false, nil
+			}
+		}
+	}
+
+	return false, fmt.Errorf("method %s not found in SDK client %s", methodName, clientName)
+}